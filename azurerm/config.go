@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/go-azure-helpers/authentication"
 	"github.com/hashicorp/go-azure-helpers/sender"
+	"github.com/hashicorp/terraform/helper/logging"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/analysisservices"
@@ -144,7 +146,7 @@ type ArmClient struct {
 
 // getArmClient is a helper method which returns a fully instantiated
 // *ArmClient based on the Config's current settings.
-func getArmClient(authConfig *authentication.Config, skipProviderRegistration bool, partnerId string, disableCorrelationRequestID bool) (*ArmClient, error) {
+func getArmClient(authConfig *authentication.Config, skipProviderRegistration bool, partnerId string, disableCorrelationRequestID bool, httpTraceFile string, enableReadCache bool) (*ArmClient, error) {
 	env, err := authentication.DetermineEnvironment(authConfig.Environment)
 	if err != nil {
 		return nil, err
@@ -175,6 +177,35 @@ func getArmClient(authConfig *authentication.Config, skipProviderRegistration bo
 
 	sender := sender.BuildSender("AzureRM")
 
+	// HTTP Tracing is enabled when debug logging is enabled (replacing the raw request logging, which
+	// includes secrets) or when a file to write the trace to has been specified
+	var httpTracer *common.HTTPTracer
+	if logging.IsDebugOrHigher() || httpTraceFile != "" {
+		httpTracer, err = common.NewHTTPTracer("AzureRM", httpTraceFile)
+		if err != nil {
+			return nil, err
+		}
+
+		sender = httpTracer.BuildSender()
+	}
+
+	// the Read Cache is opt-in and lives for the duration of this run of the Provider
+	var readCache *common.ReadCache
+	if enableReadCache {
+		readCache = common.NewReadCache(common.DefaultReadCacheWindow)
+		client.Client.ReadCache = readCache
+	}
+
+	// when recording/replaying acceptance tests the requests used to obtain tokens are also recorded
+	if common.CurrentRecordingMode() != common.RecordingModeLive {
+		common.ScrubValuesFromRecordings(map[string]string{
+			authConfig.ClientID:       common.RecordingPlaceholderID,
+			authConfig.SubscriptionID: common.RecordingPlaceholderID,
+			authConfig.TenantID:       common.RecordingPlaceholderID,
+		})
+		sender = autorest.DecorateSender(sender, common.WithRecording())
+	}
+
 	// Resource Manager endpoints
 	endpoint := env.ResourceManagerEndpoint
 	auth, err := authConfig.GetAuthorizationToken(sender, oauthConfig, env.TokenAudience)
@@ -209,6 +240,8 @@ func getArmClient(authConfig *authentication.Config, skipProviderRegistration bo
 		SkipProviderReg:             skipProviderRegistration,
 		DisableCorrelationRequestID: disableCorrelationRequestID,
 		Environment:                 *env,
+		HTTPTracer:                  httpTracer,
+		ReadCache:                   readCache,
 	}
 
 	client.analysisservices = analysisservices.BuildClient(o)
@@ -221,6 +254,7 @@ func getArmClient(authConfig *authentication.Config, skipProviderRegistration bo
 	client.cdn = cdn.BuildClient(o)
 	client.cognitive = cognitive.BuildClient(o)
 	client.compute = clients.NewComputeClient(o)
+	client.Compute = client.compute
 	client.containers = containers.BuildClient(o)
 	client.cosmos = cosmos.BuildClient(o)
 	client.databricks = databricks.BuildClient(o)
@@ -2,12 +2,17 @@ package azurerm
 
 import (
 	"github.com/hashicorp/terraform/helper/schema"
+	"time"
 )
 
 func dataSourceArmAutomationVariableDateTime() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceArmAutomationVariableDateTimeRead,
 
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: datasourceAutomationVariableCommonSchema(schema.TypeString),
 	}
 }
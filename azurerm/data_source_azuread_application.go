@@ -3,9 +3,11 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -19,6 +21,10 @@ As such the Azure Active Directory resources within the AzureRM Provider are now
 `,
 		Read: dataSourceArmAzureADApplicationRead,
 
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"object_id": {
 				Type:          schema.TypeString,
@@ -75,7 +81,8 @@ As such the Azure Active Directory resources within the AzureRM Provider are now
 
 func dataSourceArmAzureADApplicationRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).graph.ApplicationsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	var application graphrbac.Application
 
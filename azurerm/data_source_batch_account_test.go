@@ -5,7 +5,6 @@ import (
 	"os"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -14,7 +13,7 @@ import (
 func TestAccDataSourceAzureRMBatchAccount_basic(t *testing.T) {
 	dataSourceName := "data.azurerm_batch_account.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	location := testLocation()
 	config := testAccDataSourceAzureRMBatchAccount_basic(ri, rs, location)
 
@@ -37,7 +36,7 @@ func TestAccDataSourceAzureRMBatchAccount_basic(t *testing.T) {
 func TestAccDataSourceAzureRMBatchAccount_complete(t *testing.T) {
 	dataSourceName := "data.azurerm_batch_account.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	location := testLocation()
 	config := testAccDataSourceAzureRMBatchAccount_complete(ri, rs, location)
 
@@ -62,7 +61,7 @@ func TestAccDataSourceAzureRMBatchAccount_complete(t *testing.T) {
 func TestAccDataSourceAzureRMBatchAccount_userSubscription(t *testing.T) {
 	dataSourceName := "data.azurerm_batch_account.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	location := testLocation()
 
 	tenantID := os.Getenv("ARM_TENANT_ID")
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 )
@@ -12,7 +11,7 @@ import (
 func TestAccDataSourceAzureRMBatchCertificate_basic(t *testing.T) {
 	dataSourceName := "data.azurerm_batch_certificate.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	location := testLocation()
 	config := testAccDataSourceAzureRMBatchCertificate_basic(ri, rs, location)
 
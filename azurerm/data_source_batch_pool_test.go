@@ -6,7 +6,6 @@ import (
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
@@ -14,7 +13,7 @@ func TestAccDataSourceAzureRMBatchPool_complete(t *testing.T) {
 	dataSourceName := "data.azurerm_batch_pool.test"
 	ri := tf.AccRandTimeInt()
 
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	location := testLocation()
 	config := testAccDataSourceAzureRMBatchPool_complete(ri, rs, location)
 
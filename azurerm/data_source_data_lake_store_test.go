@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 )
@@ -12,7 +11,7 @@ import (
 func TestAccDataSourceAzureRMDataLakeStore_basic(t *testing.T) {
 	dataSourceName := "data.azurerm_data_lake_store.test"
 	rInt := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -33,7 +32,7 @@ func TestAccDataSourceAzureRMDataLakeStore_basic(t *testing.T) {
 func TestAccDataSourceAzureRMDataLakeStore_tier(t *testing.T) {
 	dataSourceName := "data.azurerm_data_lake_store.test"
 	rInt := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -0,0 +1,44 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccDataSourceAzureRMDedicatedHostGroup_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_dedicated_host_group.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMDedicatedHostGroup_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "location"),
+					resource.TestCheckResourceAttr(dataSourceName, "platform_fault_domain_count", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "zones.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.ENV", "prod"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMDedicatedHostGroup_basic(rInt int, location string) string {
+	config := testAccAzureRMDedicatedHostGroup_complete(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_dedicated_host_group" "test" {
+  name                = "${azurerm_dedicated_host_group.test.name}"
+  resource_group_name = "${azurerm_dedicated_host_group.test.resource_group_name}"
+}
+`, config)
+}
@@ -0,0 +1,46 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccDataSourceAzureRMDedicatedHost_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_dedicated_host.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMDedicatedHost_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "location"),
+					resource.TestCheckResourceAttr(dataSourceName, "sku_name", "DSv3-Type1"),
+					resource.TestCheckResourceAttr(dataSourceName, "platform_fault_domain", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "auto_replace_on_failure", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "license_type", "Windows_Server_Hybrid"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMDedicatedHost_basic(rInt int, location string) string {
+	config := testAccAzureRMDedicatedHost_complete(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_dedicated_host" "test" {
+  name                      = "${azurerm_dedicated_host.test.name}"
+  dedicated_host_group_name = "${azurerm_dedicated_host_group.test.name}"
+  resource_group_name       = "${azurerm_dedicated_host_group.test.resource_group_name}"
+}
+`, config)
+}
@@ -5,7 +5,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 )
@@ -13,7 +12,7 @@ import (
 func TestAccDataSourceAzureRMHDInsightCluster_hadoop(t *testing.T) {
 	dataSourceName := "data.azurerm_hdinsight_cluster.test"
 	rInt := tf.AccRandTimeInt()
-	rString := strings.ToLower(acctest.RandString(11))
+	rString := strings.ToLower(tf.AccRandString(11))
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -37,7 +36,7 @@ func TestAccDataSourceAzureRMHDInsightCluster_hadoop(t *testing.T) {
 func TestAccDataSourceAzureRMHDInsightCluster_hbase(t *testing.T) {
 	dataSourceName := "data.azurerm_hdinsight_cluster.test"
 	rInt := tf.AccRandTimeInt()
-	rString := strings.ToLower(acctest.RandString(11))
+	rString := strings.ToLower(tf.AccRandString(11))
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -61,7 +60,7 @@ func TestAccDataSourceAzureRMHDInsightCluster_hbase(t *testing.T) {
 func TestAccDataSourceAzureRMHDInsightCluster_interactiveQuery(t *testing.T) {
 	dataSourceName := "data.azurerm_hdinsight_cluster.test"
 	rInt := tf.AccRandTimeInt()
-	rString := strings.ToLower(acctest.RandString(11))
+	rString := strings.ToLower(tf.AccRandString(11))
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -85,7 +84,7 @@ func TestAccDataSourceAzureRMHDInsightCluster_interactiveQuery(t *testing.T) {
 func TestAccDataSourceAzureRMHDInsightCluster_kafka(t *testing.T) {
 	dataSourceName := "data.azurerm_hdinsight_cluster.test"
 	rInt := tf.AccRandTimeInt()
-	rString := strings.ToLower(acctest.RandString(11))
+	rString := strings.ToLower(tf.AccRandString(11))
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -109,7 +108,7 @@ func TestAccDataSourceAzureRMHDInsightCluster_kafka(t *testing.T) {
 func TestAccDataSourceAzureRMHDInsightCluster_mlServices(t *testing.T) {
 	dataSourceName := "data.azurerm_hdinsight_cluster.test"
 	rInt := tf.AccRandTimeInt()
-	rString := strings.ToLower(acctest.RandString(11))
+	rString := strings.ToLower(tf.AccRandString(11))
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -133,7 +132,7 @@ func TestAccDataSourceAzureRMHDInsightCluster_mlServices(t *testing.T) {
 func TestAccDataSourceAzureRMHDInsightCluster_rserver(t *testing.T) {
 	dataSourceName := "data.azurerm_hdinsight_cluster.test"
 	rInt := tf.AccRandTimeInt()
-	rString := strings.ToLower(acctest.RandString(11))
+	rString := strings.ToLower(tf.AccRandString(11))
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -157,7 +156,7 @@ func TestAccDataSourceAzureRMHDInsightCluster_rserver(t *testing.T) {
 func TestAccDataSourceAzureRMHDInsightCluster_spark(t *testing.T) {
 	dataSourceName := "data.azurerm_hdinsight_cluster.test"
 	rInt := tf.AccRandTimeInt()
-	rString := strings.ToLower(acctest.RandString(11))
+	rString := strings.ToLower(tf.AccRandString(11))
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -181,7 +180,7 @@ func TestAccDataSourceAzureRMHDInsightCluster_spark(t *testing.T) {
 func TestAccDataSourceAzureRMHDInsightCluster_storm(t *testing.T) {
 	dataSourceName := "data.azurerm_hdinsight_cluster.test"
 	rInt := tf.AccRandTimeInt()
-	rString := strings.ToLower(acctest.RandString(11))
+	rString := strings.ToLower(tf.AccRandString(11))
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
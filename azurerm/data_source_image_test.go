@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 )
@@ -12,7 +11,7 @@ import (
 func TestAccDataSourceAzureRMImage_basic(t *testing.T) {
 	dataSourceName := "data.azurerm_image.test"
 
-	config := testAccDataSourceAzureRMImageBasic(tf.AccRandTimeInt(), acctest.RandString(4), testLocation())
+	config := testAccDataSourceAzureRMImageBasic(tf.AccRandTimeInt(), tf.AccRandString(4), testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -44,7 +43,7 @@ func TestAccDataSourceAzureRMImage_localFilter(t *testing.T) {
 	descDataSourceName := "data.azurerm_image.test2"
 
 	ri := tf.AccRandTimeInt()
-	config := testAccDataSourceAzureRMImageLocalFilter(ri, acctest.RandString(4), testLocation())
+	config := testAccDataSourceAzureRMImageLocalFilter(ri, tf.AccRandString(4), testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -2,6 +2,7 @@ package azurerm
 
 import (
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2018-02-14/keyvault"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -11,6 +12,10 @@ import (
 func dataSourceArmKeyVaultAccessPolicy() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceArmKeyVaultAccessPolicyRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -2,11 +2,14 @@ package azurerm
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -14,6 +17,10 @@ func dataSourceArmKeyVaultKey() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceArmKeyVaultKeyRead,
 
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -22,12 +29,13 @@ func dataSourceArmKeyVaultKey() *schema.Resource {
 			},
 
 			"key_vault_id": {
-				Type:          schema.TypeString,
-				Optional:      true, //todo required in 2.0
-				Computed:      true, //todo removed in 2.0
-				ForceNew:      true,
-				ValidateFunc:  azure.ValidateResourceID,
-				ConflictsWith: []string{"vault_uri"},
+				Type:             schema.TypeString,
+				Optional:         true, //todo required in 2.0
+				Computed:         true, //todo removed in 2.0
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
+				ConflictsWith:    []string{"vault_uri"},
 			},
 
 			//todo remove in 2.0
@@ -82,7 +90,8 @@ func dataSourceArmKeyVaultKey() *schema.Resource {
 func dataSourceArmKeyVaultKeyRead(d *schema.ResourceData, meta interface{}) error {
 	vaultClient := meta.(*ArmClient).keyvault.VaultsClient
 	client := meta.(*ArmClient).keyvault.ManagementClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	keyVaultBaseUri := d.Get("vault_uri").(string)
 	name := d.Get("name").(string)
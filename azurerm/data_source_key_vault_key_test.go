@@ -4,14 +4,14 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 )
 
 func TestAccDataSourceAzureRMKeyVaultKey_complete(t *testing.T) {
 	dataSourceName := "data.azurerm_key_vault_key.test"
 
-	rString := acctest.RandString(8)
+	rString := tf.AccRandString(8)
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -4,14 +4,14 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 )
 
 func TestAccDataSourceAzureRMKeyVaultSecret_basic(t *testing.T) {
 	dataSourceName := "data.azurerm_key_vault_secret.test"
 
-	rString := acctest.RandString(8)
+	rString := tf.AccRandString(8)
 	location := testLocation()
 	config := testAccDataSourceKeyVaultSecret_basic(rString, location)
 
@@ -33,7 +33,7 @@ func TestAccDataSourceAzureRMKeyVaultSecret_basic(t *testing.T) {
 func TestAccDataSourceAzureRMKeyVaultSecret_complete(t *testing.T) {
 	dataSourceName := "data.azurerm_key_vault_secret.test"
 
-	rString := acctest.RandString(8)
+	rString := tf.AccRandString(8)
 	location := testLocation()
 	config := testAccDataSourceKeyVaultSecret_complete(rString, location)
 
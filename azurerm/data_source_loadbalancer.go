@@ -2,12 +2,14 @@ package azurerm
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -15,6 +17,10 @@ func dataSourceArmLoadBalancer() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceArmLoadBalancerRead,
 
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -90,7 +96,8 @@ func dataSourceArmLoadBalancerRead(d *schema.ResourceData, meta interface{}) err
 	resourceGroup := d.Get("resource_group_name").(string)
 
 	client := meta.(*ArmClient).network.LoadBalancersClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resp, err := client.Get(ctx, resourceGroup, name, "")
 	if err != nil {
@@ -161,7 +168,7 @@ func flattenLoadBalancerDataSourceFrontendIpConfiguration(ipConfigs *[]network.F
 			ipConfig["private_ip_address_allocation"] = props.PrivateIPAllocationMethod
 
 			if subnet := props.Subnet; subnet != nil && subnet.ID != nil {
-				ipConfig["subnet_id"] = *subnet.ID
+				ipConfig["subnet_id"] = azure.NormalizeResourceID(*subnet.ID)
 			}
 
 			if pip := props.PrivateIPAddress; pip != nil {
@@ -169,7 +176,7 @@ func flattenLoadBalancerDataSourceFrontendIpConfiguration(ipConfigs *[]network.F
 			}
 
 			if pip := props.PublicIPAddress; pip != nil && pip.ID != nil {
-				ipConfig["public_ip_address_id"] = *pip.ID
+				ipConfig["public_ip_address_id"] = azure.NormalizeResourceID(*pip.ID)
 			}
 		}
 
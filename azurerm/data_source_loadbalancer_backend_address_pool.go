@@ -2,16 +2,22 @@ package azurerm
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	networkSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
 )
 
 func dataSourceArmLoadBalancerBackendAddressPool() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceArmLoadBalancerBackendAddressPoolRead,
 
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -20,9 +26,10 @@ func dataSourceArmLoadBalancerBackendAddressPool() *schema.Resource {
 			},
 
 			"loadbalancer_id": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: azure.ValidateResourceID,
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     networkSvc.ValidateLoadBalancerID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
 			},
 		},
 	}
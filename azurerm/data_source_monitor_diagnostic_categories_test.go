@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 )
@@ -31,7 +30,7 @@ func TestAccDataSourceArmMonitorDiagnosticCategories_appService(t *testing.T) {
 
 func TestAccDataSourceArmMonitorDiagnosticCategories_storageAccount(t *testing.T) {
 	dataSourceName := "data.azurerm_monitor_diagnostic_categories.test"
-	rs := acctest.RandString(8)
+	rs := tf.AccRandString(8)
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
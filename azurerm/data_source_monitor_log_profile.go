@@ -2,14 +2,20 @@ package azurerm
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func dataSourceArmMonitorLogProfile() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceArmLogProfileRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -60,7 +66,8 @@ func dataSourceArmMonitorLogProfile() *schema.Resource {
 
 func dataSourceArmLogProfileRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).monitor.LogProfilesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resp, err := client.Get(ctx, name)
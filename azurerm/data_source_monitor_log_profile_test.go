@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 )
@@ -16,7 +15,7 @@ import (
 func testAccDataSourceAzureRMMonitorLogProfile_storageaccount(t *testing.T) {
 	dataSourceName := "data.azurerm_monitor_log_profile.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(10)
+	rs := tf.AccRandString(10)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -43,7 +42,7 @@ func testAccDataSourceAzureRMMonitorLogProfile_storageaccount(t *testing.T) {
 func testAccDataSourceAzureRMMonitorLogProfile_eventhub(t *testing.T) {
 	dataSourceName := "data.azurerm_monitor_log_profile.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(10)
+	rs := tf.AccRandString(10)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
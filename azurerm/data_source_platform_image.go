@@ -2,15 +2,21 @@ package azurerm
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func dataSourceArmPlatformImage() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceArmPlatformImageRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"location": azure.SchemaLocation(),
 
@@ -39,7 +45,8 @@ func dataSourceArmPlatformImage() *schema.Resource {
 
 func dataSourceArmPlatformImageRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).compute.VMImageClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	publisher := d.Get("publisher").(string)
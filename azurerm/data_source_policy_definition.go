@@ -2,16 +2,23 @@ package azurerm
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/policy"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 )
 
 func dataSourceArmPolicyDefinition() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceArmPolicyDefinitionRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"display_name": {
 				Type:         schema.TypeString,
@@ -19,9 +26,10 @@ func dataSourceArmPolicyDefinition() *schema.Resource {
 				ValidateFunc: validate.NoEmptyStrings,
 			},
 			"management_group_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: azure.ValidateResourceIDOrEmpty,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     azure.ValidateResourceIDOrEmpty,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
 			},
 			"name": {
 				Type:     schema.TypeString,
@@ -57,7 +65,8 @@ func dataSourceArmPolicyDefinition() *schema.Resource {
 
 func dataSourceArmPolicyDefinitionRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).policy.DefinitionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("display_name").(string)
 	managementGroupID := d.Get("management_group_id").(string)
@@ -0,0 +1,135 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/privatedns/mgmt/2018-09-01/privatedns"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmPrivateDnsZone() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmPrivateDnsZoneRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"number_of_record_sets": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"max_number_of_record_sets": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"max_number_of_virtual_network_links": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"max_number_of_virtual_network_links_with_registration": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"tags": tags.SchemaDataSource(),
+		},
+	}
+}
+
+func dataSourceArmPrivateDnsZoneRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).privateDns.PrivateZonesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	var (
+		resp privatedns.PrivateZone
+		err  error
+	)
+	if resourceGroup != "" {
+		resp, err = client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Error: Private DNS Zone %q (Resource Group %q) was not found", name, resourceGroup)
+			}
+			return fmt.Errorf("Error reading Private DNS Zone %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	} else {
+		zone, err := findPrivateZone(ctx, client, name)
+		if err != nil {
+			return err
+		}
+
+		if zone == nil {
+			return fmt.Errorf("Error: Private DNS Zone %q was not found", name)
+		}
+
+		resp = *zone
+	}
+
+	if resp.ID == nil || *resp.ID == "" {
+		return fmt.Errorf("Error retrieving Private DNS Zone %q: ID was nil or empty", name)
+	}
+
+	id, err := azure.ParseAzureResourceID(*resp.ID)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(*resp.ID)
+	d.Set("name", name)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if props := resp.PrivateZoneProperties; props != nil {
+		d.Set("number_of_record_sets", props.NumberOfRecordSets)
+		d.Set("max_number_of_record_sets", props.MaxNumberOfRecordSets)
+		d.Set("max_number_of_virtual_network_links", props.MaxNumberOfVirtualNetworkLinks)
+		d.Set("max_number_of_virtual_network_links_with_registration", props.MaxNumberOfVirtualNetworkLinksWithRegistration)
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func findPrivateZone(ctx context.Context, client *privatedns.PrivateZonesClient, name string) (*privatedns.PrivateZone, error) {
+	zones, err := client.ListComplete(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing Private DNS Zones: %+v", err)
+	}
+
+	for zones.NotDone() {
+		zone := zones.Value()
+		if zone.Name != nil && *zone.Name == name {
+			return &zone, nil
+		}
+
+		if err := zones.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("Error listing Private DNS Zones: %+v", err)
+		}
+	}
+
+	return nil, nil
+}
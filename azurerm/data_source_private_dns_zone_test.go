@@ -0,0 +1,132 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccDataSourceAzureRMPrivateDNSZone_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_private_dns_zone.test"
+	rInt := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateDnsZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePrivateDNSZone_basic(rInt, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "max_number_of_record_sets"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMPrivateDNSZone_tags(t *testing.T) {
+	dataSourceName := "data.azurerm_private_dns_zone.test"
+	rInt := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateDnsZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePrivateDNSZone_tags(rInt, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.hello", "world"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMPrivateDNSZone_withoutResourceGroupName(t *testing.T) {
+	dataSourceName := "data.azurerm_private_dns_zone.test"
+	rInt := tf.AccRandTimeInt()
+	location := testLocation()
+	resourceGroupName := fmt.Sprintf("acctestRG-%d", rInt)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateDnsZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePrivateDNSZone_onlyName(rInt, location, resourceGroupName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resource_group_name", resourceGroupName),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePrivateDNSZone_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_private_dns_zone" "test" {
+  name                = "acctestzone%d.internal"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+data "azurerm_private_dns_zone" "test" {
+  name                = "${azurerm_private_dns_zone.test.name}"
+  resource_group_name = "${azurerm_private_dns_zone.test.resource_group_name}"
+}
+`, rInt, location, rInt)
+}
+
+func testAccDataSourcePrivateDNSZone_tags(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_private_dns_zone" "test" {
+  name                = "acctestzone%d.internal"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  tags = {
+    hello = "world"
+  }
+}
+
+data "azurerm_private_dns_zone" "test" {
+  name                = "${azurerm_private_dns_zone.test.name}"
+  resource_group_name = "${azurerm_private_dns_zone.test.resource_group_name}"
+}
+`, rInt, location, rInt)
+}
+
+func testAccDataSourcePrivateDNSZone_onlyName(rInt int, location, resourceGroupName string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "%s"
+  location = "%s"
+}
+
+resource "azurerm_private_dns_zone" "test" {
+  name                = "acctestzone%d.internal"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+data "azurerm_private_dns_zone" "test" {
+  name = "${azurerm_private_dns_zone.test.name}"
+}
+`, resourceGroupName, location, rInt)
+}
@@ -0,0 +1,41 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccDataSourceAzureRMPrivateEndpointConnection_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_private_endpoint_connection.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMPrivateEndpointConnection_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "private_service_connection.0.status", "Approved"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "private_service_connection.0.private_ip_address"),
+					resource.TestCheckResourceAttr(dataSourceName, "network_interface_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMPrivateEndpointConnection_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_private_endpoint_connection" "test" {
+  name                = "${azurerm_private_endpoint.test.name}"
+  resource_group_name = "${azurerm_private_endpoint.test.resource_group_name}"
+}
+`, testAccAzureRMPrivateEndpoint_basic(rInt, location))
+}
@@ -0,0 +1,43 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccDataSourceAzureRMPrivateLinkService_complete(t *testing.T) {
+	dataSourceName := "data.azurerm_private_link_service.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMPrivateLinkService_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "alias"),
+					resource.TestCheckResourceAttr(dataSourceName, "nat_ip_configuration.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "nat_ip_configuration.0.private_ip_address", "10.5.1.17"),
+					resource.TestCheckResourceAttr(dataSourceName, "load_balancer_frontend_ip_configuration_ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMPrivateLinkService_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_private_link_service" "test" {
+  name                = "${azurerm_private_link_service.test.name}"
+  resource_group_name = "${azurerm_private_link_service.test.resource_group_name}"
+}
+`, testAccAzureRMPrivateLinkService_complete(rInt, location))
+}
@@ -10,12 +10,17 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 )
 
 func dataSourceArmPublicIPs() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceArmPublicIPsRead,
 
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
 
@@ -72,7 +77,8 @@ func dataSourceArmPublicIPs() *schema.Resource {
 
 func dataSourceArmPublicIPsRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).network.PublicIPsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resourceGroup := d.Get("resource_group_name").(string)
 
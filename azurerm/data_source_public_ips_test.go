@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 )
@@ -12,7 +11,7 @@ import (
 func TestAccDataSourceAzureRMPublicIPs_namePrefix(t *testing.T) {
 	dataSourceName := "data.azurerm_public_ips.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(5)
+	rs := tf.AccRandString(5)
 	location := testLocation()
 
 	resourceConfig := testAccDataSourceAzureRMPublicIPs_prefix(ri, rs, location)
@@ -42,7 +41,7 @@ func TestAccDataSourceAzureRMPublicIPs_assigned(t *testing.T) {
 	attachedDataSourceName := "data.azurerm_public_ips.attached"
 	unattachedDataSourceName := "data.azurerm_public_ips.unattached"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(5)
+	rs := tf.AccRandString(5)
 	location := testLocation()
 
 	resourceConfig := testAccDataSourceAzureRMPublicIPs_attached(ri, rs, location)
@@ -74,7 +73,7 @@ func TestAccDataSourceAzureRMPublicIPs_allocationType(t *testing.T) {
 	staticDataSourceName := "data.azurerm_public_ips.static"
 	dynamicDataSourceName := "data.azurerm_public_ips.dynamic"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(5)
+	rs := tf.AccRandString(5)
 	location := testLocation()
 
 	resourceConfig := testAccDataSourceAzureRMPublicIPs_allocationType(ri, rs, location)
@@ -3,6 +3,7 @@ package azurerm
 import (
 	"crypto/sha256"
 	"encoding/hex"
+	"time"
 
 	"github.com/hashicorp/go-azure-helpers/storage"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -21,6 +22,10 @@ func dataSourceArmStorageAccountSharedAccessSignature() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceArmStorageAccountSasRead,
 
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"connection_string": {
 				Type:      schema.TypeString,
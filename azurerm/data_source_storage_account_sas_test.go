@@ -5,7 +5,6 @@ import (
 	"testing"
 	"time"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 )
@@ -13,9 +12,9 @@ import (
 func TestAccDataSourceArmStorageAccountSas_basic(t *testing.T) {
 	dataSourceName := "data.azurerm_storage_account_sas.test"
 	rInt := tf.AccRandTimeInt()
-	rString := acctest.RandString(4)
+	rString := tf.AccRandString(4)
 	location := testLocation()
-	utcNow := time.Now().UTC()
+	utcNow := tf.AccTimeNow()
 	startDate := utcNow.Format(time.RFC3339)
 	endDate := utcNow.Add(time.Hour * 24).Format(time.RFC3339)
 
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 )
@@ -12,7 +11,7 @@ import (
 func TestAccDataSourceAzureRMStorageAccount_basic(t *testing.T) {
 	dataSourceName := "data.azurerm_storage_account.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	location := testLocation()
 	preConfig := testAccDataSourceAzureRMStorageAccount_basic(ri, rs, location)
 	config := testAccDataSourceAzureRMStorageAccount_basicWithDataSource(ri, rs, location)
@@ -41,7 +40,7 @@ func TestAccDataSourceAzureRMStorageAccount_basic(t *testing.T) {
 func TestAccDataSourceAzureRMStorageAccount_withWriteLock(t *testing.T) {
 	dataSourceName := "data.azurerm_storage_account.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -2,15 +2,21 @@ package azurerm
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func dataSourceArmSubscription() *schema.Resource {
 	return &schema.Resource{
-		Read:   dataSourceArmSubscriptionRead,
+		Read: dataSourceArmSubscriptionRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: azure.SchemaSubscription(true),
 	}
 }
@@ -18,7 +24,8 @@ func dataSourceArmSubscription() *schema.Resource {
 func dataSourceArmSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
 	groupClient := client.Subscription.Client
-	ctx := client.StopContext
+	ctx, cancel := timeouts.ForRead(client.StopContext, d)
+	defer cancel()
 
 	subscriptionId := d.Get("subscription_id").(string)
 	if subscriptionId == "" {
@@ -3,15 +3,21 @@ package azurerm
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 )
 
 func dataSourceArmSubscriptions() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceArmSubscriptionsRead,
 
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"display_name_prefix": {
 				Type:     schema.TypeString,
@@ -35,7 +41,8 @@ func dataSourceArmSubscriptions() *schema.Resource {
 func dataSourceArmSubscriptionsRead(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
 	subClient := armClient.Subscription.Client
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForRead(armClient.StopContext, d)
+	defer cancel()
 
 	displayNamePrefix := strings.ToLower(d.Get("display_name_prefix").(string))
 	displayNameContains := strings.ToLower(d.Get("display_name_contains").(string))
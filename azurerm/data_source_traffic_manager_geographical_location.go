@@ -3,14 +3,20 @@ package azurerm
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/trafficmanager/mgmt/2018-04-01/trafficmanager"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 )
 
 func dataSourceArmTrafficManagerGeographicalLocation() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceArmTrafficManagerGeographicalLocationRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -22,7 +28,8 @@ func dataSourceArmTrafficManagerGeographicalLocation() *schema.Resource {
 
 func dataSourceArmTrafficManagerGeographicalLocationRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).TrafficManager.GeographialHierarchiesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	results, err := client.GetDefault(ctx)
 	if err != nil {
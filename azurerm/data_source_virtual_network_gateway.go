@@ -2,12 +2,14 @@ package azurerm
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -15,6 +17,10 @@ func dataSourceArmVirtualNetworkGateway() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceArmVirtualNetworkGatewayRead,
 
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -184,7 +190,8 @@ func dataSourceArmVirtualNetworkGateway() *schema.Resource {
 
 func dataSourceArmVirtualNetworkGatewayRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).network.VnetGatewayClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resGroup := d.Get("resource_group_name").(string)
@@ -258,13 +265,13 @@ func flattenArmVirtualNetworkGatewayDataSourceIPConfigurations(ipConfigs *[]netw
 
 			if subnet := props.Subnet; subnet != nil {
 				if id := subnet.ID; id != nil {
-					v["subnet_id"] = *id
+					v["subnet_id"] = azure.NormalizeResourceID(*id)
 				}
 			}
 
 			if pip := props.PublicIPAddress; pip != nil {
 				if id := pip.ID; id != nil {
-					v["public_ip_address_id"] = *id
+					v["public_ip_address_id"] = azure.NormalizeResourceID(*id)
 				}
 			}
 
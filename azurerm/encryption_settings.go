@@ -3,6 +3,7 @@ package azurerm
 import (
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -34,8 +35,9 @@ func encryptionSettingsSchema() *schema.Schema {
 							},
 
 							"source_vault_id": {
-								Type:     schema.TypeString,
-								Required: true,
+								Type:             schema.TypeString,
+								Required:         true,
+								DiffSuppressFunc: suppress.ResourceIDDifference,
 							},
 						},
 					},
@@ -52,8 +54,9 @@ func encryptionSettingsSchema() *schema.Schema {
 							},
 
 							"source_vault_id": {
-								Type:     schema.TypeString,
-								Required: true,
+								Type:             schema.TypeString,
+								Required:         true,
+								DiffSuppressFunc: suppress.ResourceIDDifference,
 							},
 						},
 					},
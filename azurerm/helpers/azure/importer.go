@@ -0,0 +1,33 @@
+package azure
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// ValidateResourceIDPriorToImport returns an Importer which validates the Resource ID being
+// imported using the specified ValidateFunc, prior to passing it through into the State
+func ValidateResourceIDPriorToImport(validateFunc schema.SchemaValidateFunc) *schema.ResourceImporter {
+	return ValidateResourceIDPriorToImportThen(validateFunc, schema.ImportStatePassthrough)
+}
+
+// ValidateResourceIDPriorToImportThen returns an Importer which validates the Resource ID being
+// imported using the specified ValidateFunc, prior to calling the specified Importer
+func ValidateResourceIDPriorToImportThen(validateFunc schema.SchemaValidateFunc, importer schema.StateFunc) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			if _, errs := validateFunc(d.Id(), "id"); len(errs) > 0 {
+				messages := make([]string, 0, len(errs))
+				for _, err := range errs {
+					messages = append(messages, err.Error())
+				}
+
+				return nil, fmt.Errorf("Error validating the ID %q prior to import: %s", d.Id(), strings.Join(messages, "\n"))
+			}
+
+			return importer(d, meta)
+		},
+	}
+}
@@ -1,12 +1,20 @@
 package azure
 
 import (
+	"context"
 	"fmt"
+	"log"
+	"net/http"
 	"net/url"
 	"regexp"
 	"strings"
+	"time"
 
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 type KeyVaultChildID struct {
@@ -72,3 +80,119 @@ func ValidateKeyVaultChildId(i interface{}, k string) (warnings []string, errors
 
 	return warnings, errors
 }
+
+// KeyVaultChildIsDeletedButRecoverable returns whether the specified error was returned because a soft-deleted
+// object (e.g. a Secret) with the same name exists within the Key Vault
+func KeyVaultChildIsDeletedButRecoverable(resp autorest.Response, err error) bool {
+	if err == nil || resp.Response == nil || resp.StatusCode != http.StatusConflict {
+		return false
+	}
+
+	if detailed, ok := err.(autorest.DetailedError); ok {
+		if requestErr, ok := detailed.Original.(*azure.RequestError); ok && requestErr.ServiceError != nil {
+			if code, ok := requestErr.ServiceError.InnerError["code"].(string); ok {
+				return strings.EqualFold(code, "ObjectIsDeletedButRecoverable")
+			}
+		}
+	}
+
+	return strings.Contains(err.Error(), "ObjectIsDeletedButRecoverable")
+}
+
+// KeyVaultChildSoftDeletedError returns the error used when a soft-deleted object with the same name exists
+// within the Key Vault, but recovering soft-deleted objects hasn't been enabled in the Provider configuration
+func KeyVaultChildSoftDeletedError(objectType, name, keyVaultBaseUrl string) error {
+	return fmt.Errorf(`A soft-deleted %s with the Name %q exists in the Key Vault %q.
+
+This %s either needs to be recovered or purged before a new %s with this name can be created - alternatively
+it can be recovered automatically by setting "recover_soft_deleted_key_vault_objects" to "true" in the "key_vault"
+block within the "features" block in the Provider configuration.`, objectType, name, keyVaultBaseUrl, objectType, objectType)
+}
+
+// RecoverKeyVaultChild recovers a soft-deleted Key Vault object (e.g. a Secret) and then waits for it to become
+// available, since the Key Vault data-plane is eventually consistent
+func RecoverKeyVaultChild(ctx context.Context, objectType, name, keyVaultBaseUrl string, recover func() error, get func() (autorest.Response, error)) error {
+	log.Printf("[DEBUG] Recovering the soft-deleted %s %q (Key Vault %q)", objectType, name, keyVaultBaseUrl)
+	if err := recover(); err != nil {
+		return fmt.Errorf("Error recovering the soft-deleted %s %q (Key Vault %q): %+v", objectType, name, keyVaultBaseUrl, err)
+	}
+
+	log.Printf("[DEBUG] Waiting for the recovered %s %q (Key Vault %q) to become available", objectType, name, keyVaultBaseUrl)
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{"pending"},
+		Target:                    []string{"available"},
+		Refresh:                   keyVaultChildRefreshFunc(get, "available", "pending"),
+		Timeout:                   keyVaultChildTimeout(ctx),
+		Delay:                     5 * time.Second,
+		MinTimeout:                5 * time.Second,
+		ContinuousTargetOccurence: 3,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for the recovered %s %q (Key Vault %q) to become available: %+v", objectType, name, keyVaultBaseUrl, err)
+	}
+
+	return nil
+}
+
+// PurgeKeyVaultChild waits for a deleted Key Vault object (e.g. a Secret) to become soft-deleted, purges it
+// and then waits for the purge to complete - such that an object with the same name can be created again
+func PurgeKeyVaultChild(ctx context.Context, objectType, name, keyVaultBaseUrl string, getDeleted func() (autorest.Response, error), purge func() (autorest.Response, error)) error {
+	log.Printf("[DEBUG] Waiting for %s %q (Key Vault %q) to become soft-deleted", objectType, name, keyVaultBaseUrl)
+	deletedConf := &resource.StateChangeConf{
+		Pending:                   []string{"pending"},
+		Target:                    []string{"deleted"},
+		Refresh:                   keyVaultChildRefreshFunc(getDeleted, "deleted", "pending"),
+		Timeout:                   keyVaultChildTimeout(ctx),
+		MinTimeout:                5 * time.Second,
+		ContinuousTargetOccurence: 3,
+	}
+	if _, err := deletedConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for %s %q (Key Vault %q) to become soft-deleted: %+v", objectType, name, keyVaultBaseUrl, err)
+	}
+
+	log.Printf("[DEBUG] Purging the soft-deleted %s %q (Key Vault %q)", objectType, name, keyVaultBaseUrl)
+	if resp, err := purge(); err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error purging the soft-deleted %s %q (Key Vault %q): %+v", objectType, name, keyVaultBaseUrl, err)
+		}
+	}
+
+	log.Printf("[DEBUG] Waiting for the soft-deleted %s %q (Key Vault %q) to be purged", objectType, name, keyVaultBaseUrl)
+	purgedConf := &resource.StateChangeConf{
+		Pending:                   []string{"pending"},
+		Target:                    []string{"purged"},
+		Refresh:                   keyVaultChildRefreshFunc(getDeleted, "pending", "purged"),
+		Timeout:                   keyVaultChildTimeout(ctx),
+		MinTimeout:                5 * time.Second,
+		ContinuousTargetOccurence: 3,
+	}
+	if _, err := purgedConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for the soft-deleted %s %q (Key Vault %q) to be purged: %+v", objectType, name, keyVaultBaseUrl, err)
+	}
+
+	return nil
+}
+
+func keyVaultChildRefreshFunc(get func() (autorest.Response, error), foundState string, notFoundState string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := get()
+		if err != nil {
+			if utils.ResponseWasNotFound(resp) {
+				return resp, notFoundState, nil
+			}
+
+			return nil, "", err
+		}
+
+		return resp, foundState, nil
+	}
+}
+
+func keyVaultChildTimeout(ctx context.Context) time.Duration {
+	if deadline, ok := ctx.Deadline(); ok {
+		return time.Until(deadline)
+	}
+
+	return 30 * time.Minute
+}
@@ -1,6 +1,12 @@
 package azure
 
-import "testing"
+import (
+	"net/http"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
 
 func TestAccAzureRMValidateKeyVaultChildID(t *testing.T) {
 	cases := []struct {
@@ -183,3 +189,57 @@ func TestAccAzureRMKeyVaultChild_validateName(t *testing.T) {
 		}
 	}
 }
+
+func TestKeyVaultChildIsDeletedButRecoverable(t *testing.T) {
+	recoverable := &azure.RequestError{
+		ServiceError: &azure.ServiceError{
+			Code: "Conflict",
+			InnerError: map[string]interface{}{
+				"code": "ObjectIsDeletedButRecoverable",
+			},
+		},
+	}
+
+	cases := []struct {
+		StatusCode int
+		Error      error
+		Expected   bool
+	}{
+		{
+			StatusCode: http.StatusOK,
+			Error:      nil,
+			Expected:   false,
+		},
+		{
+			StatusCode: http.StatusConflict,
+			Error:      autorest.NewErrorWithError(recoverable, "keyvault.BaseClient", "SetSecret", nil, "Failure responding to request"),
+			Expected:   true,
+		},
+		{
+			StatusCode: http.StatusConflict,
+			Error: autorest.NewErrorWithError(&azure.RequestError{
+				ServiceError: &azure.ServiceError{
+					Code: "Conflict",
+				},
+			}, "keyvault.BaseClient", "SetSecret", nil, "Failure responding to request"),
+			Expected: false,
+		},
+		{
+			StatusCode: http.StatusBadRequest,
+			Error:      autorest.NewErrorWithError(recoverable, "keyvault.BaseClient", "SetSecret", nil, "Failure responding to request"),
+			Expected:   false,
+		},
+	}
+
+	for _, tc := range cases {
+		resp := autorest.Response{
+			Response: &http.Response{
+				StatusCode: tc.StatusCode,
+			},
+		}
+
+		if actual := KeyVaultChildIsDeletedButRecoverable(resp, tc.Error); actual != tc.Expected {
+			t.Fatalf("Expected %t for a %d (%+v) but got %t", tc.Expected, tc.StatusCode, tc.Error, actual)
+		}
+	}
+}
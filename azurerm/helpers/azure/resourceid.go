@@ -3,6 +3,7 @@ package azure
 import (
 	"fmt"
 	"net/url"
+	"sort"
 	"strings"
 )
 
@@ -92,3 +93,82 @@ func ParseAzureResourceID(id string) (*ResourceID, error) {
 
 	return idObj, nil
 }
+
+// PopSegment retrieves the value of the segment with the specified key from the Path and
+// then removes it from the Path, so that any unexpected segments can be detected by
+// ValidateNoRemainingSegments.
+//
+// Since Azure doesn't consistently case the keys within Resource ID's (e.g. `resourceGroups`
+// vs `resourcegroups`) this falls back to a case-insensitive match when no exact match exists.
+func (id *ResourceID) PopSegment(key string) (string, error) {
+	value, ok := id.Path[key]
+	if !ok {
+		for k, v := range id.Path {
+			if strings.EqualFold(k, key) {
+				key = k
+				value = v
+				ok = true
+				break
+			}
+		}
+	}
+
+	if !ok || value == "" {
+		return "", fmt.Errorf("ID was missing the `%s` element", key)
+	}
+
+	delete(id.Path, key)
+	return value, nil
+}
+
+// ValidateNoRemainingSegments validates that all of the segments within the Path have been
+// consumed (via PopSegment) - to ensure that the ID doesn't contain any unexpected segments.
+func (id *ResourceID) ValidateNoRemainingSegments(input string) error {
+	if len(id.Path) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(id.Path))
+	for k := range id.Path {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return fmt.Errorf("ID %q contained unexpected segments: %s", input, strings.Join(keys, ", "))
+}
+
+// NormalizeResourceID returns the specified Resource ID with the casing of the well-known
+// `subscriptions`, `resourceGroups` and `providers` segments normalised and any trailing
+// slash removed - since Azure frequently returns these with different casing to that
+// which was specified, which otherwise causes spurious diffs.
+//
+// Values which can't be parsed as a Resource ID are returned unchanged.
+func NormalizeResourceID(input string) string {
+	if !strings.HasPrefix(input, "/") {
+		return input
+	}
+
+	components := strings.Split(strings.Trim(input, "/"), "/")
+	if len(components)%2 != 0 || !strings.EqualFold(components[0], "subscriptions") {
+		return input
+	}
+
+	for i := 0; i < len(components); i += 2 {
+		switch strings.ToLower(components[i]) {
+		case "subscriptions":
+			components[i] = "subscriptions"
+		case "resourcegroups":
+			components[i] = "resourceGroups"
+		case "providers":
+			components[i] = "providers"
+		}
+	}
+
+	return "/" + strings.Join(components, "/")
+}
+
+// ResourceIDsAreEquivalent returns whether the two specified Resource ID's refer to the
+// same resource, ignoring differences in casing and any trailing slash.
+func ResourceIDsAreEquivalent(first, second string) bool {
+	return strings.EqualFold(NormalizeResourceID(first), NormalizeResourceID(second))
+}
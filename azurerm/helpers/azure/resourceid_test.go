@@ -175,3 +175,139 @@ func TestParseAzureResourceID(t *testing.T) {
 		}
 	}
 }
+
+func TestResourceIDPopSegment(t *testing.T) {
+	testCases := []struct {
+		id            string
+		key           string
+		expectedValue string
+		expectError   bool
+	}{
+		{
+			// exact match
+			"/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			"virtualNetworks",
+			"network1",
+			false,
+		},
+		{
+			// differing case
+			"/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualnetworks/network1",
+			"virtualNetworks",
+			"network1",
+			false,
+		},
+		{
+			// missing
+			"/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/routeTables/table1",
+			"virtualNetworks",
+			"",
+			true,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Logf("[DEBUG] Testing %q", test.id)
+		parsed, err := ParseAzureResourceID(test.id)
+		if err != nil {
+			t.Fatalf("Unexpected error parsing: %s", err)
+		}
+
+		value, err := parsed.PopSegment(test.key)
+		if err != nil {
+			if test.expectError {
+				continue
+			}
+
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if test.expectError {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if value != test.expectedValue {
+			t.Fatalf("Expected %q but got %q", test.expectedValue, value)
+		}
+
+		if err := parsed.ValidateNoRemainingSegments(test.id); err != nil {
+			t.Fatalf("Expected no remaining segments but got: %s", err)
+		}
+	}
+}
+
+func TestResourceIDValidateNoRemainingSegments(t *testing.T) {
+	id := "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1"
+	parsed, err := ParseAzureResourceID(id)
+	if err != nil {
+		t.Fatalf("Unexpected error parsing: %s", err)
+	}
+
+	if _, err := parsed.PopSegment("virtualNetworks"); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if err := parsed.ValidateNoRemainingSegments(id); err == nil {
+		t.Fatalf("Expected an error since `subnets` hasn't been consumed but didn't get one")
+	}
+}
+
+func TestNormalizeResourceID(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected string
+	}{
+		{
+			// not a resource id
+			input:    "hello-world",
+			expected: "hello-world",
+		},
+		{
+			input:    "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1",
+			expected: "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1",
+		},
+		{
+			input:    "/subscriptions/11111111-1111-1111-1111-111111111111/resourcegroups/group1/",
+			expected: "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1",
+		},
+		{
+			input:    "/Subscriptions/11111111-1111-1111-1111-111111111111/ResourceGroups/Group1/Providers/Microsoft.network/virtualNetworks/network1",
+			expected: "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/Group1/providers/Microsoft.network/virtualNetworks/network1",
+		},
+	}
+
+	for _, test := range testData {
+		t.Logf("[DEBUG] Testing %q", test.input)
+
+		actual := NormalizeResourceID(test.input)
+		if actual != test.expected {
+			t.Fatalf("Expected %q but got %q", test.expected, actual)
+		}
+	}
+}
+
+func TestResourceIDsAreEquivalent(t *testing.T) {
+	testData := []struct {
+		first    string
+		second   string
+		expected bool
+	}{
+		{
+			first:    "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			second:   "/subscriptions/11111111-1111-1111-1111-111111111111/resourcegroups/group1/providers/Microsoft.network/virtualNetworks/network1/",
+			expected: true,
+		},
+		{
+			first:    "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			second:   "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network2",
+			expected: false,
+		},
+	}
+
+	for _, test := range testData {
+		t.Logf("[DEBUG] Testing %q / %q", test.first, test.second)
+
+		if actual := ResourceIDsAreEquivalent(test.first, test.second); actual != test.expected {
+			t.Fatalf("Expected %t but got %t", test.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,14 @@
+package suppress
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// ResourceIDDifference suppresses the diff between two Resource ID's which differ only in
+// casing (e.g. `resourcegroups` vs `resourceGroups`) or by a trailing slash, both of which
+// Azure returns inconsistently
+func ResourceIDDifference(_, old, new string, _ *schema.ResourceData) bool {
+	return strings.EqualFold(strings.TrimSuffix(old, "/"), strings.TrimSuffix(new, "/"))
+}
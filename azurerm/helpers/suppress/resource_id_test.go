@@ -0,0 +1,57 @@
+package suppress
+
+import "testing"
+
+func TestResourceIDDifference(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Old      string
+		New      string
+		Suppress bool
+	}{
+		{
+			Name:     "empty",
+			Old:      "",
+			New:      "",
+			Suppress: true,
+		},
+		{
+			Name:     "empty vs id",
+			Old:      "",
+			New:      "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1",
+			Suppress: false,
+		},
+		{
+			Name:     "same id",
+			Old:      "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1",
+			New:      "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1",
+			Suppress: true,
+		},
+		{
+			Name:     "different resource group casing",
+			Old:      "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			New:      "/subscriptions/11111111-1111-1111-1111-111111111111/resourcegroups/GROUP1/providers/Microsoft.network/virtualNetworks/network1",
+			Suppress: true,
+		},
+		{
+			Name:     "trailing slash",
+			Old:      "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/",
+			New:      "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1",
+			Suppress: true,
+		},
+		{
+			Name:     "different resource",
+			Old:      "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			New:      "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network2",
+			Suppress: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			if ResourceIDDifference("test", tc.Old, tc.New, nil) != tc.Suppress {
+				t.Fatalf("Expected ResourceIDDifference to return %t for '%q' == '%q'", tc.Suppress, tc.Old, tc.New)
+			}
+		})
+	}
+}
@@ -6,8 +6,12 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
 )
 
+// NOTE: the random values generated for a test are stored in its recording (when recording),
+// so that the same resource names are used when the test is replayed
+
 func AccRandTimeInt() int {
 	// acctest.RantInt() returns a value of size:
 	// 000000000000000000
@@ -15,13 +19,59 @@ func AccRandTimeInt() int {
 
 	//go format: 2006-01-02 15:04:05.00
 
-	timeStr := strings.Replace(time.Now().Local().Format("060102150405.00"), ".", "", 1) //no way to not have a .?
-	postfix := acctest.RandStringFromCharSet(4, "0123456789")
+	value := common.RecordedValue("AccRandTimeInt", func() string {
+		timeStr := strings.Replace(time.Now().Local().Format("060102150405.00"), ".", "", 1) //no way to not have a .?
+		postfix := acctest.RandStringFromCharSet(4, "0123456789")
+		return timeStr + postfix
+	})
+
+	i, err := strconv.Atoi(value)
+	if err != nil {
+		panic(err)
+	}
+
+	return i
+}
+
+// AccRandString returns a random lower-case alphabetic string of the specified length
+func AccRandString(strlen int) string {
+	return common.RecordedValue("AccRandString", func() string {
+		return acctest.RandString(strlen)
+	})
+}
+
+// AccRandStringFromCharSet returns a random string of the specified length using the specified characters
+func AccRandStringFromCharSet(strlen int, charSet string) string {
+	return common.RecordedValue("AccRandStringFromCharSet", func() string {
+		return acctest.RandStringFromCharSet(strlen, charSet)
+	})
+}
+
+// AccRandIntRange returns a random integer between min (inclusive) and max (exclusive)
+func AccRandIntRange(min int, max int) int {
+	value := common.RecordedValue("AccRandIntRange", func() string {
+		return strconv.Itoa(acctest.RandIntRange(min, max))
+	})
 
-	i, err := strconv.Atoi(timeStr + postfix)
+	i, err := strconv.Atoi(value)
 	if err != nil {
 		panic(err)
 	}
 
 	return i
 }
+
+// AccTimeNow returns the current time in UTC, which (like the random values) is stored in the recording for
+// the calling test so that configurations based on the current time are identical when the test is replayed
+func AccTimeNow() time.Time {
+	value := common.RecordedValue("AccTimeNow", func() string {
+		return time.Now().UTC().Format(time.RFC3339Nano)
+	})
+
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		panic(err)
+	}
+
+	return t
+}
@@ -0,0 +1,53 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBastionHostName(t *testing.T) {
+	cases := []struct {
+		Value  string
+		Errors int
+	}{
+		{
+			Value:  "",
+			Errors: 1,
+		},
+		{
+			Value:  "a",
+			Errors: 0,
+		},
+		{
+			Value:  "bastion-host_1.2",
+			Errors: 0,
+		},
+		{
+			Value:  "bastion.",
+			Errors: 1,
+		},
+		{
+			Value:  "-bastion",
+			Errors: 1,
+		},
+		{
+			Value:  "bastion host",
+			Errors: 1,
+		},
+		{
+			Value:  strings.Repeat("a", 80),
+			Errors: 0,
+		},
+		{
+			Value:  strings.Repeat("a", 81),
+			Errors: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := BastionHostName(tc.Value, "name")
+		if len(errors) != tc.Errors {
+			t.Fatalf("Expected BastionHostName to return %d error(s) for %q but got %d", tc.Errors, tc.Value, len(errors))
+		}
+	}
+}
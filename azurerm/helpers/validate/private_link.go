@@ -0,0 +1,23 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// PrivateLinkName validates the name of a Private Endpoint, Private Link Service or one of their child resources
+func PrivateLinkName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	// The name must begin with a letter or number, end with a letter, number or underscore, and may contain
+	// only letters, numbers, underscores, periods, or hyphens - and be between 1 and 80 characters long
+	if !regexp.MustCompile(`^([a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9_.-]{0,78}[a-zA-Z0-9_])$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 80 characters, begin with a letter or number, end with a letter, number or underscore, and may contain only letters, numbers, underscores, periods, or hyphens, got %q", k, v))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,57 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPrivateLinkName(t *testing.T) {
+	cases := []struct {
+		Value  string
+		Errors int
+	}{
+		{
+			Value:  "",
+			Errors: 1,
+		},
+		{
+			Value:  "a",
+			Errors: 0,
+		},
+		{
+			Value:  "hello-world_1.2",
+			Errors: 0,
+		},
+		{
+			Value:  "hello_",
+			Errors: 0,
+		},
+		{
+			Value:  "hello-",
+			Errors: 1,
+		},
+		{
+			Value:  "_hello",
+			Errors: 1,
+		},
+		{
+			Value:  "hello!world",
+			Errors: 1,
+		},
+		{
+			Value:  strings.Repeat("a", 80),
+			Errors: 0,
+		},
+		{
+			Value:  strings.Repeat("a", 81),
+			Errors: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := PrivateLinkName(tc.Value, "name")
+		if len(errors) != tc.Errors {
+			t.Fatalf("Expected PrivateLinkName to return %d error(s) for %q but got %d", tc.Errors, tc.Value, len(errors))
+		}
+	}
+}
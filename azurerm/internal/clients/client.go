@@ -2,11 +2,33 @@ package clients
 
 import (
 	"context"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 )
 
 type Client struct {
 	// StopContext is used for propagating control from Terraform Core (e.g. Ctrl/Cmd+C)
 	StopContext context.Context
 
-	Compute ComputeClient
+	// Features contains the behaviours which have been opted into via the `features` block
+	Features features.UserFeatures
+
+	// ReadCache is used to deduplicate identical reads during a run, when opted into via `enable_read_cache`
+	ReadCache *common.ReadCache
+
+	Compute *ComputeClient
+}
+
+// Base returns this Client - which is embedded within the Provider's `meta` object, allowing
+// it to be retrieved via FromMeta
+func (client *Client) Base() *Client {
+	return client
+}
+
+// FromMeta returns the Client from the `meta` object passed to Data Sources and Resources,
+// which allows those defined within a Service Package to access the SDK Clients without
+// importing the `azurerm` package
+func FromMeta(meta interface{}) *Client {
+	return meta.(interface{ Base() *Client }).Base()
 }
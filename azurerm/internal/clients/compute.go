@@ -7,27 +7,37 @@ import (
 )
 
 type ComputeClient struct {
-	AvailabilitySetsClient         *compute.AvailabilitySetsClient
-	DisksClient                    *compute.DisksClient
-	GalleriesClient                *compute.GalleriesClient
-	GalleryImagesClient            *compute.GalleryImagesClient
-	GalleryImageVersionsClient     *compute.GalleryImageVersionsClient
-	ProximityPlacementGroupsClient *compute.ProximityPlacementGroupsClient
-	MarketplaceAgreementsClient    *marketplaceordering.MarketplaceAgreementsClient
-	ImagesClient                   *compute.ImagesClient
-	SnapshotsClient                *compute.SnapshotsClient
-	UsageClient                    *compute.UsageClient
-	VMExtensionImageClient         *compute.VirtualMachineExtensionImagesClient
-	VMExtensionClient              *compute.VirtualMachineExtensionsClient
-	VMScaleSetClient               *compute.VirtualMachineScaleSetsClient
-	VMClient                       *compute.VirtualMachinesClient
-	VMImageClient                  *compute.VirtualMachineImagesClient
+	AvailabilitySetsClient          *compute.AvailabilitySetsClient
+	DedicatedHostsClient            *compute.DedicatedHostsClient
+	DedicatedHostGroupsClient       *compute.DedicatedHostGroupsClient
+	DisksClient                     *compute.DisksClient
+	GalleriesClient                 *compute.GalleriesClient
+	GalleryImagesClient             *compute.GalleryImagesClient
+	GalleryImageVersionsClient      *compute.GalleryImageVersionsClient
+	ProximityPlacementGroupsClient  *compute.ProximityPlacementGroupsClient
+	MarketplaceAgreementsClient     *marketplaceordering.MarketplaceAgreementsClient
+	ImagesClient                    *compute.ImagesClient
+	SnapshotsClient                 *compute.SnapshotsClient
+	UsageClient                     *compute.UsageClient
+	VMExtensionImageClient          *compute.VirtualMachineExtensionImagesClient
+	VMExtensionClient               *compute.VirtualMachineExtensionsClient
+	VMScaleSetClient                *compute.VirtualMachineScaleSetsClient
+	VMScaleSetRollingUpgradesClient *compute.VirtualMachineScaleSetRollingUpgradesClient
+	VMScaleSetVMsClient             *compute.VirtualMachineScaleSetVMsClient
+	VMClient                        *compute.VirtualMachinesClient
+	VMImageClient                   *compute.VirtualMachineImagesClient
 }
 
 func NewComputeClient(o *common.ClientOptions) *ComputeClient {
 	availabilitySetsClient := compute.NewAvailabilitySetsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&availabilitySetsClient.Client, o.ResourceManagerAuthorizer)
 
+	dedicatedHostsClient := compute.NewDedicatedHostsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&dedicatedHostsClient.Client, o.ResourceManagerAuthorizer)
+
+	dedicatedHostGroupsClient := compute.NewDedicatedHostGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&dedicatedHostGroupsClient.Client, o.ResourceManagerAuthorizer)
+
 	disksClient := compute.NewDisksClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&disksClient.Client, o.ResourceManagerAuthorizer)
 
@@ -67,24 +77,34 @@ func NewComputeClient(o *common.ClientOptions) *ComputeClient {
 	vmScaleSetClient := compute.NewVirtualMachineScaleSetsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&vmScaleSetClient.Client, o.ResourceManagerAuthorizer)
 
+	vmScaleSetRollingUpgradesClient := compute.NewVirtualMachineScaleSetRollingUpgradesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&vmScaleSetRollingUpgradesClient.Client, o.ResourceManagerAuthorizer)
+
+	vmScaleSetVMsClient := compute.NewVirtualMachineScaleSetVMsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&vmScaleSetVMsClient.Client, o.ResourceManagerAuthorizer)
+
 	vmClient := compute.NewVirtualMachinesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&vmClient.Client, o.ResourceManagerAuthorizer)
 
 	return &ComputeClient{
-		AvailabilitySetsClient:         &availabilitySetsClient,
-		DisksClient:                    &disksClient,
-		GalleriesClient:                &galleriesClient,
-		GalleryImagesClient:            &galleryImagesClient,
-		GalleryImageVersionsClient:     &galleryImageVersionsClient,
-		ImagesClient:                   &imagesClient,
-		MarketplaceAgreementsClient:    &marketplaceAgreementsClient,
-		ProximityPlacementGroupsClient: &proximityPlacementGroupsClient,
-		SnapshotsClient:                &snapshotsClient,
-		UsageClient:                    &usageClient,
-		VMExtensionImageClient:         &vmExtensionImageClient,
-		VMExtensionClient:              &vmExtensionClient,
-		VMScaleSetClient:               &vmScaleSetClient,
-		VMClient:                       &vmClient,
-		VMImageClient:                  &vmImageClient,
+		AvailabilitySetsClient:          &availabilitySetsClient,
+		DedicatedHostsClient:            &dedicatedHostsClient,
+		DedicatedHostGroupsClient:       &dedicatedHostGroupsClient,
+		DisksClient:                     &disksClient,
+		GalleriesClient:                 &galleriesClient,
+		GalleryImagesClient:             &galleryImagesClient,
+		GalleryImageVersionsClient:      &galleryImageVersionsClient,
+		ImagesClient:                    &imagesClient,
+		MarketplaceAgreementsClient:     &marketplaceAgreementsClient,
+		ProximityPlacementGroupsClient:  &proximityPlacementGroupsClient,
+		SnapshotsClient:                 &snapshotsClient,
+		UsageClient:                     &usageClient,
+		VMExtensionImageClient:          &vmExtensionImageClient,
+		VMExtensionClient:               &vmExtensionClient,
+		VMScaleSetClient:                &vmScaleSetClient,
+		VMScaleSetRollingUpgradesClient: &vmScaleSetRollingUpgradesClient,
+		VMScaleSetVMsClient:             &vmScaleSetVMsClient,
+		VMClient:                        &vmClient,
+		VMImageClient:                   &vmImageClient,
 	}
 }
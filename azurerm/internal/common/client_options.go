@@ -30,6 +30,12 @@ type ClientOptions struct {
 	SkipProviderReg             bool
 	DisableCorrelationRequestID bool
 	Environment                 azure.Environment
+
+	// HTTPTracer (when set) emits a redacted trace record for each request made by the clients
+	HTTPTracer *HTTPTracer
+
+	// ReadCache (when set) deduplicates identical GET requests made whilst reading resources
+	ReadCache *ReadCache
 }
 
 func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.Authorizer) {
@@ -41,9 +47,37 @@ func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.A
 	c.Sender = sender.BuildSender("AzureRM")
 	c.PollingDuration = o.PollingDuration
 	c.SkipResourceProviderRegistration = o.SkipProviderReg
+
 	if !o.DisableCorrelationRequestID {
 		c.RequestInspector = WithCorrelationRequestID(CorrelationRequestID())
 	}
+
+	if o.HTTPTracer != nil {
+		// NOTE: this decorates the Sender (rather than inspecting the Response) so that each retry of a
+		// request and each poll of a long-running operation is traced - and since the RequestInspector is
+		// applied before the Sender is invoked, the headers set by it are also traced
+		c.Sender = autorest.DecorateSender(o.HTTPTracer.BuildSender(), o.HTTPTracer.WithTracing())
+	}
+
+	switch CurrentRecordingMode() {
+	case RecordingModeRecord:
+		c.Sender = autorest.DecorateSender(c.Sender, WithRecording(), armThrottling.WithPacing())
+
+	case RecordingModeReplay:
+		// nothing is sent to Azure when replaying, so there's no need to pace requests or wait between them
+		c.Sender = autorest.DecorateSender(c.Sender, WithRecording())
+		c.PollingDelay = 0
+		c.RetryDuration = 0
+
+	default:
+		// pace requests based on the rate limits reported by ARM, to avoid (and honour) throttling
+		c.Sender = autorest.DecorateSender(c.Sender, armThrottling.WithPacing())
+	}
+
+	if o.ReadCache != nil {
+		// NOTE: this wraps the pacing, so that requests served from the cache aren't delayed
+		c.Sender = autorest.DecorateSender(c.Sender, o.ReadCache.WithCaching())
+	}
 }
 
 func setUserAgent(client *autorest.Client, partnerID string) {
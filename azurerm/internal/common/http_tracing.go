@@ -0,0 +1,379 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+const (
+	// HeaderARMRequestID is the header returned by Azure Resource Manager containing the unique ID of a request
+	HeaderARMRequestID = "x-ms-request-id"
+
+	// maxTracedBodyLength is the maximum number of bytes of a request/response body included in a trace record
+	maxTracedBodyLength = 64 * 1024
+
+	redactedValue = "REDACTED"
+)
+
+// redactedHeaders are the (canonicalised) names of the HTTP headers whose values are never traced
+var redactedHeaders = map[string]struct{}{
+	"Authorization":                {},
+	"Cookie":                       {},
+	"Ocp-Apim-Subscription-Key":    {},
+	"Set-Cookie":                   {},
+	"X-Ms-Authorization-Auxiliary": {},
+}
+
+// redactedQueryParameters are the names of the query string parameters whose values are never traced,
+// which includes the signature of a SAS Token and the Function/Logic App access keys
+var redactedQueryParameters = map[string]struct{}{
+	"client_secret": {},
+	"code":          {},
+	"sig":           {},
+}
+
+// redactedFormFields are the names of form fields (used when obtaining tokens) whose values are never traced
+var redactedFormFields = map[string]struct{}{
+	"assertion":        {},
+	"client_assertion": {},
+	"client_secret":    {},
+	"password":         {},
+	"refresh_token":    {},
+}
+
+// secretBodyField matches the names of JSON fields which contain (or are likely to contain) secrets,
+// for example `adminPassword`, `primaryKey`, `key1`, `primaryConnectionString` and `access_token`
+var secretBodyField = regexp.MustCompile(`(?i)(password|secret|connectionstring|token|key[0-9]*|keys)$`)
+
+// keyVaultSecretBodyFields are the names of the JSON fields within requests to (and responses from) the Key Vault
+// data plane which contain secrets, which are too generic to redact for other APIs. This includes the `value` of
+// a Secret (and the PFX when importing a Certificate), the `pwd` for that PFX and the private members of a JSON Web Key
+var keyVaultSecretBodyFields = map[string]struct{}{
+	"d":       {},
+	"dp":      {},
+	"dq":      {},
+	"k":       {},
+	"key_hsm": {},
+	"p":       {},
+	"pwd":     {},
+	"q":       {},
+	"qi":      {},
+	"value":   {},
+}
+
+// keyVaultDNSSuffixes are the DNS suffixes of the Key Vault data plane in each of the Azure Clouds
+var keyVaultDNSSuffixes = []string{
+	azure.PublicCloud.KeyVaultDNSSuffix,
+	azure.ChinaCloud.KeyVaultDNSSuffix,
+	azure.GermanCloud.KeyVaultDNSSuffix,
+	azure.USGovernmentCloud.KeyVaultDNSSuffix,
+}
+
+// isSecretBodyField returns whether the JSON field with the specified name contains (or is likely to contain)
+// a secret, within a request sent to (or response received from) the specified host
+func isSecretBodyField(host string, key string) bool {
+	if secretBodyField.MatchString(key) {
+		return true
+	}
+
+	if isKeyVaultDataPlaneHost(host) {
+		_, ok := keyVaultSecretBodyFields[strings.ToLower(key)]
+		return ok
+	}
+
+	return false
+}
+
+// isKeyVaultDataPlaneHost returns whether the specified host (e.g. `example.vault.azure.net:443`) is a Key Vault
+func isKeyVaultDataPlaneHost(host string) bool {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	host = strings.ToLower(host)
+
+	for _, suffix := range keyVaultDNSSuffixes {
+		if strings.HasSuffix(host, "."+suffix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type httpTraceContextKey struct{}
+
+// httpTraceState is the state stored in the Context of a Request, which is used to track the
+// number of attempts made for a given Request across retries
+type httpTraceState struct {
+	sync.Mutex
+	attempt int
+}
+
+// HTTPTraceRecord is the structured trace record emitted for each attempt of a request
+type HTTPTraceRecord struct {
+	Timestamp            string              `json:"timestamp"`
+	Method               string              `json:"method"`
+	URL                  string              `json:"url"`
+	StatusCode           int                 `json:"status_code,omitempty"`
+	DurationMs           int64               `json:"duration_ms"`
+	CorrelationRequestID string              `json:"correlation_request_id,omitempty"`
+	ARMRequestID         string              `json:"arm_request_id,omitempty"`
+	RetryCount           int                 `json:"retry_count"`
+	RequestHeaders       map[string][]string `json:"request_headers,omitempty"`
+	RequestBody          string              `json:"request_body,omitempty"`
+	ResponseHeaders      map[string][]string `json:"response_headers,omitempty"`
+	ResponseBody         string              `json:"response_body,omitempty"`
+	Error                string              `json:"error,omitempty"`
+}
+
+// HTTPTracer emits a structured (JSON) trace record for each request sent to Azure, with any
+// credentials and secrets redacted - which is written to the log and optionally to a file
+type HTTPTracer struct {
+	providerName string
+
+	mu     sync.Mutex
+	output io.Writer
+}
+
+// NewHTTPTracer returns a HTTPTracer which logs trace records and, when a file path is specified,
+// additionally appends each trace record as a line of JSON to that file
+func NewHTTPTracer(providerName string, filePath string) (*HTTPTracer, error) {
+	tracer := HTTPTracer{
+		providerName: providerName,
+	}
+
+	if filePath != "" {
+		file, err := os.OpenFile(filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			return nil, fmt.Errorf("Error opening the HTTP Trace file %q: %+v", filePath, err)
+		}
+
+		tracer.output = file
+	}
+
+	return &tracer, nil
+}
+
+// BuildSender returns a Sender which (unlike `sender.BuildSender`) doesn't dump the raw
+// request and response into the log, since these are traced in a redacted form instead
+func (t *HTTPTracer) BuildSender() autorest.Sender {
+	return &http.Client{
+		Transport: &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+		},
+	}
+}
+
+// WithTracing returns a SendDecorator which emits a trace record for each attempt of a request once
+// it's completed - since this decorates the Sender of a client, this includes each retry of a request
+// and each request made when polling a long-running operation
+func (t *HTTPTracer) WithTracing() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			state, ok := r.Context().Value(httpTraceContextKey{}).(*httpTraceState)
+			if !ok {
+				state = &httpTraceState{}
+
+				// the request is updated in-place, since the same Request is re-sent when retrying
+				*r = *r.WithContext(context.WithValue(r.Context(), httpTraceContextKey{}, state))
+			}
+
+			state.Lock()
+			state.attempt++
+			attempt := state.attempt
+			state.Unlock()
+
+			started := time.Now()
+			requestBody := readBodyForTracing(r.URL.Host, r.Header.Get("Content-Type"), &r.Body)
+
+			resp, err := s.Do(r)
+			t.trace(r, requestBody, attempt, started, resp, err)
+			return resp, err
+		})
+	}
+}
+
+func (t *HTTPTracer) trace(req *http.Request, requestBody string, attempt int, started time.Time, resp *http.Response, err error) {
+	record := HTTPTraceRecord{
+		Timestamp:            time.Now().UTC().Format(time.RFC3339Nano),
+		Method:               req.Method,
+		URL:                  redactURL(req.URL),
+		DurationMs:           time.Since(started).Nanoseconds() / int64(time.Millisecond),
+		CorrelationRequestID: req.Header.Get(HeaderCorrelationRequestID),
+		RetryCount:           attempt - 1,
+		RequestHeaders:       redactHeaders(req.Header),
+		RequestBody:          requestBody,
+	}
+
+	if resp != nil {
+		record.StatusCode = resp.StatusCode
+		record.ARMRequestID = resp.Header.Get(HeaderARMRequestID)
+		record.ResponseHeaders = redactHeaders(resp.Header)
+		record.ResponseBody = readBodyForTracing(req.URL.Host, resp.Header.Get("Content-Type"), &resp.Body)
+	}
+
+	if err != nil {
+		record.Error = err.Error()
+	}
+
+	t.write(record)
+}
+
+func (t *HTTPTracer) write(record HTTPTraceRecord) {
+	payload, err := json.Marshal(record)
+	if err != nil {
+		log.Printf("[DEBUG] %s: Error serializing HTTP Trace record: %+v", t.providerName, err)
+		return
+	}
+
+	log.Printf("[DEBUG] %s HTTP Trace: %s", t.providerName, payload)
+
+	if t.output == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, err := t.output.Write(append(payload, '\n')); err != nil {
+		log.Printf("[DEBUG] %s: Error writing to the HTTP Trace file: %+v", t.providerName, err)
+	}
+}
+
+// readBodyForTracing reads and replaces the specified body (sent to or received from the specified host), returning
+// a redacted representation of it. Only JSON and Form bodies are read, since other content types (e.g. Blobs) can be
+// arbitrarily large.
+func readBodyForTracing(host string, contentType string, body *io.ReadCloser) string {
+	if body == nil || *body == nil || *body == http.NoBody {
+		return ""
+	}
+
+	contentType = strings.ToLower(contentType)
+	isForm := strings.Contains(contentType, "application/x-www-form-urlencoded")
+	isJSON := strings.Contains(contentType, "json")
+	if !isForm && !isJSON {
+		return fmt.Sprintf("(body of type %q)", contentType)
+	}
+
+	payload, err := ioutil.ReadAll(*body)
+	(*body).Close()
+	*body = ioutil.NopCloser(bytes.NewReader(payload))
+	if err != nil || len(payload) == 0 {
+		return ""
+	}
+
+	if isForm {
+		return redactFormBody(payload)
+	}
+
+	return redactJSONBody(host, payload)
+}
+
+func redactFormBody(payload []byte) string {
+	values, err := url.ParseQuery(string(payload))
+	if err != nil {
+		return fmt.Sprintf("(%d bytes of form data)", len(payload))
+	}
+
+	for key := range values {
+		if _, ok := redactedFormFields[strings.ToLower(key)]; ok {
+			values.Set(key, redactedValue)
+		}
+	}
+
+	return values.Encode()
+}
+
+func redactJSONBody(host string, payload []byte) string {
+	var parsed interface{}
+	if err := json.Unmarshal(payload, &parsed); err != nil {
+		return fmt.Sprintf("(%d bytes of invalid JSON)", len(payload))
+	}
+
+	redacted, err := json.Marshal(redactJSONValue(host, parsed))
+	if err != nil {
+		return fmt.Sprintf("(%d bytes of JSON)", len(payload))
+	}
+
+	if len(redacted) > maxTracedBodyLength {
+		return string(redacted[0:maxTracedBodyLength]) + "... (truncated)"
+	}
+
+	return string(redacted)
+}
+
+func redactJSONValue(host string, input interface{}) interface{} {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if _, isString := value.(string); isString && isSecretBodyField(host, key) {
+				v[key] = redactedValue
+				continue
+			}
+
+			// e.g. `"keys": [{"keyName": "key1", "value": "abc123"}]`
+			if _, isList := value.([]interface{}); isList && isSecretBodyField(host, key) {
+				v[key] = redactedValue
+				continue
+			}
+
+			v[key] = redactJSONValue(host, value)
+		}
+		return v
+
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redactJSONValue(host, value)
+		}
+		return v
+	}
+
+	return input
+}
+
+func redactHeaders(input http.Header) map[string][]string {
+	output := make(map[string][]string, len(input))
+	for key, values := range input {
+		if _, ok := redactedHeaders[http.CanonicalHeaderKey(key)]; ok {
+			output[key] = []string{redactedValue}
+			continue
+		}
+
+		output[key] = values
+	}
+
+	return output
+}
+
+func redactURL(input *url.URL) string {
+	if input == nil {
+		return ""
+	}
+
+	output := *input
+	values := output.Query()
+	for key := range values {
+		if _, ok := redactedQueryParameters[strings.ToLower(key)]; ok {
+			values.Set(key, redactedValue)
+		}
+	}
+	output.RawQuery = values.Encode()
+
+	return output.String()
+}
@@ -0,0 +1,401 @@
+package common
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestHTTPTracerRedactsSecrets(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set(HeaderARMRequestID, "arm-request-id")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"name":"example","properties":{"primaryKey":"abc123","keys":[{"value":"def456"}]}}`)) // nolint: errcheck
+	}))
+	defer server.Close()
+
+	output := bytes.Buffer{}
+	tracer := &HTTPTracer{
+		providerName: "Test",
+		output:       &output,
+	}
+
+	client := autorest.NewClientWithUserAgent("test")
+	client.Sender = autorest.DecorateSender(tracer.BuildSender(), tracer.WithTracing())
+	client.RequestInspector = WithCorrelationRequestID("correlation-id")
+
+	req, err := autorest.Prepare((&http.Request{}).WithContext(context.Background()),
+		autorest.AsPut(),
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.WithBaseURL(server.URL),
+		autorest.WithPath("/container/blob"),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"sig": "secret-signature",
+			"sv":  "2018-11-09",
+		}),
+		autorest.WithHeader("Authorization", "Bearer secret-token"),
+		autorest.WithJSON(map[string]interface{}{
+			"adminPassword": "P@ssw0rd1234!",
+			"adminUsername": "adminuser",
+		}))
+	if err != nil {
+		t.Fatalf("Error preparing request: %+v", err)
+	}
+
+	resp, err := autorest.SendWithSender(client, req)
+	if err != nil {
+		t.Fatalf("Error sending request: %+v", err)
+	}
+	if err := autorest.Respond(resp, autorest.ByClosing()); err != nil {
+		t.Fatalf("Error responding: %+v", err)
+	}
+
+	records := parseTraceRecords(t, output.String())
+	if len(records) != 1 {
+		t.Fatalf("Expected 1 trace record but got %d: %s", len(records), output.String())
+	}
+
+	record := records[0]
+	if record.Method != "PUT" {
+		t.Fatalf("Expected the Method to be `PUT` but got %q", record.Method)
+	}
+	if record.StatusCode != http.StatusOK {
+		t.Fatalf("Expected the Status Code to be 200 but got %d", record.StatusCode)
+	}
+	if record.CorrelationRequestID != "correlation-id" {
+		t.Fatalf("Expected the Correlation Request ID to be `correlation-id` but got %q", record.CorrelationRequestID)
+	}
+	if record.ARMRequestID != "arm-request-id" {
+		t.Fatalf("Expected the ARM Request ID to be `arm-request-id` but got %q", record.ARMRequestID)
+	}
+	if record.RetryCount != 0 {
+		t.Fatalf("Expected the Retry Count to be 0 but got %d", record.RetryCount)
+	}
+
+	for _, secret := range []string{"secret-signature", "secret-token", "P@ssw0rd1234!", "abc123", "def456"} {
+		if strings.Contains(output.String(), secret) {
+			t.Fatalf("Expected %q to be redacted but it was present in the trace: %s", secret, output.String())
+		}
+	}
+
+	for _, value := range []string{"adminuser", "2018-11-09", "example"} {
+		if !strings.Contains(output.String(), value) {
+			t.Fatalf("Expected %q to be present in the trace but it wasn't: %s", value, output.String())
+		}
+	}
+}
+
+func TestHTTPTracerRetryCount(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	output := bytes.Buffer{}
+	tracer := &HTTPTracer{
+		providerName: "Test",
+		output:       &output,
+	}
+
+	client := autorest.NewClientWithUserAgent("test")
+	client.Sender = autorest.DecorateSender(tracer.BuildSender(), tracer.WithTracing())
+
+	req, err := autorest.Prepare((&http.Request{}).WithContext(context.Background()),
+		autorest.AsGet(),
+		autorest.WithBaseURL(server.URL))
+	if err != nil {
+		t.Fatalf("Error preparing request: %+v", err)
+	}
+
+	resp, err := autorest.SendWithSender(client, req, autorest.DoRetryForStatusCodes(1, 0, http.StatusInternalServerError))
+	if err != nil {
+		t.Fatalf("Error sending request: %+v", err)
+	}
+	if err := autorest.Respond(resp, autorest.ByClosing()); err != nil {
+		t.Fatalf("Error responding: %+v", err)
+	}
+
+	records := parseTraceRecords(t, output.String())
+	if len(records) != 2 {
+		t.Fatalf("Expected 2 trace records but got %d: %s", len(records), output.String())
+	}
+
+	if records[0].StatusCode != http.StatusInternalServerError || records[0].RetryCount != 0 {
+		t.Fatalf("Expected the first attempt to be a 500 with a Retry Count of 0 but got %d / %d", records[0].StatusCode, records[0].RetryCount)
+	}
+
+	if records[1].StatusCode != http.StatusOK || records[1].RetryCount != 1 {
+		t.Fatalf("Expected the second attempt to be a 200 with a Retry Count of 1 but got %d / %d", records[1].StatusCode, records[1].RetryCount)
+	}
+}
+
+func TestHTTPTracerLongRunningOperation(t *testing.T) {
+	var polls int32
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPut {
+			w.Header().Set("Azure-AsyncOperation", server.URL+"/operation")
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"properties":{"provisioningState":"Updating"}}`)) // nolint: errcheck
+			return
+		}
+
+		status := "InProgress"
+		if atomic.AddInt32(&polls, 1) > 1 {
+			status = "Succeeded"
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"status":"` + status + `"}`)) // nolint: errcheck
+	}))
+	defer server.Close()
+
+	output := bytes.Buffer{}
+	tracer := &HTTPTracer{
+		providerName: "Test",
+		output:       &output,
+	}
+
+	client := autorest.NewClientWithUserAgent("test")
+	client.Sender = autorest.DecorateSender(tracer.BuildSender(), tracer.WithTracing())
+	client.PollingDelay = 0
+
+	req, err := autorest.Prepare((&http.Request{}).WithContext(context.Background()),
+		autorest.AsPut(),
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.WithBaseURL(server.URL),
+		autorest.WithPath("/resource"),
+		autorest.WithJSON(map[string]interface{}{}))
+	if err != nil {
+		t.Fatalf("Error preparing request: %+v", err)
+	}
+
+	resp, err := autorest.SendWithSender(client, req)
+	if err != nil {
+		t.Fatalf("Error sending request: %+v", err)
+	}
+
+	future, err := azure.NewFutureFromResponse(resp)
+	if err != nil {
+		t.Fatalf("Error creating future: %+v", err)
+	}
+	if err := future.WaitForCompletionRef(context.Background(), client); err != nil {
+		t.Fatalf("Error waiting for completion: %+v", err)
+	}
+
+	pollRecords := 0
+	for _, record := range parseTraceRecords(t, output.String()) {
+		if strings.HasSuffix(record.URL, "/operation") {
+			pollRecords++
+		}
+	}
+
+	if pollRecords != 2 {
+		t.Fatalf("Expected 2 trace records for polling the operation but got %d: %s", pollRecords, output.String())
+	}
+}
+
+func TestHTTPTracerSendError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	serverURL := server.URL
+	server.Close()
+
+	output := bytes.Buffer{}
+	tracer := &HTTPTracer{
+		providerName: "Test",
+		output:       &output,
+	}
+
+	client := autorest.NewClientWithUserAgent("test")
+	client.Sender = autorest.DecorateSender(tracer.BuildSender(), tracer.WithTracing())
+
+	req, err := autorest.Prepare((&http.Request{}).WithContext(context.Background()),
+		autorest.AsGet(),
+		autorest.WithBaseURL(serverURL))
+	if err != nil {
+		t.Fatalf("Error preparing request: %+v", err)
+	}
+
+	if _, err := autorest.SendWithSender(client, req); err == nil {
+		t.Fatalf("Expected an error sending the request but didn't get one")
+	}
+
+	records := parseTraceRecords(t, output.String())
+	if len(records) != 1 {
+		t.Fatalf("Expected 1 trace record but got %d: %s", len(records), output.String())
+	}
+
+	if records[0].Error == "" || records[0].StatusCode != 0 {
+		t.Fatalf("Expected the trace record to contain the error and no Status Code but got %q / %d", records[0].Error, records[0].StatusCode)
+	}
+}
+
+func TestRedactFormBody(t *testing.T) {
+	actual := redactFormBody([]byte("grant_type=client_credentials&client_id=00000000-0000-0000-0000-000000000000&client_secret=super-secret"))
+	if strings.Contains(actual, "super-secret") {
+		t.Fatalf("Expected the `client_secret` to be redacted but got %q", actual)
+	}
+	if !strings.Contains(actual, "client_credentials") {
+		t.Fatalf("Expected the `grant_type` to be present but got %q", actual)
+	}
+}
+
+func TestRedactKeyVaultBodies(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    interface{}
+		Secrets  []string
+		Retained []string
+	}{
+		{
+			Name: "SecretSetParameters",
+			Input: keyvault.SecretSetParameters{
+				Value:       utils.String("sup3r-s3cr3t"),
+				ContentType: utils.String("text/plain"),
+			},
+			Secrets:  []string{"sup3r-s3cr3t"},
+			Retained: []string{"text/plain"},
+		},
+		{
+			Name: "SecretBundle",
+			Input: keyvault.SecretBundle{
+				Value: utils.String("sup3r-s3cr3t"),
+				ID:    utils.String("https://example.vault.azure.net/secrets/example/abc123"),
+			},
+			Secrets:  []string{"sup3r-s3cr3t"},
+			Retained: []string{"https://example.vault.azure.net/secrets/example/abc123"},
+		},
+		{
+			Name: "CertificateImportParameters",
+			Input: keyvault.CertificateImportParameters{
+				Base64EncodedCertificate: utils.String("TUlJS3BmeC1jb250ZW50cw=="),
+				Password:                 utils.String("pfx-p@ssw0rd"),
+				CertificatePolicy: &keyvault.CertificatePolicy{
+					KeyProperties: &keyvault.KeyProperties{
+						KeyType: utils.String("RSA"),
+					},
+				},
+			},
+			Secrets:  []string{"TUlJS3BmeC1jb250ZW50cw==", "pfx-p@ssw0rd"},
+			Retained: []string{"RSA"},
+		},
+		{
+			Name: "KeyImportParameters",
+			Input: keyvault.KeyImportParameters{
+				Key: &keyvault.JSONWebKey{
+					Kty: keyvault.RSA,
+					N:   utils.String("public-modulus"),
+					E:   utils.String("AQAB"),
+					D:   utils.String("private-exponent"),
+					P:   utils.String("private-prime-p"),
+					Q:   utils.String("private-prime-q"),
+					DP:  utils.String("private-exponent-dp"),
+					DQ:  utils.String("private-exponent-dq"),
+					QI:  utils.String("private-coefficient"),
+				},
+			},
+			Secrets:  []string{"private-exponent", "private-prime-p", "private-prime-q", "private-exponent-dp", "private-exponent-dq", "private-coefficient"},
+			Retained: []string{"public-modulus", "AQAB"},
+		},
+		{
+			Name: "KeyImportParameters with a Symmetric Key",
+			Input: keyvault.KeyImportParameters{
+				Key: &keyvault.JSONWebKey{
+					Kty: keyvault.Oct,
+					K:   utils.String("symmetric-key"),
+				},
+			},
+			Secrets: []string{"symmetric-key"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		payload, err := json.Marshal(v.Input)
+		if err != nil {
+			t.Fatalf("Error serializing %q: %+v", v.Name, err)
+		}
+
+		body := ioutil.NopCloser(bytes.NewReader(payload))
+		actual := readBodyForTracing("example.vault.azure.net:443", "application/json; charset=utf-8", &body)
+
+		for _, secret := range v.Secrets {
+			if strings.Contains(actual, secret) {
+				t.Fatalf("Expected %q to be redacted from %q but got %s", secret, v.Name, actual)
+			}
+		}
+
+		for _, value := range v.Retained {
+			if !strings.Contains(actual, value) {
+				t.Fatalf("Expected %q to be retained in %q but got %s", value, v.Name, actual)
+			}
+		}
+
+		// the body should be replaced, so that it can still be sent/parsed
+		if replaced, _ := ioutil.ReadAll(body); !bytes.Equal(replaced, payload) {
+			t.Fatalf("Expected the body for %q to be replaced but got %s", v.Name, replaced)
+		}
+	}
+}
+
+func TestIsSecretBodyField(t *testing.T) {
+	testData := []struct {
+		Host     string
+		Key      string
+		Expected bool
+	}{
+		{Host: "management.azure.com", Key: "adminPassword", Expected: true},
+		{Host: "management.azure.com", Key: "value", Expected: false},
+		{Host: "management.azure.com", Key: "d", Expected: false},
+		{Host: "example.vault.azure.net", Key: "value", Expected: true},
+		{Host: "example.vault.azure.net:443", Key: "pwd", Expected: true},
+		{Host: "example.vault.azure.cn", Key: "d", Expected: true},
+		{Host: "example.vault.usgovcloudapi.net", Key: "qi", Expected: true},
+		{Host: "example.vault.azure.net", Key: "n", Expected: false},
+		{Host: "example.vault.azure.net", Key: "contentType", Expected: false},
+		{Host: "vault.azure.net.example.com", Key: "value", Expected: false},
+	}
+
+	for _, v := range testData {
+		if actual := isSecretBodyField(v.Host, v.Key); actual != v.Expected {
+			t.Fatalf("Expected %q on %q to be %t but got %t", v.Key, v.Host, v.Expected, actual)
+		}
+	}
+}
+
+func parseTraceRecords(t *testing.T, input string) []HTTPTraceRecord {
+	records := make([]HTTPTraceRecord, 0)
+
+	scanner := bufio.NewScanner(strings.NewReader(input))
+	scanner.Buffer(make([]byte, 0, 1024*1024), 1024*1024)
+	for scanner.Scan() {
+		var record HTTPTraceRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("Error parsing trace record %q: %+v", scanner.Text(), err)
+		}
+
+		records = append(records, record)
+	}
+
+	return records
+}
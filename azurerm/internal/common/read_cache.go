@@ -0,0 +1,319 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const (
+	// DefaultReadCacheWindow is the duration for which a cached response is reused without being revalidated
+	DefaultReadCacheWindow = 2 * time.Minute
+
+	headerETag        = "ETag"
+	headerIfNoneMatch = "If-None-Match"
+)
+
+type readCacheContextKey struct{}
+
+// WithReadCaching returns a Context within which GET requests can be served from the Read Cache (when enabled).
+//
+// This is only used for Read operations, since the requests made when polling long-running operations
+// (e.g. Create/Delete) must always be sent to Azure.
+func WithReadCaching(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readCacheContextKey{}, true)
+}
+
+func readCachingAllowed(ctx context.Context) bool {
+	v, ok := ctx.Value(readCacheContextKey{}).(bool)
+	return ok && v
+}
+
+type readCacheEntry struct {
+	statusCode int
+	header     http.Header
+	body       []byte
+	etag       string
+	storedAt   time.Time
+}
+
+// ReadCache is an opt-in cache of the responses to GET requests, which is used to deduplicate the
+// requests made for the same (parent) resource during a refresh - for example when each Subnet
+// Association reads the same Subnet. Cached responses are reused for a short window, after which
+// they're revalidated using their ETag, and are invalidated by any write to the same resource
+// (or to a parent/child of it).
+type ReadCache struct {
+	mu       sync.Mutex
+	entries  map[string]*readCacheEntry
+	inflight map[string]chan struct{}
+	window   time.Duration
+
+	// generations is the number of writes made to each path, which is used to detect that a
+	// resource was written to whilst a GET for it was in-flight (and so that it's response is stale)
+	generations map[string]uint64
+}
+
+// NewReadCache returns a ReadCache which reuses responses for the specified window
+func NewReadCache(window time.Duration) *ReadCache {
+	return &ReadCache{
+		entries:     make(map[string]*readCacheEntry),
+		inflight:    make(map[string]chan struct{}),
+		window:      window,
+		generations: make(map[string]uint64),
+	}
+}
+
+// Clear removes all of the cached responses
+func (c *ReadCache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = make(map[string]*readCacheEntry)
+}
+
+// WithCaching returns a SendDecorator which serves GET requests made within a Read from the cache
+// where possible, and invalidates the cache for any resource which is written to
+func (c *ReadCache) WithCaching() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			if r.Method != http.MethodGet && r.Method != http.MethodHead {
+				// the cache is also invalidated once the write completes, since the resource
+				// could have been read (and cached) whilst the write was in progress
+				c.invalidate(r)
+				defer c.invalidate(r)
+				return s.Do(r)
+			}
+
+			if r.Method != http.MethodGet || !readCachingAllowed(r.Context()) {
+				return s.Do(r)
+			}
+
+			return c.get(s, r)
+		})
+	}
+}
+
+func (c *ReadCache) get(s autorest.Sender, r *http.Request) (*http.Response, error) {
+	key := readCacheKey(r)
+
+	for {
+		c.mu.Lock()
+		entry := c.entries[key]
+		if entry != nil && time.Since(entry.storedAt) < c.window {
+			c.mu.Unlock()
+			log.Printf("[DEBUG] Read Cache: using the cached response for %q", r.URL.Path)
+			return entry.toResponse(r), nil
+		}
+
+		// deduplicate identical requests which are in-flight
+		if waitFor, ok := c.inflight[key]; ok {
+			c.mu.Unlock()
+			select {
+			case <-waitFor:
+				continue
+			case <-r.Context().Done():
+				return nil, r.Context().Err()
+			}
+		}
+
+		done := make(chan struct{})
+		c.inflight[key] = done
+		generation := c.generationFor(r)
+		c.mu.Unlock()
+
+		resp, err := c.send(s, r, key, entry, generation)
+
+		c.mu.Lock()
+		delete(c.inflight, key)
+		close(done)
+		c.mu.Unlock()
+
+		return resp, err
+	}
+}
+
+func (c *ReadCache) send(s autorest.Sender, r *http.Request, key string, stale *readCacheEntry, generation uint64) (*http.Response, error) {
+	if stale != nil && stale.etag != "" {
+		r.Header.Set(headerIfNoneMatch, stale.etag)
+		defer r.Header.Del(headerIfNoneMatch)
+	}
+
+	resp, err := s.Do(r)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+
+	if resp.StatusCode == http.StatusNotModified && stale != nil {
+		resp.Body.Close()
+
+		c.mu.Lock()
+		stale.storedAt = time.Now()
+		c.mu.Unlock()
+
+		log.Printf("[DEBUG] Read Cache: the cached response for %q was still valid", r.URL.Path)
+		return stale.toResponse(r), nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return resp, nil
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return resp, err
+	}
+
+	if !responseIsCacheable(resp.Header.Get("Content-Type"), body) {
+		return resp, nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// the resource (or a parent/child of it) was written to whilst this request was in-flight,
+	// as such this response may predate the write and can't be cached
+	if c.generationFor(r) != generation {
+		log.Printf("[DEBUG] Read Cache: %q was written to during the request - not caching the response", r.URL.Path)
+		return resp, nil
+	}
+
+	c.entries[key] = &readCacheEntry{
+		statusCode: resp.StatusCode,
+		header:     resp.Header,
+		body:       body,
+		etag:       resp.Header.Get(headerETag),
+		storedAt:   time.Now(),
+	}
+
+	return resp, nil
+}
+
+// invalidate removes any cached responses for the resource being written to, and for any
+// parent or child of it - since these can embed the resource (e.g. a Virtual Network and it's Subnets)
+func (c *ReadCache) invalidate(r *http.Request) {
+	if r.URL == nil {
+		return
+	}
+
+	host := strings.ToLower(r.URL.Host)
+	path := strings.ToLower(strings.TrimSuffix(r.URL.Path, "/"))
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generations[host+"|"+path]++
+
+	for key, entry := range c.entries {
+		if entry == nil {
+			continue
+		}
+
+		entryHost, entryPath := splitReadCacheKey(key)
+		if entryHost != host {
+			continue
+		}
+
+		if readCachePathsOverlap(entryPath, path) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// generationFor returns the number of writes made to the resource being requested and to any
+// parent or child of it - as such this changes whenever the cached response would be invalidated.
+// The caller must hold the lock.
+func (c *ReadCache) generationFor(r *http.Request) uint64 {
+	host, path := splitReadCacheKey(readCacheKey(r))
+
+	generation := uint64(0)
+	for key, count := range c.generations {
+		writeHost, writePath := splitReadCacheKey(key)
+		if writeHost == host && readCachePathsOverlap(writePath, path) {
+			generation += count
+		}
+	}
+	return generation
+}
+
+func (e *readCacheEntry) toResponse(r *http.Request) *http.Response {
+	header := make(http.Header, len(e.header))
+	for k, v := range e.header {
+		header[k] = append([]string{}, v...)
+	}
+
+	return &http.Response{
+		Status:        http.StatusText(e.statusCode),
+		StatusCode:    e.statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(e.body)),
+		ContentLength: int64(len(e.body)),
+		Request:       r,
+	}
+}
+
+// responseIsCacheable returns whether the specified response can be cached, which is limited to JSON
+// responses for resources which aren't in the middle of being provisioned
+func responseIsCacheable(contentType string, body []byte) bool {
+	if !strings.Contains(strings.ToLower(contentType), "json") {
+		return false
+	}
+
+	var parsed struct {
+		Properties *struct {
+			ProvisioningState *string `json:"provisioningState"`
+		} `json:"properties"`
+	}
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		// e.g. a JSON array rather than an object
+		return false
+	}
+
+	if parsed.Properties == nil || parsed.Properties.ProvisioningState == nil {
+		return true
+	}
+
+	switch strings.ToLower(*parsed.Properties.ProvisioningState) {
+	case "succeeded", "failed", "canceled", "cancelled":
+		return true
+	}
+
+	return false
+}
+
+// readCacheKey returns the key used for a request - ARM paths are case-insensitive
+// however the Query String (which includes the API Version) is retained as-is
+func readCacheKey(r *http.Request) string {
+	return strings.ToLower(r.URL.Host) + "|" + strings.ToLower(strings.TrimSuffix(r.URL.Path, "/")) + "?" + r.URL.RawQuery
+}
+
+// readCachePathsOverlap returns whether the two (lower-cased) paths are the same, or one is a parent of the other
+func readCachePathsOverlap(first string, second string) bool {
+	return first == second || strings.HasPrefix(first, second+"/") || strings.HasPrefix(second, first+"/")
+}
+
+func splitReadCacheKey(key string) (host string, path string) {
+	segments := strings.SplitN(key, "|", 2)
+	if len(segments) != 2 {
+		return "", ""
+	}
+
+	path = segments[1]
+	if idx := strings.Index(path, "?"); idx != -1 {
+		path = path[0:idx]
+	}
+
+	return segments[0], path
+}
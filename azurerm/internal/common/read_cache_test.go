@@ -0,0 +1,255 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestReadCacheDeduplicatesReads(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"name":"example","properties":{"provisioningState":"Succeeded"}}`)) // nolint: errcheck
+	}))
+	defer server.Close()
+
+	sender := buildReadCacheSender(NewReadCache(time.Minute))
+	ctx := WithReadCaching(context.Background())
+
+	for i := 0; i < 3; i++ {
+		body := sendReadCacheRequest(t, ctx, sender, http.MethodGet, server.URL+"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example")
+		if body != `{"name":"example","properties":{"provisioningState":"Succeeded"}}` {
+			t.Fatalf("Unexpected body %q", body)
+		}
+	}
+
+	// ARM paths are case-insensitive
+	sendReadCacheRequest(t, ctx, sender, http.MethodGet, server.URL+"/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/EXAMPLE")
+
+	if v := atomic.LoadInt32(&requests); v != 1 {
+		t.Fatalf("Expected 1 request to be sent but got %d", v)
+	}
+
+	// requests which aren't made within a Read aren't cached
+	sendReadCacheRequest(t, context.Background(), sender, http.MethodGet, server.URL+"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example")
+	if v := atomic.LoadInt32(&requests); v != 2 {
+		t.Fatalf("Expected 2 requests to be sent but got %d", v)
+	}
+}
+
+func TestReadCacheInvalidatedByWrites(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"name":"example"}`)) // nolint: errcheck
+	}))
+	defer server.Close()
+
+	sender := buildReadCacheSender(NewReadCache(time.Minute))
+	ctx := WithReadCaching(context.Background())
+
+	vnetPath := server.URL + "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/example"
+	sendReadCacheRequest(t, ctx, sender, http.MethodGet, vnetPath)
+
+	// writing to a child resource (e.g. a Subnet) invalidates the parent
+	sendReadCacheRequest(t, context.Background(), sender, http.MethodPut, vnetPath+"/subnets/internal")
+	sendReadCacheRequest(t, ctx, sender, http.MethodGet, vnetPath)
+
+	if v := atomic.LoadInt32(&requests); v != 3 {
+		t.Fatalf("Expected 3 requests to be sent but got %d", v)
+	}
+}
+
+func TestReadCacheRevalidatesUsingETag(t *testing.T) {
+	var requests int32
+	var revalidations int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		if r.Header.Get("If-None-Match") == `"etag1"` {
+			atomic.AddInt32(&revalidations, 1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("ETag", `"etag1"`)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"name":"example"}`)) // nolint: errcheck
+	}))
+	defer server.Close()
+
+	// a window of 0 means every cached response needs revalidating
+	sender := buildReadCacheSender(NewReadCache(0))
+	ctx := WithReadCaching(context.Background())
+
+	for i := 0; i < 2; i++ {
+		body := sendReadCacheRequest(t, ctx, sender, http.MethodGet, server.URL+"/example")
+		if body != `{"name":"example"}` {
+			t.Fatalf("Unexpected body %q", body)
+		}
+	}
+
+	if v := atomic.LoadInt32(&requests); v != 2 {
+		t.Fatalf("Expected 2 requests to be sent but got %d", v)
+	}
+	if v := atomic.LoadInt32(&revalidations); v != 1 {
+		t.Fatalf("Expected 1 revalidation but got %d", v)
+	}
+}
+
+func TestReadCacheDeduplicatesInFlightReads(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		time.Sleep(50 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"name":"example"}`)) // nolint: errcheck
+	}))
+	defer server.Close()
+
+	sender := buildReadCacheSender(NewReadCache(time.Minute))
+	ctx := WithReadCaching(context.Background())
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sendReadCacheRequest(t, ctx, sender, http.MethodGet, server.URL+"/example")
+		}()
+	}
+	wg.Wait()
+
+	if v := atomic.LoadInt32(&requests); v != 1 {
+		t.Fatalf("Expected 1 request to be sent but got %d", v)
+	}
+}
+
+func TestReadCacheDiscardsReadsInterleavedWithWrites(t *testing.T) {
+	var requests int32
+	var version int32
+	getStarted := make(chan struct{}, 1)
+	releaseGet := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			atomic.AddInt32(&version, 1)
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+
+		body := fmt.Sprintf(`{"name":"example","version":%d}`, atomic.LoadInt32(&version))
+		if atomic.AddInt32(&requests, 1) == 1 {
+			// hold the first GET (with the original body) until the write has completed
+			getStarted <- struct{}{}
+			<-releaseGet
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(body)) // nolint: errcheck
+	}))
+	defer server.Close()
+
+	sender := buildReadCacheSender(NewReadCache(time.Minute))
+	ctx := WithReadCaching(context.Background())
+	vnetPath := server.URL + "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/example"
+
+	done := make(chan string)
+	go func() {
+		done <- sendReadCacheRequest(t, ctx, sender, http.MethodGet, vnetPath)
+	}()
+
+	<-getStarted
+	sendReadCacheRequest(t, context.Background(), sender, http.MethodPut, vnetPath+"/subnets/internal")
+	close(releaseGet)
+
+	if body := <-done; body != `{"name":"example","version":0}` {
+		t.Fatalf("Unexpected body for the in-flight read %q", body)
+	}
+
+	// the in-flight response predates the write, so mustn't have been cached
+	if body := sendReadCacheRequest(t, ctx, sender, http.MethodGet, vnetPath); body != `{"name":"example","version":1}` {
+		t.Fatalf("Expected the read after the write to return the updated body but got %q", body)
+	}
+	if v := atomic.LoadInt32(&requests); v != 2 {
+		t.Fatalf("Expected 2 reads to be sent but got %d", v)
+	}
+}
+
+func TestResponseIsCacheable(t *testing.T) {
+	cases := []struct {
+		ContentType string
+		Body        string
+		Expected    bool
+	}{
+		{
+			ContentType: "application/json; charset=utf-8",
+			Body:        `{"name":"example"}`,
+			Expected:    true,
+		},
+		{
+			ContentType: "application/json",
+			Body:        `{"properties":{"provisioningState":"Succeeded"}}`,
+			Expected:    true,
+		},
+		{
+			ContentType: "application/json",
+			Body:        `{"properties":{"provisioningState":"Updating"}}`,
+			Expected:    false,
+		},
+		{
+			ContentType: "application/json",
+			Body:        `[]`,
+			Expected:    false,
+		},
+		{
+			ContentType: "application/octet-stream",
+			Body:        `{"name":"example"}`,
+			Expected:    false,
+		},
+	}
+
+	for _, tc := range cases {
+		if actual := responseIsCacheable(tc.ContentType, []byte(tc.Body)); actual != tc.Expected {
+			t.Fatalf("Expected %t for %q (%s) but got %t", tc.Expected, tc.Body, tc.ContentType, actual)
+		}
+	}
+}
+
+func buildReadCacheSender(cache *ReadCache) autorest.Sender {
+	return autorest.DecorateSender(&http.Client{}, cache.WithCaching())
+}
+
+func sendReadCacheRequest(t *testing.T, ctx context.Context, sender autorest.Sender, method string, uri string) string {
+	req, err := http.NewRequest(method, uri, nil)
+	if err != nil {
+		t.Fatalf("Error building request: %+v", err)
+	}
+
+	resp, err := sender.Do(req.WithContext(ctx))
+	if err != nil {
+		t.Fatalf("Error sending request: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("Error reading body: %+v", err)
+	}
+
+	return string(body)
+}
@@ -0,0 +1,504 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// RecordingMode determines whether requests are sent to Azure, recorded or replayed
+type RecordingMode string
+
+const (
+	// RecordingModeLive sends requests to Azure without recording them (the default)
+	RecordingModeLive RecordingMode = "live"
+
+	// RecordingModeRecord sends requests to Azure and records them (with secrets scrubbed) into a cassette per test
+	RecordingModeRecord RecordingMode = "record"
+
+	// RecordingModeReplay replays the interactions from the cassette for each test, without sending any requests
+	RecordingModeReplay RecordingMode = "replay"
+
+	// RecordingPlaceholderID is used in place of the Subscription, Tenant and Client IDs in recordings
+	RecordingPlaceholderID = "00000000-0000-0000-0000-000000000000"
+
+	// recordingSecretValue is used in place of secrets in recordings, which is valid base64 since
+	// some secrets (e.g. Storage Account Keys) are decoded prior to being used
+	recordingSecretValue = "cmVkYWN0ZWQ="
+
+	recordingModeEnvVar = "ARM_TEST_RECORDING_MODE"
+	recordingsDirEnvVar = "ARM_TEST_RECORDINGS_DIR"
+
+	defaultRecordingsDirectory = "testdata/recordings"
+)
+
+// armRecorder is shared between all of the clients (including those used for authentication),
+// such that all of the interactions for a given test are recorded into the same cassette
+var armRecorder = newRecorder()
+
+// Cassette contains the interactions (and random values) recorded for a single test
+type Cassette struct {
+	// Values contains the random values (e.g. resource name suffixes) generated for this test, by name
+	Values map[string][]string `json:"values"`
+
+	// Interactions contains the requests sent to Azure, in the order they were sent
+	Interactions []RecordedInteraction `json:"interactions"`
+
+	path          string
+	usedValues    map[string]int
+	used          []bool
+	lastMatchedAt map[string]int
+}
+
+// RecordedInteraction is a request sent to Azure and the response which was received
+type RecordedInteraction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is the (scrubbed) request sent to Azure
+type RecordedRequest struct {
+	Method  string              `json:"method"`
+	URL     string              `json:"url"`
+	Headers map[string][]string `json:"headers,omitempty"`
+	Body    string              `json:"body,omitempty"`
+}
+
+// RecordedResponse is the (scrubbed) response returned from Azure
+type RecordedResponse struct {
+	StatusCode int                 `json:"status_code"`
+	Headers    map[string][]string `json:"headers,omitempty"`
+	Body       string              `json:"body,omitempty"`
+
+	// BodyBase64 is used in place of Body when the response body isn't valid UTF-8 (e.g. a Blob)
+	BodyBase64 []byte `json:"body_base64,omitempty"`
+}
+
+type recorder struct {
+	mu sync.Mutex
+
+	cassettes map[string]*Cassette
+	current   *Cassette
+
+	// replacements are values (e.g. the Subscription ID) which are replaced in recorded interactions
+	replacements map[string]string
+}
+
+func newRecorder() *recorder {
+	return &recorder{
+		cassettes:    make(map[string]*Cassette),
+		replacements: make(map[string]string),
+	}
+}
+
+// CurrentRecordingMode returns the RecordingMode specified in the `ARM_TEST_RECORDING_MODE` Environment Variable
+func CurrentRecordingMode() RecordingMode {
+	switch v := RecordingMode(strings.ToLower(os.Getenv(recordingModeEnvVar))); v {
+	case RecordingModeRecord, RecordingModeReplay:
+		return v
+	case "", RecordingModeLive:
+		return RecordingModeLive
+	default:
+		log.Printf("[WARN] Unsupported value %q for %q - requests will be sent to Azure", v, recordingModeEnvVar)
+		return RecordingModeLive
+	}
+}
+
+type recordingTest interface {
+	Name() string
+	Fatalf(format string, args ...interface{})
+}
+
+// StartRecording switches the cassette used for recording/replaying interactions to the one for the specified test.
+//
+// Since the clients are shared between tests, tests which are recorded/replayed must be run sequentially (`-parallel=1`).
+func StartRecording(t recordingTest) {
+	mode := CurrentRecordingMode()
+	if mode == RecordingModeLive {
+		return
+	}
+
+	armRecorder.mu.Lock()
+	defer armRecorder.mu.Unlock()
+
+	cassette, err := armRecorder.cassetteFor(mode, t.Name())
+	if err != nil {
+		t.Fatalf("Error loading the cassette for %q: %+v", t.Name(), err)
+		return
+	}
+
+	if mode == RecordingModeRecord {
+		cassette.Interactions = make([]RecordedInteraction, 0)
+	}
+	cassette.used = make([]bool, len(cassette.Interactions))
+	cassette.lastMatchedAt = make(map[string]int)
+	armRecorder.current = cassette
+}
+
+// RecordedValue returns a value generated by the specified function when running live or recording -
+// which is stored in the cassette for the calling test, such that the same value is returned when replaying
+func RecordedValue(name string, generate func() string) string {
+	mode := CurrentRecordingMode()
+	if mode == RecordingModeLive {
+		return generate()
+	}
+
+	testName := callingTestName()
+	if testName == "" {
+		return generate()
+	}
+
+	armRecorder.mu.Lock()
+	defer armRecorder.mu.Unlock()
+
+	cassette, err := armRecorder.cassetteFor(mode, testName)
+	if err != nil {
+		log.Printf("[WARN] Error loading the cassette for %q: %+v", testName, err)
+		return generate()
+	}
+
+	if mode == RecordingModeReplay {
+		index := cassette.usedValues[name]
+		if values := cassette.Values[name]; index < len(values) {
+			cassette.usedValues[name]++
+			return values[index]
+		}
+
+		log.Printf("[WARN] No recorded value for %q was found in the cassette for %q", name, testName)
+		return generate()
+	}
+
+	value := generate()
+	cassette.Values[name] = append(cassette.Values[name], value)
+	if err := cassette.save(); err != nil {
+		log.Printf("[WARN] Error saving the cassette for %q: %+v", testName, err)
+	}
+	return value
+}
+
+// ScrubValuesFromRecordings registers values (e.g. the Subscription ID) which are replaced with the specified
+// placeholder in any recorded interactions, and in requests prior to them being matched when replaying
+func ScrubValuesFromRecordings(replacements map[string]string) {
+	armRecorder.mu.Lock()
+	defer armRecorder.mu.Unlock()
+
+	for value, placeholder := range replacements {
+		if value == "" || value == placeholder {
+			continue
+		}
+
+		armRecorder.replacements[value] = placeholder
+	}
+}
+
+// WithRecording returns a SendDecorator which records interactions to (or replays interactions from) the
+// cassette for the current test, depending on the current RecordingMode
+func WithRecording() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			switch CurrentRecordingMode() {
+			case RecordingModeRecord:
+				return armRecorder.record(s, r)
+			case RecordingModeReplay:
+				return armRecorder.replay(r)
+			}
+
+			return s.Do(r)
+		})
+	}
+}
+
+func (rec *recorder) record(s autorest.Sender, r *http.Request) (*http.Response, error) {
+	requestBody := readBodyForRecording(&r.Body)
+
+	resp, err := s.Do(r)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+
+	responseBody := readBodyForRecording(&resp.Body)
+
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+
+	if rec.current == nil {
+		log.Printf("[WARN] No cassette is loaded - not recording %s %s", r.Method, redactURL(r.URL))
+		return resp, nil
+	}
+
+	interaction := RecordedInteraction{
+		Request: RecordedRequest{
+			Method:  r.Method,
+			URL:     rec.scrub(redactURL(r.URL)),
+			Headers: rec.scrubHeaders(r.Header),
+			Body:    rec.scrub(scrubBodyForRecording(r.URL.Host, r.Header.Get("Content-Type"), requestBody)),
+		},
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Headers:    rec.scrubHeaders(resp.Header),
+		},
+	}
+
+	if utf8.Valid(responseBody) {
+		interaction.Response.Body = rec.scrub(scrubBodyForRecording(r.URL.Host, resp.Header.Get("Content-Type"), responseBody))
+	} else {
+		interaction.Response.BodyBase64 = responseBody
+	}
+
+	rec.current.Interactions = append(rec.current.Interactions, interaction)
+	rec.current.used = append(rec.current.used, true)
+	if err := rec.current.save(); err != nil {
+		log.Printf("[WARN] Error saving the cassette %q: %+v", rec.current.path, err)
+	}
+
+	return resp, nil
+}
+
+func (rec *recorder) replay(r *http.Request) (*http.Response, error) {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+
+	uri := rec.scrub(redactURL(r.URL))
+	if rec.current == nil {
+		return nil, fmt.Errorf("Error replaying %s %s: no cassette is loaded", r.Method, uri)
+	}
+
+	// the body is scrubbed in the same way as when it was recorded, so that it can be compared
+	body := rec.scrub(scrubBodyForRecording(r.URL.Host, r.Header.Get("Content-Type"), readBodyForRecording(&r.Body)))
+	interaction := rec.current.match(r.Method, uri, body)
+	if interaction == nil {
+		return nil, fmt.Errorf("Error replaying %s %s: no interaction with a matching URI and body was found in the cassette %q", r.Method, uri, rec.current.path)
+	}
+
+	header := make(http.Header, len(interaction.Response.Headers))
+	for k, v := range interaction.Response.Headers {
+		// the recorded delays (and rate limits) aren't applicable when replaying
+		if strings.EqualFold(k, headerRetryAfter) {
+			continue
+		}
+		header[k] = append([]string{}, v...)
+	}
+
+	responseBody := []byte(interaction.Response.Body)
+	if interaction.Response.BodyBase64 != nil {
+		responseBody = interaction.Response.BodyBase64
+	}
+
+	return &http.Response{
+		Status:        http.StatusText(interaction.Response.StatusCode),
+		StatusCode:    interaction.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(responseBody)),
+		ContentLength: int64(len(responseBody)),
+		Request:       r,
+	}, nil
+}
+
+// match returns the first unused interaction for the specified request (which must have the same method, URI
+// and scrubbed body as the recorded request) - or where all of the matching interactions have been used (e.g.
+// when polling a long-running operation) the last one which was matched
+func (c *Cassette) match(method, uri, body string) *RecordedInteraction {
+	key := method + " " + uri + " " + body
+	for i, interaction := range c.Interactions {
+		if c.used[i] || interaction.Request.Method != method || interaction.Request.URL != uri || interaction.Request.Body != body {
+			continue
+		}
+
+		c.used[i] = true
+		c.lastMatchedAt[key] = i
+		return &c.Interactions[i]
+	}
+
+	if i, ok := c.lastMatchedAt[key]; ok {
+		return &c.Interactions[i]
+	}
+
+	return nil
+}
+
+func (c *Cassette) save() error {
+	payload, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.path), 0755); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(c.path, payload, 0644)
+}
+
+// cassetteFor returns the cassette for the specified test, which is loaded from disk when replaying
+func (rec *recorder) cassetteFor(mode RecordingMode, testName string) (*Cassette, error) {
+	// Sub-Tests share the cassette of the parent Test
+	testName = strings.Split(testName, "/")[0]
+	if cassette, ok := rec.cassettes[testName]; ok {
+		return cassette, nil
+	}
+
+	directory := os.Getenv(recordingsDirEnvVar)
+	if directory == "" {
+		directory = defaultRecordingsDirectory
+	}
+
+	cassette := &Cassette{
+		Values:        make(map[string][]string),
+		Interactions:  make([]RecordedInteraction, 0),
+		path:          filepath.Join(directory, fmt.Sprintf("%s.json", testName)),
+		usedValues:    make(map[string]int),
+		lastMatchedAt: make(map[string]int),
+	}
+
+	if mode == RecordingModeReplay {
+		payload, err := ioutil.ReadFile(cassette.path)
+		if err != nil {
+			return nil, fmt.Errorf("Error reading cassette %q: %+v", cassette.path, err)
+		}
+
+		if err := json.Unmarshal(payload, cassette); err != nil {
+			return nil, fmt.Errorf("Error parsing cassette %q: %+v", cassette.path, err)
+		}
+	}
+
+	rec.cassettes[testName] = cassette
+	return cassette, nil
+}
+
+func (rec *recorder) scrub(input string) string {
+	for value, placeholder := range rec.replacements {
+		input = strings.Replace(input, value, placeholder, -1)
+	}
+
+	return input
+}
+
+func (rec *recorder) scrubHeaders(input http.Header) map[string][]string {
+	output := redactHeaders(input)
+	for key, values := range output {
+		scrubbed := make([]string, 0, len(values))
+		for _, v := range values {
+			scrubbed = append(scrubbed, rec.scrub(v))
+		}
+		output[key] = scrubbed
+	}
+
+	return output
+}
+
+// callingTestName returns the name of the outermost Test function in the call stack, if any
+func callingTestName() string {
+	pcs := make([]uintptr, 64)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+
+	name := ""
+	for {
+		frame, more := frames.Next()
+
+		// e.g. `github.com/terraform-providers/terraform-provider-azurerm/azurerm.TestAccAzureRMResourceGroup_basic.func1`
+		function := frame.Function[strings.LastIndex(frame.Function, "/")+1:]
+		if segments := strings.Split(function, "."); len(segments) > 1 && strings.HasPrefix(segments[1], "Test") {
+			name = segments[1]
+		}
+
+		if !more {
+			break
+		}
+	}
+
+	return name
+}
+
+func readBodyForRecording(body *io.ReadCloser) []byte {
+	if body == nil || *body == nil || *body == http.NoBody {
+		return nil
+	}
+
+	payload, err := ioutil.ReadAll(*body)
+	(*body).Close()
+	*body = ioutil.NopCloser(bytes.NewReader(payload))
+	if err != nil {
+		return nil
+	}
+
+	return payload
+}
+
+// scrubBodyForRecording returns the specified body (sent to or received from the specified host) with any secrets
+// replaced - unlike the HTTP Trace the structure of the body is retained, since this needs to be parsed when it's replayed
+func scrubBodyForRecording(host string, contentType string, payload []byte) string {
+	if len(payload) == 0 {
+		return ""
+	}
+
+	contentType = strings.ToLower(contentType)
+	if strings.Contains(contentType, "application/x-www-form-urlencoded") {
+		return redactFormBody(payload)
+	}
+
+	if !strings.Contains(contentType, "json") {
+		return string(payload)
+	}
+
+	// numbers are retained as-is, rather than being parsed into a float
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+
+	var parsed interface{}
+	if err := decoder.Decode(&parsed); err != nil {
+		return string(payload)
+	}
+
+	scrubbed, err := json.Marshal(scrubJSONValue(host, parsed, false))
+	if err != nil {
+		return string(payload)
+	}
+
+	return string(scrubbed)
+}
+
+// scrubJSONValue replaces any secret values within the specified JSON value - where `withinSecret` is
+// true the `value` fields are also replaced, e.g. `"keys": [{"keyName": "key1", "value": "abc123"}]`
+func scrubJSONValue(host string, input interface{}, withinSecret bool) interface{} {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			isSecret := isSecretBodyField(host, key)
+			if _, isString := value.(string); isString && (isSecret || (withinSecret && strings.EqualFold(key, "value"))) {
+				v[key] = recordingSecretValue
+				continue
+			}
+
+			v[key] = scrubJSONValue(host, value, isSecret)
+		}
+		return v
+
+	case []interface{}:
+		for i, value := range v {
+			v[i] = scrubJSONValue(host, value, withinSecret)
+		}
+		return v
+
+	case string:
+		if withinSecret {
+			return recordingSecretValue
+		}
+	}
+
+	return input
+}
@@ -0,0 +1,294 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestRecordingRecordAndReplay(t *testing.T) {
+	directory, err := ioutil.TempDir("", "recordings")
+	if err != nil {
+		t.Fatalf("Error creating temporary directory: %+v", err)
+	}
+	defer os.RemoveAll(directory)
+
+	defer os.Setenv(recordingsDirEnvVar, os.Getenv(recordingsDirEnvVar)) // nolint: errcheck
+	defer os.Setenv(recordingModeEnvVar, os.Getenv(recordingModeEnvVar)) // nolint: errcheck
+	os.Setenv(recordingsDirEnvVar, directory)                            // nolint: errcheck
+
+	subscriptionId := "11111111-1111-1111-1111-111111111111"
+
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"id":"/subscriptions/%s/resourceGroups/example","properties":{"primaryKey":"abc123","count":12345678901234567890}}`, subscriptionId)
+	}))
+	defer server.Close()
+
+	ScrubValuesFromRecordings(map[string]string{
+		subscriptionId: RecordingPlaceholderID,
+	})
+
+	uri := fmt.Sprintf("%s/subscriptions/%s/resourceGroups/example?api-version=2019-05-01", server.URL, subscriptionId)
+
+	// record
+	os.Setenv(recordingModeEnvVar, string(RecordingModeRecord)) // nolint: errcheck
+	armRecorder.cassettes = make(map[string]*Cassette)
+	recordedName := RecordedValue("name", func() string { return "recorded" })
+	StartRecording(t)
+	recorded := sendRecordingRequest(t, uri)
+
+	if strings.Contains(recorded, "cmVkYWN0ZWQ=") {
+		t.Fatalf("Expected the live response to be returned unmodified but got %q", recorded)
+	}
+
+	payload, err := ioutil.ReadFile(filepath.Join(directory, fmt.Sprintf("%s.json", t.Name())))
+	if err != nil {
+		t.Fatalf("Error reading cassette: %+v", err)
+	}
+	for _, secret := range []string{subscriptionId, "abc123"} {
+		if strings.Contains(string(payload), secret) {
+			t.Fatalf("Expected %q to be scrubbed from the cassette but it wasn't: %s", secret, payload)
+		}
+	}
+
+	// replay
+	os.Setenv(recordingModeEnvVar, string(RecordingModeReplay)) // nolint: errcheck
+	armRecorder.cassettes = make(map[string]*Cassette)
+	replayedName := RecordedValue("name", func() string { return "replayed" })
+	StartRecording(t)
+	replayed := sendRecordingRequest(t, uri)
+
+	if replayedName != recordedName {
+		t.Fatalf("Expected the replayed value to be %q but got %q", recordedName, replayedName)
+	}
+
+	if v := atomic.LoadInt32(&requests); v != 1 {
+		t.Fatalf("Expected 1 request to be sent but got %d", v)
+	}
+
+	var parsed struct {
+		ID         string `json:"id"`
+		Properties struct {
+			PrimaryKey string      `json:"primaryKey"`
+			Count      json.Number `json:"count"`
+		} `json:"properties"`
+	}
+	if err := json.Unmarshal([]byte(replayed), &parsed); err != nil {
+		t.Fatalf("Error parsing replayed response %q: %+v", replayed, err)
+	}
+	if parsed.ID != fmt.Sprintf("/subscriptions/%s/resourceGroups/example", RecordingPlaceholderID) {
+		t.Fatalf("Expected the Subscription ID to be scrubbed but got %q", parsed.ID)
+	}
+	if parsed.Properties.PrimaryKey != recordingSecretValue {
+		t.Fatalf("Expected the Primary Key to be scrubbed but got %q", parsed.Properties.PrimaryKey)
+	}
+	if parsed.Properties.Count.String() != "12345678901234567890" {
+		t.Fatalf("Expected the count to be retained but got %q", parsed.Properties.Count.String())
+	}
+
+	// polling the same URI again returns the last matching interaction
+	sendRecordingRequest(t, uri)
+
+	// whereas a request which wasn't recorded returns an error
+	req, _ := http.NewRequest(http.MethodDelete, uri, nil)
+	if _, err := autorest.DecorateSender(&http.Client{}, WithRecording()).Do(req); err == nil {
+		t.Fatalf("Expected an error replaying a request which wasn't recorded but didn't get one")
+	}
+}
+
+func TestRecordingKeyVaultSecretRoundTrip(t *testing.T) {
+	directory, err := ioutil.TempDir("", "recordings")
+	if err != nil {
+		t.Fatalf("Error creating temporary directory: %+v", err)
+	}
+	defer os.RemoveAll(directory)
+
+	defer os.Setenv(recordingsDirEnvVar, os.Getenv(recordingsDirEnvVar)) // nolint: errcheck
+	defer os.Setenv(recordingModeEnvVar, os.Getenv(recordingModeEnvVar)) // nolint: errcheck
+	os.Setenv(recordingsDirEnvVar, directory)                            // nolint: errcheck
+
+	secretId := "https://example.vault.azure.net/secrets/example/abc123"
+	uri := "https://example.vault.azure.net/secrets/example?api-version=2016-10-01"
+
+	// Key Vault echoes the value of the Secret when it's set, and returns it when it's retrieved
+	var requests int32
+	keyVault := autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&requests, 1)
+		body, err := json.Marshal(keyvault.SecretBundle{
+			ID:          utils.String(secretId),
+			Value:       utils.String("sup3r-s3cr3t"),
+			ContentType: utils.String("text/plain"),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json; charset=utf-8"}},
+			Body:       ioutil.NopCloser(bytes.NewReader(body)),
+			Request:    r,
+		}, nil
+	})
+
+	setSecret := func() *http.Request {
+		body, err := json.Marshal(keyvault.SecretSetParameters{
+			Value:       utils.String("sup3r-s3cr3t"),
+			ContentType: utils.String("text/plain"),
+		})
+		if err != nil {
+			t.Fatalf("Error serializing: %+v", err)
+		}
+
+		req, err := http.NewRequest(http.MethodPut, uri, bytes.NewReader(body))
+		if err != nil {
+			t.Fatalf("Error building request: %+v", err)
+		}
+		req.Header.Set("Content-Type", "application/json; charset=utf-8")
+		return req
+	}
+	getSecret := func() *http.Request {
+		req, err := http.NewRequest(http.MethodGet, uri, nil)
+		if err != nil {
+			t.Fatalf("Error building request: %+v", err)
+		}
+		return req
+	}
+
+	// record
+	os.Setenv(recordingModeEnvVar, string(RecordingModeRecord)) // nolint: errcheck
+	armRecorder.cassettes = make(map[string]*Cassette)
+	StartRecording(t)
+	for _, req := range []*http.Request{setSecret(), getSecret()} {
+		if recorded := sendRecordingRequestWithSender(t, keyVault, req); !strings.Contains(recorded, "sup3r-s3cr3t") {
+			t.Fatalf("Expected the live response to be returned unmodified but got %q", recorded)
+		}
+	}
+
+	payload, err := ioutil.ReadFile(filepath.Join(directory, fmt.Sprintf("%s.json", t.Name())))
+	if err != nil {
+		t.Fatalf("Error reading cassette: %+v", err)
+	}
+	if strings.Contains(string(payload), "sup3r-s3cr3t") {
+		t.Fatalf("Expected the value of the Secret to be scrubbed from the cassette but it wasn't: %s", payload)
+	}
+	for _, value := range []string{secretId, "text/plain"} {
+		if !strings.Contains(string(payload), value) {
+			t.Fatalf("Expected %q to be retained in the cassette but it wasn't: %s", value, payload)
+		}
+	}
+
+	// replay
+	os.Setenv(recordingModeEnvVar, string(RecordingModeReplay)) // nolint: errcheck
+	armRecorder.cassettes = make(map[string]*Cassette)
+	StartRecording(t)
+	for _, req := range []*http.Request{setSecret(), getSecret()} {
+		replayed := sendRecordingRequestWithSender(t, keyVault, req)
+
+		var secret keyvault.SecretBundle
+		if err := json.Unmarshal([]byte(replayed), &secret); err != nil {
+			t.Fatalf("Error parsing replayed response %q: %+v", replayed, err)
+		}
+		if secret.ID == nil || *secret.ID != secretId {
+			t.Fatalf("Expected the ID of the Secret to be %q but got %v", secretId, secret.ID)
+		}
+		if secret.Value == nil || *secret.Value != recordingSecretValue {
+			t.Fatalf("Expected the value of the Secret to be scrubbed but got %v", secret.Value)
+		}
+	}
+
+	if v := atomic.LoadInt32(&requests); v != 2 {
+		t.Fatalf("Expected 2 requests to be sent to Key Vault but got %d", v)
+	}
+
+	// a request with a body which differs from the one which was recorded isn't matched
+	req := setSecret()
+	req.Body = ioutil.NopCloser(strings.NewReader(`{"value":"sup3r-s3cr3t","contentType":"application/json"}`))
+	if _, err := autorest.DecorateSender(keyVault, WithRecording()).Do(req); err == nil {
+		t.Fatalf("Expected an error replaying a request with a different body but didn't get one")
+	}
+}
+
+func TestScrubJSONValue(t *testing.T) {
+	input := `{"keys":[{"keyName":"key1","value":"abc123"}],"adminPassword":"P@ssw0rd1234!","name":"example"}`
+
+	var parsed interface{}
+	if err := json.Unmarshal([]byte(input), &parsed); err != nil {
+		t.Fatalf("Error parsing: %+v", err)
+	}
+
+	output, err := json.Marshal(scrubJSONValue("management.azure.com", parsed, false))
+	if err != nil {
+		t.Fatalf("Error serializing: %+v", err)
+	}
+
+	expected := `{"adminPassword":"cmVkYWN0ZWQ=","keys":[{"keyName":"key1","value":"cmVkYWN0ZWQ="}],"name":"example"}`
+	if string(output) != expected {
+		t.Fatalf("Expected %s but got %s", expected, output)
+	}
+}
+
+func TestCallingTestName(t *testing.T) {
+	if actual := callingTestName(); actual != "TestCallingTestName" {
+		t.Fatalf("Expected `TestCallingTestName` but got %q", actual)
+	}
+
+	func() {
+		if actual := callingTestName(); actual != "TestCallingTestName" {
+			t.Fatalf("Expected `TestCallingTestName` from a closure but got %q", actual)
+		}
+	}()
+}
+
+func sendRecordingRequestWithSender(t *testing.T, s autorest.Sender, req *http.Request) string {
+	resp, err := autorest.DecorateSender(s, WithRecording()).Do(req.WithContext(context.Background()))
+	if err != nil {
+		t.Fatalf("Error sending request: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("Error reading body: %+v", err)
+	}
+
+	return string(body)
+}
+
+func sendRecordingRequest(t *testing.T, uri string) string {
+	req, err := http.NewRequest(http.MethodGet, uri, nil)
+	if err != nil {
+		t.Fatalf("Error building request: %+v", err)
+	}
+
+	sender := autorest.DecorateSender(&http.Client{}, WithRecording())
+	resp, err := sender.Do(req.WithContext(context.Background()))
+	if err != nil {
+		t.Fatalf("Error sending request: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("Error reading body: %+v", err)
+	}
+
+	return string(body)
+}
@@ -0,0 +1,226 @@
+package common
+
+import (
+	"log"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const (
+	headerRateLimitRemainingSubscriptionReads   = "x-ms-ratelimit-remaining-subscription-reads"
+	headerRateLimitRemainingSubscriptionWrites  = "x-ms-ratelimit-remaining-subscription-writes"
+	headerRateLimitRemainingSubscriptionDeletes = "x-ms-ratelimit-remaining-subscription-deletes"
+	headerRetryAfter                            = "Retry-After"
+)
+
+type operationClass string
+
+const (
+	operationClassReads   operationClass = "reads"
+	operationClassWrites  operationClass = "writes"
+	operationClassDeletes operationClass = "deletes"
+)
+
+var subscriptionIdInPath = regexp.MustCompile(`(?i)/subscriptions/([^/]+)`)
+
+// armThrottling is the throttling tracker shared between all of the clients, since ARM
+// applies its rate limits per Subscription (rather than per API/client)
+var armThrottling = newThrottlingTracker()
+
+type throttlingKey struct {
+	host           string
+	subscriptionId string
+	class          operationClass
+}
+
+type throttlingBudget struct {
+	// remaining is the number of requests remaining, as last reported by ARM (less those sent since)
+	// or -1 if this isn't known
+	remaining int
+
+	// blockedUntil is the time until which no further requests should be sent, as a result of being throttled
+	blockedUntil time.Time
+}
+
+// throttlingTracker tracks the remaining request budget reported by Azure Resource Manager, per Subscription
+// and per class of operation (reads/writes/deletes), such that requests can be paced as this budget runs low
+// and (once throttled) requests wait until the time specified in the `Retry-After` header
+type throttlingTracker struct {
+	sync.Mutex
+	budgets map[throttlingKey]*throttlingBudget
+
+	// lowWatermark is the number of remaining requests below which requests start being paced
+	lowWatermark int
+
+	// delayPerRequest is the delay added for each request below the lowWatermark
+	delayPerRequest time.Duration
+
+	// maxPacingDelay is the maximum delay added when pacing requests
+	maxPacingDelay time.Duration
+
+	// defaultRetryAfter is used when a request is throttled but no `Retry-After` header was returned
+	defaultRetryAfter time.Duration
+}
+
+func newThrottlingTracker() *throttlingTracker {
+	return &throttlingTracker{
+		budgets:           make(map[throttlingKey]*throttlingBudget),
+		lowWatermark:      100,
+		delayPerRequest:   100 * time.Millisecond,
+		maxPacingDelay:    10 * time.Second,
+		defaultRetryAfter: 10 * time.Second,
+	}
+}
+
+// WithPacing returns a SendDecorator which delays requests when the remaining request budget
+// is running low or the Subscription is currently being throttled
+func (t *throttlingTracker) WithPacing() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			key := throttlingKeyForRequest(r)
+
+			if delay := t.reserve(key); delay > 0 {
+				log.Printf("[DEBUG] Delaying %s request to %q by %s to avoid being throttled by Azure (Subscription %q)", r.Method, r.URL.Path, delay, key.subscriptionId)
+				select {
+				case <-time.After(delay):
+				case <-r.Context().Done():
+					return nil, r.Context().Err()
+				}
+			}
+
+			resp, err := s.Do(r)
+			if resp != nil {
+				t.observe(key, resp)
+			}
+			return resp, err
+		})
+	}
+}
+
+// reserve returns how long the next request should be delayed by, and accounts for it in the remaining budget
+func (t *throttlingTracker) reserve(key throttlingKey) time.Duration {
+	t.Lock()
+	defer t.Unlock()
+
+	budget, ok := t.budgets[key]
+	if !ok {
+		return 0
+	}
+
+	if wait := time.Until(budget.blockedUntil); wait > 0 {
+		return wait
+	}
+
+	if budget.remaining < 0 {
+		return 0
+	}
+
+	remaining := budget.remaining
+	if budget.remaining > 0 {
+		budget.remaining--
+	}
+
+	if remaining >= t.lowWatermark {
+		return 0
+	}
+
+	delay := time.Duration(t.lowWatermark-remaining) * t.delayPerRequest
+	if delay > t.maxPacingDelay {
+		delay = t.maxPacingDelay
+	}
+	return delay
+}
+
+// observe updates the budget using the rate-limit headers returned from ARM
+func (t *throttlingTracker) observe(key throttlingKey, resp *http.Response) {
+	t.Lock()
+	defer t.Unlock()
+
+	budget, ok := t.budgets[key]
+	if !ok {
+		budget = &throttlingBudget{
+			remaining: -1,
+		}
+		t.budgets[key] = budget
+	}
+
+	if v := resp.Header.Get(rateLimitHeaderForOperationClass(key.class)); v != "" {
+		if remaining, err := strconv.Atoi(v); err == nil {
+			budget.remaining = remaining
+		}
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		retryAfter := parseRetryAfter(resp.Header.Get(headerRetryAfter))
+		if retryAfter <= 0 {
+			retryAfter = t.defaultRetryAfter
+		}
+
+		if blockedUntil := time.Now().Add(retryAfter); blockedUntil.After(budget.blockedUntil) {
+			budget.blockedUntil = blockedUntil
+		}
+
+		log.Printf("[DEBUG] Azure is throttling %s for Subscription %q - pausing these requests for %s", key.class, key.subscriptionId, retryAfter)
+	}
+}
+
+func throttlingKeyForRequest(r *http.Request) throttlingKey {
+	key := throttlingKey{
+		class: operationClassForMethod(r.Method),
+	}
+
+	if r.URL != nil {
+		key.host = strings.ToLower(r.URL.Host)
+		if match := subscriptionIdInPath.FindStringSubmatch(r.URL.Path); len(match) == 2 {
+			key.subscriptionId = strings.ToLower(match[1])
+		}
+	}
+
+	return key
+}
+
+func operationClassForMethod(method string) operationClass {
+	switch strings.ToUpper(method) {
+	case http.MethodDelete:
+		return operationClassDeletes
+	case http.MethodGet, http.MethodHead:
+		return operationClassReads
+	}
+
+	return operationClassWrites
+}
+
+func rateLimitHeaderForOperationClass(class operationClass) string {
+	switch class {
+	case operationClassDeletes:
+		return headerRateLimitRemainingSubscriptionDeletes
+	case operationClassReads:
+		return headerRateLimitRemainingSubscriptionReads
+	}
+
+	return headerRateLimitRemainingSubscriptionWrites
+}
+
+// parseRetryAfter parses the value of a `Retry-After` header, which can be either a
+// number of seconds or a date in RFC1123 format
+func parseRetryAfter(input string) time.Duration {
+	if input == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(input); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if t, err := time.Parse(time.RFC1123, input); err == nil {
+		return time.Until(t)
+	}
+
+	return 0
+}
@@ -0,0 +1,186 @@
+package common
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestThrottlingKeyForRequest(t *testing.T) {
+	cases := []struct {
+		Method         string
+		URL            string
+		SubscriptionId string
+		Class          operationClass
+	}{
+		{
+			Method:         http.MethodGet,
+			URL:            "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1?api-version=2019-05-01",
+			SubscriptionId: "00000000-0000-0000-0000-000000000000",
+			Class:          operationClassReads,
+		},
+		{
+			Method:         http.MethodPut,
+			URL:            "https://management.azure.com/Subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1?api-version=2019-05-01",
+			SubscriptionId: "00000000-0000-0000-0000-000000000000",
+			Class:          operationClassWrites,
+		},
+		{
+			Method:         http.MethodPost,
+			URL:            "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Web/checkNameAvailability",
+			SubscriptionId: "00000000-0000-0000-0000-000000000000",
+			Class:          operationClassWrites,
+		},
+		{
+			Method:         http.MethodDelete,
+			URL:            "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			SubscriptionId: "00000000-0000-0000-0000-000000000000",
+			Class:          operationClassDeletes,
+		},
+		{
+			Method:         http.MethodGet,
+			URL:            "https://graph.windows.net/tenant/applications",
+			SubscriptionId: "",
+			Class:          operationClassReads,
+		},
+	}
+
+	for _, tc := range cases {
+		req, err := http.NewRequest(tc.Method, tc.URL, nil)
+		if err != nil {
+			t.Fatalf("Error building request: %+v", err)
+		}
+
+		key := throttlingKeyForRequest(req)
+		if key.subscriptionId != tc.SubscriptionId {
+			t.Fatalf("Expected the Subscription ID for %q to be %q but got %q", tc.URL, tc.SubscriptionId, key.subscriptionId)
+		}
+		if key.class != tc.Class {
+			t.Fatalf("Expected the Operation Class for %s %q to be %q but got %q", tc.Method, tc.URL, tc.Class, key.class)
+		}
+	}
+}
+
+func TestThrottlingTrackerPacesWhenBudgetIsLow(t *testing.T) {
+	tracker := newThrottlingTracker()
+	key := throttlingKey{
+		subscriptionId: "00000000-0000-0000-0000-000000000000",
+		class:          operationClassReads,
+	}
+
+	if delay := tracker.reserve(key); delay != 0 {
+		t.Fatalf("Expected no delay when the budget is unknown but got %s", delay)
+	}
+
+	tracker.observe(key, responseWithHeaders(http.StatusOK, map[string]string{
+		headerRateLimitRemainingSubscriptionReads: "11999",
+	}))
+	if delay := tracker.reserve(key); delay != 0 {
+		t.Fatalf("Expected no delay when the budget is high but got %s", delay)
+	}
+
+	tracker.observe(key, responseWithHeaders(http.StatusOK, map[string]string{
+		headerRateLimitRemainingSubscriptionReads: "90",
+	}))
+	first := tracker.reserve(key)
+	if first <= 0 {
+		t.Fatalf("Expected a delay when the budget is low but didn't get one")
+	}
+
+	// since the budget is reserved, subsequent requests should be delayed further
+	if second := tracker.reserve(key); second <= first {
+		t.Fatalf("Expected the second delay (%s) to be longer than the first (%s)", second, first)
+	}
+
+	tracker.observe(key, responseWithHeaders(http.StatusOK, map[string]string{
+		headerRateLimitRemainingSubscriptionReads: "0",
+	}))
+	if delay := tracker.reserve(key); delay != tracker.maxPacingDelay {
+		t.Fatalf("Expected the delay to be capped at %s but got %s", tracker.maxPacingDelay, delay)
+	}
+
+	// writes are tracked separately
+	writes := throttlingKey{
+		subscriptionId: key.subscriptionId,
+		class:          operationClassWrites,
+	}
+	if delay := tracker.reserve(writes); delay != 0 {
+		t.Fatalf("Expected no delay for writes but got %s", delay)
+	}
+}
+
+func TestThrottlingTrackerHonoursRetryAfter(t *testing.T) {
+	tracker := newThrottlingTracker()
+	key := throttlingKey{
+		subscriptionId: "00000000-0000-0000-0000-000000000000",
+		class:          operationClassWrites,
+	}
+
+	tracker.observe(key, responseWithHeaders(http.StatusTooManyRequests, map[string]string{
+		headerRetryAfter: "30",
+	}))
+
+	delay := tracker.reserve(key)
+	if delay <= 25*time.Second || delay > 30*time.Second {
+		t.Fatalf("Expected a delay of ~30s but got %s", delay)
+	}
+}
+
+func TestThrottlingTrackerWithPacing(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateLimitRemainingSubscriptionDeletes, "42")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	tracker := newThrottlingTracker()
+	sender := autorest.DecorateSender(&http.Client{}, tracker.WithPacing())
+
+	req, err := http.NewRequest(http.MethodDelete, server.URL+"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1", nil)
+	if err != nil {
+		t.Fatalf("Error building request: %+v", err)
+	}
+
+	resp, err := sender.Do(req)
+	if err != nil {
+		t.Fatalf("Error sending request: %+v", err)
+	}
+	resp.Body.Close()
+
+	budget, ok := tracker.budgets[throttlingKeyForRequest(req)]
+	if !ok {
+		t.Fatalf("Expected a budget to be tracked but it wasn't")
+	}
+	if budget.remaining != 42 {
+		t.Fatalf("Expected the remaining budget to be 42 but got %d", budget.remaining)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	if actual := parseRetryAfter(""); actual != 0 {
+		t.Fatalf("Expected 0 for an empty value but got %s", actual)
+	}
+
+	if actual := parseRetryAfter("17"); actual != 17*time.Second {
+		t.Fatalf("Expected 17s but got %s", actual)
+	}
+
+	future := time.Now().Add(time.Minute).UTC().Format(time.RFC1123)
+	if actual := parseRetryAfter(future); actual <= 0 || actual > time.Minute {
+		t.Fatalf("Expected ~1m for %q but got %s", future, actual)
+	}
+}
+
+func responseWithHeaders(statusCode int, headers map[string]string) *http.Response {
+	resp := &http.Response{
+		StatusCode: statusCode,
+		Header:     http.Header{},
+	}
+	for k, v := range headers {
+		resp.Header.Set(k, v)
+	}
+	return resp
+}
@@ -0,0 +1,65 @@
+package features
+
+// Expand parses the `features` block from the Provider configuration into the UserFeatures
+// which should be used - falling back to the defaults for any fields which aren't specified
+func Expand(input []interface{}) UserFeatures {
+	features := Default()
+
+	if len(input) == 0 || input[0] == nil {
+		return features
+	}
+
+	val := input[0].(map[string]interface{})
+
+	if v, ok := val["requires_import"]; ok {
+		features.RequiresImport = v.(bool)
+	}
+
+	if raw, ok := val["key_vault"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			keyVaultRaw := items[0].(map[string]interface{})
+			if v, ok := keyVaultRaw["purge_soft_delete_on_destroy"]; ok {
+				features.KeyVault.PurgeSoftDeleteOnDestroy = v.(bool)
+			}
+			if v, ok := keyVaultRaw["recover_soft_deleted_key_vaults"]; ok {
+				features.KeyVault.RecoverSoftDeletedKeyVaults = v.(bool)
+			}
+			if v, ok := keyVaultRaw["recover_soft_deleted_key_vault_objects"]; ok {
+				features.KeyVault.RecoverSoftDeletedKeyVaultObjects = v.(bool)
+			}
+		}
+	}
+
+	if raw, ok := val["resource_group"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			resourceGroupRaw := items[0].(map[string]interface{})
+			if v, ok := resourceGroupRaw["prevent_deletion_if_contains_resources"]; ok {
+				features.ResourceGroup.PreventDeletionIfContainsResources = v.(bool)
+			}
+		}
+	}
+
+	if raw, ok := val["virtual_machine"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			virtualMachineRaw := items[0].(map[string]interface{})
+			if v, ok := virtualMachineRaw["delete_os_disk_on_deletion"]; ok {
+				features.VirtualMachine.DeleteOSDiskOnDeletion = v.(bool)
+			}
+		}
+	}
+
+	if raw, ok := val["virtual_machine_scale_set"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			scaleSetRaw := items[0].(map[string]interface{})
+			if v, ok := scaleSetRaw["roll_instances_when_required"]; ok {
+				features.VirtualMachineScaleSet.RollInstancesWhenRequired = v.(bool)
+			}
+		}
+	}
+
+	return features
+}
@@ -0,0 +1,235 @@
+package features
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestExpandFeatures(t *testing.T) {
+	testData := []struct {
+		name     string
+		input    []interface{}
+		expected UserFeatures
+	}{
+		{
+			name:  "Empty Block",
+			input: []interface{}{},
+			expected: UserFeatures{
+				RequiresImport: false,
+				KeyVault: KeyVaultFeatures{
+					PurgeSoftDeleteOnDestroy:          false,
+					RecoverSoftDeletedKeyVaults:       false,
+					RecoverSoftDeletedKeyVaultObjects: false,
+				},
+				ResourceGroup: ResourceGroupFeatures{
+					PreventDeletionIfContainsResources: false,
+				},
+				VirtualMachine: VirtualMachineFeatures{
+					DeleteOSDiskOnDeletion: false,
+				},
+				VirtualMachineScaleSet: VirtualMachineScaleSetFeatures{
+					RollInstancesWhenRequired: true,
+				},
+			},
+		},
+		{
+			name: "Complete Enabled",
+			input: []interface{}{
+				map[string]interface{}{
+					"requires_import": true,
+					"key_vault": []interface{}{
+						map[string]interface{}{
+							"purge_soft_delete_on_destroy":           true,
+							"recover_soft_deleted_key_vaults":        true,
+							"recover_soft_deleted_key_vault_objects": true,
+						},
+					},
+					"resource_group": []interface{}{
+						map[string]interface{}{
+							"prevent_deletion_if_contains_resources": true,
+						},
+					},
+					"virtual_machine": []interface{}{
+						map[string]interface{}{
+							"delete_os_disk_on_deletion": true,
+						},
+					},
+					"virtual_machine_scale_set": []interface{}{
+						map[string]interface{}{
+							"roll_instances_when_required": true,
+						},
+					},
+				},
+			},
+			expected: UserFeatures{
+				RequiresImport: true,
+				KeyVault: KeyVaultFeatures{
+					PurgeSoftDeleteOnDestroy:          true,
+					RecoverSoftDeletedKeyVaults:       true,
+					RecoverSoftDeletedKeyVaultObjects: true,
+				},
+				ResourceGroup: ResourceGroupFeatures{
+					PreventDeletionIfContainsResources: true,
+				},
+				VirtualMachine: VirtualMachineFeatures{
+					DeleteOSDiskOnDeletion: true,
+				},
+				VirtualMachineScaleSet: VirtualMachineScaleSetFeatures{
+					RollInstancesWhenRequired: true,
+				},
+			},
+		},
+		{
+			name: "Complete Disabled",
+			input: []interface{}{
+				map[string]interface{}{
+					"requires_import": false,
+					"key_vault": []interface{}{
+						map[string]interface{}{
+							"purge_soft_delete_on_destroy":           false,
+							"recover_soft_deleted_key_vaults":        false,
+							"recover_soft_deleted_key_vault_objects": false,
+						},
+					},
+					"resource_group": []interface{}{
+						map[string]interface{}{
+							"prevent_deletion_if_contains_resources": false,
+						},
+					},
+					"virtual_machine": []interface{}{
+						map[string]interface{}{
+							"delete_os_disk_on_deletion": false,
+						},
+					},
+					"virtual_machine_scale_set": []interface{}{
+						map[string]interface{}{
+							"roll_instances_when_required": false,
+						},
+					},
+				},
+			},
+			expected: UserFeatures{
+				RequiresImport: false,
+				KeyVault: KeyVaultFeatures{
+					PurgeSoftDeleteOnDestroy:          false,
+					RecoverSoftDeletedKeyVaults:       false,
+					RecoverSoftDeletedKeyVaultObjects: false,
+				},
+				ResourceGroup: ResourceGroupFeatures{
+					PreventDeletionIfContainsResources: false,
+				},
+				VirtualMachine: VirtualMachineFeatures{
+					DeleteOSDiskOnDeletion: false,
+				},
+				VirtualMachineScaleSet: VirtualMachineScaleSetFeatures{
+					RollInstancesWhenRequired: false,
+				},
+			},
+		},
+		{
+			name: "Nested Blocks Omitted",
+			input: []interface{}{
+				map[string]interface{}{
+					"requires_import":           true,
+					"key_vault":                 []interface{}{},
+					"resource_group":            []interface{}{},
+					"virtual_machine":           []interface{}{},
+					"virtual_machine_scale_set": []interface{}{},
+				},
+			},
+			expected: UserFeatures{
+				RequiresImport: true,
+				KeyVault: KeyVaultFeatures{
+					PurgeSoftDeleteOnDestroy:          false,
+					RecoverSoftDeletedKeyVaults:       false,
+					RecoverSoftDeletedKeyVaultObjects: false,
+				},
+				ResourceGroup: ResourceGroupFeatures{
+					PreventDeletionIfContainsResources: false,
+				},
+				VirtualMachine: VirtualMachineFeatures{
+					DeleteOSDiskOnDeletion: false,
+				},
+				VirtualMachineScaleSet: VirtualMachineScaleSetFeatures{
+					RollInstancesWhenRequired: true,
+				},
+			},
+		},
+		{
+			name: "Virtual Machine Scale Set Block Without Fields",
+			input: []interface{}{
+				map[string]interface{}{
+					"virtual_machine_scale_set": []interface{}{
+						map[string]interface{}{},
+					},
+				},
+			},
+			expected: UserFeatures{
+				RequiresImport: false,
+				KeyVault: KeyVaultFeatures{
+					PurgeSoftDeleteOnDestroy:          false,
+					RecoverSoftDeletedKeyVaults:       false,
+					RecoverSoftDeletedKeyVaultObjects: false,
+				},
+				ResourceGroup: ResourceGroupFeatures{
+					PreventDeletionIfContainsResources: false,
+				},
+				VirtualMachine: VirtualMachineFeatures{
+					DeleteOSDiskOnDeletion: false,
+				},
+				VirtualMachineScaleSet: VirtualMachineScaleSetFeatures{
+					RollInstancesWhenRequired: true,
+				},
+			},
+		},
+	}
+
+	os.Setenv("ARM_PROVIDER_STRICT", "")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test %q..", v.name)
+
+		actual := Expand(v.input)
+		if !reflect.DeepEqual(actual, v.expected) {
+			t.Fatalf("Expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
+
+func TestExpandFeaturesDefaultsFromEnvironment(t *testing.T) {
+	os.Setenv("ARM_PROVIDER_STRICT", "true")
+	defer os.Setenv("ARM_PROVIDER_STRICT", "")
+
+	actual := Expand([]interface{}{})
+	if !actual.RequiresImport {
+		t.Fatalf("Expected `RequiresImport` to default to the value of `ARM_PROVIDER_STRICT` but it didn't")
+	}
+}
+
+func TestExpandFeaturesFromSchemaDefaults(t *testing.T) {
+	os.Setenv("ARM_PROVIDER_STRICT", "")
+
+	// when the `virtual_machine_scale_set` block is specified without `roll_instances_when_required`
+	// the value from the Schema is used, which must match the default
+	raw := map[string]interface{}{
+		"features": []interface{}{
+			map[string]interface{}{
+				"virtual_machine_scale_set": []interface{}{
+					map[string]interface{}{},
+				},
+			},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{"features": Schema()}, raw)
+
+	if v := d.Get("features.0.virtual_machine_scale_set.0.roll_instances_when_required").(bool); !v {
+		t.Fatalf("Expected the Schema to default `roll_instances_when_required` to true but got false")
+	}
+
+	actual := Expand(d.Get("features").([]interface{}))
+	if !actual.VirtualMachineScaleSet.RollInstancesWhenRequired {
+		t.Fatalf("Expected `roll_instances_when_required` to default to true but got false")
+	}
+}
@@ -6,7 +6,7 @@ import (
 )
 
 // ShouldResourcesBeImported returns whether the feature Requiring Resources to be Imported
-// should be enabled.
+// should be enabled by default.
 //
 // This feature prevents Terraform from 'adopting' resources which already exist, which is the
 // behaviour used by ARM Templates which will update these resources rather than overwriting them
@@ -18,7 +18,10 @@ import (
 // for v2.0 of the Azure Provider: https://github.com/terraform-providers/terraform-provider-azurerm/issues/2807
 //
 // Operators wishing to adopt this behaviour can opt-into this behaviour in 1.x versions of the
-// Azure Provider by setting the Environment Variable 'ARM_PROVIDER_STRICT' to 'true'
+// Azure Provider by setting `requires_import` to `true` within the `features` block of the Provider,
+// or by setting the Environment Variable 'ARM_PROVIDER_STRICT' to 'true' - which is used as the default.
+//
+// Resources should check `Features.RequiresImport` on the Client rather than calling this directly.
 func ShouldResourcesBeImported() bool {
 	// NOTE: we'll need to add an infobox to the following resources when this goes live:
 	// * App Service Source Control Token
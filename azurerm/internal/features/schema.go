@@ -0,0 +1,97 @@
+package features
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Schema returns the Schema used for the `features` block within the Provider
+func Schema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"requires_import": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("ARM_PROVIDER_STRICT", false),
+					Description: "Should existing resources be required to be imported into the State before they can be managed?",
+				},
+
+				"key_vault": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"purge_soft_delete_on_destroy": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Description: "Should a Key Vault with Soft Delete enabled be purged (permanently deleted) when it's destroyed?",
+							},
+
+							"recover_soft_deleted_key_vaults": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Description: "Should a soft-deleted Key Vault with the same name be recovered when a Key Vault is created?",
+							},
+
+							"recover_soft_deleted_key_vault_objects": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Description: "Should a soft-deleted Certificate, Key or Secret with the same name be recovered when one is created within a Key Vault?",
+							},
+						},
+					},
+				},
+
+				"resource_group": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"prevent_deletion_if_contains_resources": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Description: "Should the deletion of a Resource Group fail when it still contains Resources?",
+							},
+						},
+					},
+				},
+
+				"virtual_machine": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"delete_os_disk_on_deletion": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Description: "Should the OS Disk attached to a Virtual Machine be deleted when the Virtual Machine is deleted?",
+							},
+						},
+					},
+				},
+
+				"virtual_machine_scale_set": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"roll_instances_when_required": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Default:     true,
+								Description: "Should the instances within a Virtual Machine Scale Set be upgraded to the latest model when the Scale Set is updated?",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
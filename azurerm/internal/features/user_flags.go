@@ -0,0 +1,72 @@
+package features
+
+// UserFeatures contains the behaviours which can be opted into by users via the
+// `features` block within the Provider configuration
+type UserFeatures struct {
+	// RequiresImport specifies whether existing resources must be imported into
+	// the State before they can be managed by Terraform
+	RequiresImport bool
+
+	KeyVault               KeyVaultFeatures
+	ResourceGroup          ResourceGroupFeatures
+	VirtualMachine         VirtualMachineFeatures
+	VirtualMachineScaleSet VirtualMachineScaleSetFeatures
+}
+
+// KeyVaultFeatures contains the behaviours which apply to Key Vaults
+type KeyVaultFeatures struct {
+	// PurgeSoftDeleteOnDestroy specifies whether a Key Vault which has Soft Delete enabled
+	// should be purged (permanently deleted) when it's destroyed
+	PurgeSoftDeleteOnDestroy bool
+
+	// RecoverSoftDeletedKeyVaults specifies whether a soft-deleted Key Vault with the same
+	// name should be recovered (rather than failing) when a Key Vault is created
+	RecoverSoftDeletedKeyVaults bool
+
+	// RecoverSoftDeletedKeyVaultObjects specifies whether a soft-deleted Certificate, Key or Secret
+	// with the same name should be recovered (rather than failing) when one is created within a Key Vault
+	RecoverSoftDeletedKeyVaultObjects bool
+}
+
+// ResourceGroupFeatures contains the behaviours which apply to Resource Groups
+type ResourceGroupFeatures struct {
+	// PreventDeletionIfContainsResources specifies whether the deletion of a Resource Group
+	// should fail when it still contains Resources (e.g. those not managed by Terraform)
+	PreventDeletionIfContainsResources bool
+}
+
+// VirtualMachineFeatures contains the behaviours which apply to Virtual Machines
+type VirtualMachineFeatures struct {
+	// DeleteOSDiskOnDeletion specifies whether the OS Disk attached to a Virtual Machine
+	// should be deleted when the Virtual Machine is deleted
+	DeleteOSDiskOnDeletion bool
+}
+
+// VirtualMachineScaleSetFeatures contains the behaviours which apply to Virtual Machine Scale Sets
+type VirtualMachineScaleSetFeatures struct {
+	// RollInstancesWhenRequired specifies whether the instances within a Virtual Machine Scale Set
+	// should be upgraded to the latest model when the Scale Set is updated
+	RollInstancesWhenRequired bool
+}
+
+// Default returns the default set of User Features, which are used when the
+// `features` block (or a field within it) isn't specified
+func Default() UserFeatures {
+	return UserFeatures{
+		RequiresImport: ShouldResourcesBeImported(),
+		KeyVault: KeyVaultFeatures{
+			PurgeSoftDeleteOnDestroy:          false,
+			RecoverSoftDeletedKeyVaults:       false,
+			RecoverSoftDeletedKeyVaultObjects: false,
+		},
+		ResourceGroup: ResourceGroupFeatures{
+			PreventDeletionIfContainsResources: false,
+		},
+		VirtualMachine: VirtualMachineFeatures{
+			DeleteOSDiskOnDeletion: false,
+		},
+		VirtualMachineScaleSet: VirtualMachineScaleSetFeatures{
+			RollInstancesWhenRequired: true,
+		},
+	}
+}
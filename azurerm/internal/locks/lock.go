@@ -1,37 +1,275 @@
 package locks
 
-import "github.com/hashicorp/terraform/helper/mutexkv"
+import (
+	"context"
+	"fmt"
+	"log"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
 
-// armMutexKV is the instance of MutexKV for ARM resources
-var armMutexKV = mutexkv.NewMutexKV()
+// armLocks contains the locks for ARM resources, keyed by either the Resource ID or
+// the Resource Type and Name - these are created on demand and never removed
+var armLocks = keyedLocks{
+	locks: make(map[string]*keyedLock),
+}
 
-func ByID(id string) {
-	armMutexKV.Lock(id)
+type keyedLocks struct {
+	sync.Mutex
+	locks map[string]*keyedLock
+}
+
+type keyedLock struct {
+	// semaphore is a buffered channel with a capacity of 1 which is written to when the
+	// lock is acquired and read from when it's released, allowing us to select on it
+	semaphore chan struct{}
+
+	// holder is the name of the function which currently holds this lock
+	holder string
+
+	// acquiredAt is when the current holder acquired this lock
+	acquiredAt time.Time
+}
+
+func (k *keyedLocks) get(key string) *keyedLock {
+	k.Lock()
+	defer k.Unlock()
+
+	l, ok := k.locks[key]
+	if !ok {
+		l = &keyedLock{
+			semaphore: make(chan struct{}, 1),
+		}
+		k.locks[key] = l
+	}
+
+	return l
+}
+
+func (k *keyedLocks) currentHolder(l *keyedLock) (string, time.Duration) {
+	k.Lock()
+	defer k.Unlock()
+
+	if l.holder == "" {
+		return "unknown", 0
+	}
+
+	return l.holder, time.Since(l.acquiredAt).Round(time.Second)
+}
+
+func (k *keyedLocks) setHolder(l *keyedLock, holder string) {
+	k.Lock()
+	defer k.Unlock()
+
+	l.holder = holder
+	l.acquiredAt = time.Now()
+}
+
+func (k *keyedLocks) acquire(ctx context.Context, key string) error {
+	l := k.get(key)
+	caller := callerName()
+
+	// fast path: the lock isn't contended
+	select {
+	case l.semaphore <- struct{}{}:
+		k.setHolder(l, caller)
+		return nil
+	default:
+	}
+
+	holder, heldFor := k.currentHolder(l)
+	log.Printf("[DEBUG] %s is waiting for the lock %q which has been held by %s for %s", caller, key, holder, heldFor)
+
+	select {
+	case l.semaphore <- struct{}{}:
+		k.setHolder(l, caller)
+		log.Printf("[DEBUG] %s acquired the lock %q", caller, key)
+		return nil
+
+	case <-ctx.Done():
+		holder, heldFor := k.currentHolder(l)
+		return fmt.Errorf("Error acquiring the lock %q (held by %s for %s): %+v", key, holder, heldFor, ctx.Err())
+	}
+}
+
+func (k *keyedLocks) release(key string) {
+	l := k.get(key)
+
+	k.Lock()
+	l.holder = ""
+	l.acquiredAt = time.Time{}
+	k.Unlock()
+
+	select {
+	case <-l.semaphore:
+	default:
+		panic(fmt.Sprintf("attempted to unlock the lock %q which isn't locked", key))
+	}
+}
+
+// callerName returns the name of the first function in the call stack outside of this package,
+// which is used to log which resource is holding a lock
+func callerName() string {
+	pcs := make([]uintptr, 10)
+	n := runtime.Callers(2, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	for {
+		frame, more := frames.Next()
+		if !strings.Contains(frame.Function, "/internal/locks.") {
+			if idx := strings.LastIndex(frame.Function, "/"); idx != -1 {
+				return frame.Function[idx+1:]
+			}
+			return frame.Function
+		}
+
+		if !more {
+			return "unknown"
+		}
+	}
 }
 
 // handle the case of using the same name for different kinds of resources
+func keyForName(name string, resourceType string) string {
+	return resourceType + "." + name
+}
+
+// sortedUniqueNames returns a sorted copy of the specified names with any duplicates removed,
+// such that multiple locks are always acquired in the same order regardless of the order
+// they were specified in, which avoids deadlocks between resources locking the same names
+func sortedUniqueNames(names *[]string) []string {
+	output := make([]string, 0)
+	if names == nil {
+		return output
+	}
+
+	seen := make(map[string]struct{})
+	for _, name := range *names {
+		if _, ok := seen[name]; ok {
+			continue
+		}
+
+		seen[name] = struct{}{}
+		output = append(output, name)
+	}
+
+	sort.Strings(output)
+	return output
+}
+
+// NamesOfType is a set of Names of a single Resource Type which should be locked
+type NamesOfType struct {
+	ResourceType string
+	Names        *[]string
+}
+
+// sortedUniqueKeys returns the sorted unique keys for the Names across each of the specified
+// Resource Types, which ensures that locks spanning multiple Resource Types are always acquired
+// in a single consistent order, regardless of which resource is acquiring them
+func sortedUniqueKeys(names []NamesOfType) []string {
+	keys := make([]string, 0)
+	for _, v := range names {
+		for _, name := range sortedUniqueNames(v.Names) {
+			keys = append(keys, keyForName(name, v.ResourceType))
+		}
+	}
+
+	return sortedUniqueNames(&keys)
+}
+
+// ByID acquires the lock for the specified ID, blocking until it's available
+func ByID(id string) {
+	_ = armLocks.acquire(context.Background(), id)
+}
+
+// ByIDWithContext acquires the lock for the specified ID, returning an error if
+// the context is cancelled or times out before the lock becomes available
+func ByIDWithContext(ctx context.Context, id string) error {
+	return armLocks.acquire(ctx, id)
+}
+
+// ByName acquires the lock for the specified Name of the specified Resource Type,
+// blocking until it's available
 func ByName(name string, resourceType string) {
-	updatedName := resourceType + "." + name
-	armMutexKV.Lock(updatedName)
+	_ = armLocks.acquire(context.Background(), keyForName(name, resourceType))
 }
 
+// ByNameWithContext acquires the lock for the specified Name of the specified Resource Type,
+// returning an error if the context is cancelled or times out before the lock becomes available
+func ByNameWithContext(ctx context.Context, name string, resourceType string) error {
+	return armLocks.acquire(ctx, keyForName(name, resourceType))
+}
+
+// MultipleByName acquires the locks for each of the specified Names of the specified
+// Resource Type in a consistent order, blocking until they're all available
 func MultipleByName(names *[]string, resourceType string) {
-	for _, name := range *names {
-		ByName(name, resourceType)
+	_ = MultipleByNameWithContext(context.Background(), names, resourceType)
+}
+
+// MultipleByNameWithContext acquires the locks for each of the specified Names of the specified
+// Resource Type in a consistent order. If the context is cancelled or times out before all of the
+// locks become available, any locks which have been acquired are released and an error is returned.
+func MultipleByNameWithContext(ctx context.Context, names *[]string, resourceType string) error {
+	acquired := make([]string, 0)
+	for _, name := range sortedUniqueNames(names) {
+		if err := ByNameWithContext(ctx, name, resourceType); err != nil {
+			UnlockMultipleByName(&acquired, resourceType)
+			return err
+		}
+
+		acquired = append(acquired, name)
 	}
+
+	return nil
 }
 
+// UnlockByID releases the lock for the specified ID
 func UnlockByID(id string) {
-	armMutexKV.Unlock(id)
+	armLocks.release(id)
 }
 
+// UnlockByName releases the lock for the specified Name of the specified Resource Type
 func UnlockByName(name string, resourceType string) {
-	updatedName := resourceType + "." + name
-	armMutexKV.Unlock(updatedName)
+	armLocks.release(keyForName(name, resourceType))
 }
 
+// UnlockMultipleByName releases the locks for each of the specified Names of the specified Resource Type
 func UnlockMultipleByName(names *[]string, resourceType string) {
-	for _, name := range *names {
-		UnlockByName(name, resourceType)
+	unique := sortedUniqueNames(names)
+	for i := len(unique) - 1; i >= 0; i-- {
+		UnlockByName(unique[i], resourceType)
+	}
+}
+
+// MultipleByTypeWithContext acquires the locks for each of the specified Names across each of the
+// specified Resource Types in a consistent order. This should be used (rather than multiple calls to
+// MultipleByNameWithContext) whenever a resource needs to lock Names of more than one Resource Type,
+// so that the locks are always acquired in the same order. If the context is cancelled or times out
+// before all of the locks become available, any locks which have been acquired are released and an
+// error is returned.
+func MultipleByTypeWithContext(ctx context.Context, names ...NamesOfType) error {
+	acquired := make([]string, 0)
+	for _, key := range sortedUniqueKeys(names) {
+		if err := armLocks.acquire(ctx, key); err != nil {
+			for i := len(acquired) - 1; i >= 0; i-- {
+				armLocks.release(acquired[i])
+			}
+			return err
+		}
+
+		acquired = append(acquired, key)
+	}
+
+	return nil
+}
+
+// UnlockMultipleByType releases the locks for each of the specified Names across each of the specified Resource Types
+func UnlockMultipleByType(names ...NamesOfType) {
+	keys := sortedUniqueKeys(names)
+	for i := len(keys) - 1; i >= 0; i-- {
+		armLocks.release(keys[i])
 	}
 }
@@ -0,0 +1,182 @@
+package locks
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestByNameWithContextTimesOutWhenContended(t *testing.T) {
+	ByName("contended", "test")
+	defer UnlockByName("contended", "test")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := ByNameWithContext(ctx, "contended", "test")
+	if err == nil {
+		t.Fatalf("Expected an error acquiring a contended lock but didn't get one")
+	}
+
+	if !strings.Contains(err.Error(), "held by") || strings.Contains(err.Error(), "held by unknown") {
+		t.Fatalf("Expected the error to contain the name of the lock holder but got: %s", err)
+	}
+}
+
+func TestByNameWithContextAcquiresWhenReleased(t *testing.T) {
+	ByName("released", "test")
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		UnlockByName("released", "test")
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := ByNameWithContext(ctx, "released", "test"); err != nil {
+		t.Fatalf("Expected no error but got: %s", err)
+	}
+	UnlockByName("released", "test")
+}
+
+func TestMultipleByNameWithContextReleasesOnFailure(t *testing.T) {
+	ByName("c", "test")
+	defer UnlockByName("c", "test")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	names := []string{"c", "a", "b"}
+	if err := MultipleByNameWithContext(ctx, &names, "test"); err == nil {
+		t.Fatalf("Expected an error acquiring a contended lock but didn't get one")
+	}
+
+	// since "a" and "b" were released these should be immediately available
+	ctx2, cancel2 := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel2()
+
+	released := []string{"a", "b"}
+	if err := MultipleByNameWithContext(ctx2, &released, "test"); err != nil {
+		t.Fatalf("Expected the locks to have been released but got: %s", err)
+	}
+	UnlockMultipleByName(&released, "test")
+}
+
+func TestMultipleByNameWithContextDuplicates(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	names := []string{"duplicate", "duplicate"}
+	if err := MultipleByNameWithContext(ctx, &names, "test"); err != nil {
+		t.Fatalf("Expected no error locking duplicate names but got: %s", err)
+	}
+	UnlockMultipleByName(&names, "test")
+}
+
+func TestSortedUniqueNames(t *testing.T) {
+	cases := []struct {
+		Input    *[]string
+		Expected []string
+	}{
+		{
+			Input:    nil,
+			Expected: []string{},
+		},
+		{
+			Input:    &[]string{"subnet2", "subnet1"},
+			Expected: []string{"subnet1", "subnet2"},
+		},
+		{
+			Input:    &[]string{"subnet2", "subnet1", "subnet2"},
+			Expected: []string{"subnet1", "subnet2"},
+		},
+	}
+
+	for _, tc := range cases {
+		actual := sortedUniqueNames(tc.Input)
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("Expected %+v but got %+v", tc.Expected, actual)
+		}
+	}
+}
+
+func TestSortedUniqueKeys(t *testing.T) {
+	// the same locks specified in a different order must be acquired in the same order
+	first := sortedUniqueKeys([]NamesOfType{
+		{ResourceType: "vnet", Names: &[]string{"network1"}},
+		{ResourceType: "subnet", Names: &[]string{"subnet2", "subnet1"}},
+	})
+	second := sortedUniqueKeys([]NamesOfType{
+		{ResourceType: "subnet", Names: &[]string{"subnet1", "subnet2", "subnet1"}},
+		{ResourceType: "vnet", Names: &[]string{"network1"}},
+		{ResourceType: "nsg", Names: nil},
+	})
+
+	expected := []string{"subnet.subnet1", "subnet.subnet2", "vnet.network1"}
+	if !reflect.DeepEqual(first, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, first)
+	}
+	if !reflect.DeepEqual(second, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, second)
+	}
+}
+
+func TestMultipleByTypeWithContextOppositeOrders(t *testing.T) {
+	vnetThenSubnet := []NamesOfType{
+		{ResourceType: "vnet", Names: &[]string{"ordering"}},
+		{ResourceType: "subnet", Names: &[]string{"ordering"}},
+	}
+	subnetThenVnet := []NamesOfType{
+		{ResourceType: "subnet", Names: &[]string{"ordering"}},
+		{ResourceType: "vnet", Names: &[]string{"ordering"}},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	errs := make(chan error, 200)
+	for i := 0; i < 100; i++ {
+		for _, names := range [][]NamesOfType{vnetThenSubnet, subnetThenVnet} {
+			go func(names []NamesOfType) {
+				if err := MultipleByTypeWithContext(ctx, names...); err != nil {
+					errs <- err
+					return
+				}
+				UnlockMultipleByType(names...)
+				errs <- nil
+			}(names)
+		}
+	}
+
+	for i := 0; i < 200; i++ {
+		if err := <-errs; err != nil {
+			t.Fatalf("Expected no error (deadlock?) but got: %s", err)
+		}
+	}
+}
+
+func TestMultipleByTypeWithContextReleasesOnFailure(t *testing.T) {
+	ByName("contended", "typeB")
+	defer UnlockByName("contended", "typeB")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	names := []NamesOfType{
+		{ResourceType: "typeA", Names: &[]string{"available"}},
+		{ResourceType: "typeB", Names: &[]string{"contended"}},
+	}
+	if err := MultipleByTypeWithContext(ctx, names...); err == nil {
+		t.Fatalf("Expected an error acquiring a contended lock but didn't get one")
+	}
+
+	ctx2, cancel2 := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel2()
+
+	if err := ByNameWithContext(ctx2, "available", "typeA"); err != nil {
+		t.Fatalf("Expected the lock to have been released but got: %s", err)
+	}
+	UnlockByName("available", "typeA")
+}
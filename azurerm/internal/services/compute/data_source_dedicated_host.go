@@ -0,0 +1,114 @@
+package compute
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmDedicatedHost() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmDedicatedHostRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"dedicated_host_group_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
+
+			"location": azure.SchemaLocationForDataSource(),
+
+			"sku_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"platform_fault_domain": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"auto_replace_on_failure": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"license_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tags.SchemaDataSource(),
+		},
+	}
+}
+
+func dataSourceArmDedicatedHostRead(d *schema.ResourceData, meta interface{}) error {
+	client := clients.FromMeta(meta).Compute.DedicatedHostsClient
+	ctx, cancel := timeouts.ForRead(clients.FromMeta(meta).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	hostGroupName := d.Get("dedicated_host_group_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	resp, err := client.Get(ctx, resourceGroup, hostGroupName, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error: Dedicated Host %q (Host Group %q / Resource Group %q) was not found", name, hostGroupName, resourceGroup)
+		}
+
+		return fmt.Errorf("Error retrieving Dedicated Host %q (Host Group %q / Resource Group %q): %+v", name, hostGroupName, resourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Error retrieving Dedicated Host %q (Host Group %q / Resource Group %q): ID was nil", name, hostGroupName, resourceGroup)
+	}
+	d.SetId(*resp.ID)
+
+	d.Set("name", name)
+	d.Set("dedicated_host_group_name", hostGroupName)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	skuName := ""
+	if resp.Sku != nil && resp.Sku.Name != nil {
+		skuName = *resp.Sku.Name
+	}
+	d.Set("sku_name", skuName)
+
+	if props := resp.DedicatedHostProperties; props != nil {
+		d.Set("auto_replace_on_failure", props.AutoReplaceOnFailure)
+		d.Set("license_type", string(props.LicenseType))
+
+		platformFaultDomain := 0
+		if props.PlatformFaultDomain != nil {
+			platformFaultDomain = int(*props.PlatformFaultDomain)
+		}
+		d.Set("platform_fault_domain", platformFaultDomain)
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
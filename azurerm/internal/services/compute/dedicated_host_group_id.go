@@ -0,0 +1,78 @@
+package compute
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DedicatedHostGroupID is a typed representation of the ID of a Dedicated Host Group
+type DedicatedHostGroupID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewDedicatedHostGroupID returns a new DedicatedHostGroupID from the specified segments
+func NewDedicatedHostGroupID(subscriptionId, resourceGroup, name string) DedicatedHostGroupID {
+	return DedicatedHostGroupID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Dedicated Host Group
+func (id DedicatedHostGroupID) String() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/hostGroups/%s", id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseDedicatedHostGroupID parses the specified Resource ID into a DedicatedHostGroupID, returning an error
+// if the ID isn't a valid Dedicated Host Group ID
+func ParseDedicatedHostGroupID(input string) (*DedicatedHostGroupID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Dedicated Host Group ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Compute") {
+		return nil, fmt.Errorf("Error parsing Dedicated Host Group ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Compute", id.Provider)
+	}
+
+	resourceId := DedicatedHostGroupID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("Error parsing Dedicated Host Group ID %q: ID was missing the `resourceGroups` element", input)
+	}
+
+	if resourceId.Name, err = id.PopSegment("hostGroups"); err != nil {
+		return nil, fmt.Errorf("Error parsing Dedicated Host Group ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoRemainingSegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Dedicated Host Group ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDedicatedHostGroupID validates that the specified value is a valid Dedicated Host Group ID
+func ValidateDedicatedHostGroupID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseDedicatedHostGroupID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Dedicated Host Group ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,136 @@
+package compute
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"testing"
+)
+
+func TestDedicatedHostIDFormatter(t *testing.T) {
+	actual := NewDedicatedHostID("12345678-1234-9876-4563-123456789012", "resourceGroup1", "hostGroup1", "name1").String()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resourceGroup1/providers/Microsoft.Compute/hostGroups/hostGroup1/hosts/name1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestParseDedicatedHostID(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    string
+		Expected *DedicatedHostID
+	}{
+		{
+			Name:     "Empty",
+			Input:    "",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing subscriptions Segment",
+			Input:    "/resourceGroups/resourceGroup1/providers/Microsoft.Compute/hostGroups/hostGroup1/hosts/name1",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing subscriptions Value",
+			Input:    "/subscriptions//resourceGroups/resourceGroup1/providers/Microsoft.Compute/hostGroups/hostGroup1/hosts/name1",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing resourceGroups Segment",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Compute/hostGroups/hostGroup1/hosts/name1",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing resourceGroups Value",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups//providers/Microsoft.Compute/hostGroups/hostGroup1/hosts/name1",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing hostGroups Segment",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resourceGroup1/providers/Microsoft.Compute/hosts/name1",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing hostGroups Value",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resourceGroup1/providers/Microsoft.Compute/hostGroups//hosts/name1",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing hosts Segment",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resourceGroup1/providers/Microsoft.Compute/hostGroups/hostGroup1",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing hosts Value",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resourceGroup1/providers/Microsoft.Compute/hostGroups/hostGroup1/hosts/",
+			Expected: nil,
+		},
+		{
+			Name:     "Wrong Resource Provider",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resourceGroup1/providers/Microsoft.Example/hostGroups/hostGroup1/hosts/name1",
+			Expected: nil,
+		},
+		{
+			Name:     "Unexpected Segment",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resourceGroup1/providers/Microsoft.Compute/hostGroups/hostGroup1/hosts/name1/extra/value1",
+			Expected: nil,
+		},
+		{
+			Name:  "Valid",
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resourceGroup1/providers/Microsoft.Compute/hostGroups/hostGroup1/hosts/name1",
+			Expected: &DedicatedHostID{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "resourceGroup1",
+				HostGroupName:  "hostGroup1",
+				Name:           "name1",
+			},
+		},
+		{
+			Name:  "Different Casing",
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/resourceGroup1/providers/microsoft.compute/HOSTGROUPS/hostGroup1/HOSTS/name1",
+			Expected: &DedicatedHostID{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "resourceGroup1",
+				HostGroupName:  "hostGroup1",
+				Name:           "name1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := ParseDedicatedHostID(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Expected == nil {
+			t.Fatalf("Expected an error but got a value for %q", v.Input)
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+
+		if actual.HostGroupName != v.Expected.HostGroupName {
+			t.Fatalf("Expected %q but got %q for HostGroupName", v.Expected.HostGroupName, actual.HostGroupName)
+		}
+
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+
+		if _, errors := ValidateDedicatedHostID(v.Input, "id"); len(errors) > 0 {
+			t.Fatalf("Expected %q to be a valid ID but got: %+v", v.Input, errors)
+		}
+	}
+}
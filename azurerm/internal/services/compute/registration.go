@@ -13,10 +13,20 @@ func (r Registration) Name() string {
 
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*schema.Resource {
-	return map[string]*schema.Resource{}
+	return map[string]*schema.Resource{
+		"azurerm_dedicated_host":       dataSourceArmDedicatedHost(),
+		"azurerm_dedicated_host_group": dataSourceArmDedicatedHostGroup(),
+	}
 }
 
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*schema.Resource {
-	return map[string]*schema.Resource{}
+	return map[string]*schema.Resource{
+		"azurerm_dedicated_host":                    resourceArmDedicatedHost(),
+		"azurerm_dedicated_host_group":              resourceArmDedicatedHostGroup(),
+		"azurerm_linux_virtual_machine":             resourceArmLinuxVirtualMachine(),
+		"azurerm_linux_virtual_machine_scale_set":   resourceArmLinuxVirtualMachineScaleSet(),
+		"azurerm_windows_virtual_machine":           resourceArmWindowsVirtualMachine(),
+		"azurerm_windows_virtual_machine_scale_set": resourceArmWindowsVirtualMachineScaleSet(),
+	}
 }
@@ -0,0 +1,204 @@
+package compute
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmDedicatedHostGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDedicatedHostGroupCreate,
+		Read:   resourceArmDedicatedHostGroupRead,
+		Update: resourceArmDedicatedHostGroupUpdate,
+		Delete: resourceArmDedicatedHostGroupDelete,
+
+		Importer: azure.ValidateResourceIDPriorToImport(ValidateDedicatedHostGroupID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"platform_fault_domain_count": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 3),
+			},
+
+			// the API only supports a single zone per Dedicated Host Group
+			"zones": azure.SchemaSingleZone(),
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceArmDedicatedHostGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	client := clients.FromMeta(meta).Compute.DedicatedHostGroupsClient
+	ctx, cancel := timeouts.ForCreate(clients.FromMeta(meta).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if clients.FromMeta(meta).Features.RequiresImport {
+		existing, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for existing Dedicated Host Group %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_dedicated_host_group", *existing.ID)
+		}
+	}
+
+	parameters := compute.DedicatedHostGroup{
+		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
+		DedicatedHostGroupProperties: &compute.DedicatedHostGroupProperties{
+			PlatformFaultDomainCount: utils.Int32(int32(d.Get("platform_fault_domain_count").(int))),
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if zones := azure.ExpandZones(d.Get("zones").([]interface{})); zones != nil {
+		parameters.Zones = zones
+	}
+
+	log.Printf("[DEBUG] Creating Dedicated Host Group %q (Resource Group %q)..", name, resourceGroup)
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters); err != nil {
+		return fmt.Errorf("Error creating Dedicated Host Group %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	log.Printf("[DEBUG] Created Dedicated Host Group %q (Resource Group %q).", name, resourceGroup)
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Dedicated Host Group %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Error retrieving Dedicated Host Group %q (Resource Group %q): ID was nil", name, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmDedicatedHostGroupRead(d, meta)
+}
+
+func resourceArmDedicatedHostGroupRead(d *schema.ResourceData, meta interface{}) error {
+	client := clients.FromMeta(meta).Compute.DedicatedHostGroupsClient
+	ctx, cancel := timeouts.ForRead(clients.FromMeta(meta).StopContext, d)
+	defer cancel()
+
+	id, err := ParseDedicatedHostGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Dedicated Host Group %q was not found in Resource Group %q - removing from state!", id.Name, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Dedicated Host Group %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	if props := resp.DedicatedHostGroupProperties; props != nil {
+		platformFaultDomainCount := 0
+		if props.PlatformFaultDomainCount != nil {
+			platformFaultDomainCount = int(*props.PlatformFaultDomainCount)
+		}
+		d.Set("platform_fault_domain_count", platformFaultDomainCount)
+	}
+
+	if err := d.Set("zones", utils.FlattenStringSlice(resp.Zones)); err != nil {
+		return fmt.Errorf("Error setting `zones`: %+v", err)
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceArmDedicatedHostGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := clients.FromMeta(meta).Compute.DedicatedHostGroupsClient
+	ctx, cancel := timeouts.ForUpdate(clients.FromMeta(meta).StopContext, d)
+	defer cancel()
+
+	id, err := ParseDedicatedHostGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// only the tags can be updated on a Dedicated Host Group
+	parameters := compute.DedicatedHostGroupUpdate{
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	log.Printf("[DEBUG] Updating Dedicated Host Group %q (Resource Group %q)..", id.Name, id.ResourceGroup)
+	if _, err := client.Update(ctx, id.ResourceGroup, id.Name, parameters); err != nil {
+		return fmt.Errorf("Error updating Dedicated Host Group %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+	log.Printf("[DEBUG] Updated Dedicated Host Group %q (Resource Group %q).", id.Name, id.ResourceGroup)
+
+	return resourceArmDedicatedHostGroupRead(d, meta)
+}
+
+func resourceArmDedicatedHostGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := clients.FromMeta(meta).Compute.DedicatedHostGroupsClient
+	ctx, cancel := timeouts.ForDelete(clients.FromMeta(meta).StopContext, d)
+	defer cancel()
+
+	id, err := ParseDedicatedHostGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Dedicated Host Group %q (Resource Group %q)..", id.Name, id.ResourceGroup)
+	resp, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Dedicated Host Group %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+	log.Printf("[DEBUG] Deleted Dedicated Host Group %q (Resource Group %q).", id.Name, id.ResourceGroup)
+
+	return nil
+}
@@ -0,0 +1,804 @@
+package compute
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	networkSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmLinuxVirtualMachine() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmLinuxVirtualMachineCreate,
+		Read:   resourceArmLinuxVirtualMachineRead,
+		Update: resourceArmLinuxVirtualMachineUpdate,
+		Delete: resourceArmLinuxVirtualMachineDelete,
+
+		Importer: azure.ValidateResourceIDPriorToImport(ValidateVirtualMachineID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(45 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(45 * time.Minute),
+			Delete: schema.DefaultTimeout(45 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: ValidateLinuxName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			// Required
+			"admin_username": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"network_interface_ids": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: networkSvc.ValidateNetworkInterfaceID,
+				},
+			},
+
+			"os_disk": virtualMachineOSDiskSchema(),
+
+			"size": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			// Optional
+			"admin_password": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"admin_ssh_key": sshKeysSchema(),
+
+			"allow_extension_operations": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"availability_set_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
+				ConflictsWith: []string{
+					"zone",
+				},
+			},
+
+			"boot_diagnostics": bootDiagnosticsSchema(),
+
+			"computer_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: ValidateLinuxComputerName,
+			},
+
+			"custom_data": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validate.Base64String(),
+			},
+
+			"dedicated_host_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     ValidateDedicatedHostID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
+			},
+
+			"disable_password_authentication": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+
+			"eviction_policy": {
+				// only applicable when `priority` is set to `Low`
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(compute.Deallocate),
+					string(compute.Delete),
+				}, false),
+			},
+
+			"identity": virtualMachineIdentitySchema(),
+
+			"max_bid_price": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Default:      -1,
+				ValidateFunc: validate.FloatAtLeast(-1.0),
+			},
+
+			"plan": planSchema(),
+
+			"priority": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(compute.Regular),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(compute.Regular),
+					string(compute.Low),
+				}, false),
+			},
+
+			"provision_vm_agent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				ForceNew: true,
+			},
+
+			"proximity_placement_group_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
+			},
+
+			"secret": linuxSecretSchema(),
+
+			"source_image_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
+			},
+
+			"source_image_reference": sourceImageReferenceSchema(true),
+
+			"tags": tags.Schema(),
+
+			"zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ConflictsWith: []string{
+					"availability_set_id",
+				},
+			},
+
+			// Computed
+			"virtual_machine_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmLinuxVirtualMachineCreate(d *schema.ResourceData, meta interface{}) error {
+	client := clients.FromMeta(meta).Compute.VMClient
+	ctx, cancel := timeouts.ForCreate(clients.FromMeta(meta).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if err := locks.ByNameWithContext(ctx, name, virtualMachineResourceName); err != nil {
+		return err
+	}
+	defer locks.UnlockByName(name, virtualMachineResourceName)
+
+	if clients.FromMeta(meta).Features.RequiresImport {
+		existing, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for existing Linux Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_linux_virtual_machine", *existing.ID)
+		}
+	}
+
+	adminUsername := d.Get("admin_username").(string)
+	allowExtensionOperations := d.Get("allow_extension_operations").(bool)
+	bootDiagnosticsRaw := d.Get("boot_diagnostics").([]interface{})
+	bootDiagnostics := expandBootDiagnostics(bootDiagnosticsRaw)
+	identityRaw := d.Get("identity").([]interface{})
+	identity, err := expandVirtualMachineIdentity(identityRaw)
+	if err != nil {
+		return fmt.Errorf("Error expanding `identity`: %+v", err)
+	}
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	networkInterfaceIdsRaw := d.Get("network_interface_ids").([]interface{})
+	networkInterfaceIds := expandVirtualMachineNetworkInterfaceIDs(networkInterfaceIdsRaw)
+
+	osDiskRaw := d.Get("os_disk").([]interface{})
+	osDisk := expandVirtualMachineOSDisk(osDiskRaw, compute.Linux)
+
+	planRaw := d.Get("plan").([]interface{})
+	plan := expandPlan(planRaw)
+	priority := compute.VirtualMachinePriorityTypes(d.Get("priority").(string))
+	provisionVMAgent := d.Get("provision_vm_agent").(bool)
+	size := d.Get("size").(string)
+	t := d.Get("tags").(map[string]interface{})
+
+	sourceImageReferenceRaw := d.Get("source_image_reference").([]interface{})
+	sourceImageId := d.Get("source_image_id").(string)
+	sourceImageReference, err := expandSourceImageReference(sourceImageReferenceRaw, sourceImageId)
+	if err != nil {
+		return err
+	}
+
+	sshKeysRaw := d.Get("admin_ssh_key").(*schema.Set).List()
+	sshKeys := expandSSHKeys(sshKeysRaw)
+
+	secretsRaw := d.Get("secret").([]interface{})
+	secrets := expandVirtualMachineSecrets(secretsRaw)
+
+	var computerName string
+	if v, ok := d.GetOk("computer_name"); ok && len(v.(string)) > 0 {
+		computerName = v.(string)
+	} else {
+		computerName = name
+	}
+
+	disablePasswordAuthentication := d.Get("disable_password_authentication").(bool)
+	adminPassword := d.Get("admin_password").(string)
+	if disablePasswordAuthentication && adminPassword != "" {
+		return fmt.Errorf("`admin_password` cannot be specified when `disable_password_authentication` is set to `true`")
+	}
+	if disablePasswordAuthentication && len(sshKeys) == 0 {
+		return fmt.Errorf("at least one `admin_ssh_key` must be specified when `disable_password_authentication` is set to `true`")
+	}
+	if !disablePasswordAuthentication && adminPassword == "" {
+		return fmt.Errorf("an `admin_password` must be specified when `disable_password_authentication` is set to `false`")
+	}
+
+	params := compute.VirtualMachine{
+		Name:     utils.String(name),
+		Location: utils.String(location),
+		Identity: identity,
+		Plan:     plan,
+		VirtualMachineProperties: &compute.VirtualMachineProperties{
+			HardwareProfile: &compute.HardwareProfile{
+				VMSize: compute.VirtualMachineSizeTypes(size),
+			},
+			OsProfile: &compute.OSProfile{
+				AdminUsername:            utils.String(adminUsername),
+				ComputerName:             utils.String(computerName),
+				AllowExtensionOperations: utils.Bool(allowExtensionOperations),
+				LinuxConfiguration: &compute.LinuxConfiguration{
+					DisablePasswordAuthentication: utils.Bool(disablePasswordAuthentication),
+					ProvisionVMAgent:              utils.Bool(provisionVMAgent),
+					SSH: &compute.SSHConfiguration{
+						PublicKeys: &sshKeys,
+					},
+				},
+				Secrets: secrets,
+			},
+			NetworkProfile: &compute.NetworkProfile{
+				NetworkInterfaces: &networkInterfaceIds,
+			},
+			Priority: priority,
+			StorageProfile: &compute.StorageProfile{
+				ImageReference: sourceImageReference,
+				OsDisk:         osDisk,
+
+				// Data Disks are instead handled via the Association resource - as such we can send an empty value here
+				// but for Updates this'll need to be nil, else any associations will be overwritten
+				DataDisks: &[]compute.DataDisk{},
+			},
+
+			DiagnosticsProfile: bootDiagnostics,
+		},
+		Tags: tags.Expand(t),
+	}
+
+	if adminPassword != "" {
+		params.OsProfile.AdminPassword = utils.String(adminPassword)
+	}
+
+	if v, ok := d.GetOk("availability_set_id"); ok {
+		params.AvailabilitySet = &compute.SubResource{
+			ID: utils.String(v.(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("custom_data"); ok {
+		params.OsProfile.CustomData = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("eviction_policy"); ok {
+		if params.Priority != compute.Low {
+			return fmt.Errorf("An `eviction_policy` can only be specified when `priority` is set to `Low`")
+		}
+		params.EvictionPolicy = compute.VirtualMachineEvictionPolicyTypes(v.(string))
+	} else if priority == compute.Low {
+		return fmt.Errorf("An `eviction_policy` must be specified when `priority` is set to `Low`")
+	}
+
+	if v, ok := d.GetOk("max_bid_price"); ok {
+		maxBidPrice := v.(float64)
+		if maxBidPrice > -1 && priority != compute.Low {
+			return fmt.Errorf("`max_bid_price` can only be configured when `priority` is set to `Low`")
+		}
+
+		if priority == compute.Low {
+			params.BillingProfile = &compute.BillingProfile{
+				MaxPrice: utils.Float(maxBidPrice),
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("dedicated_host_id"); ok {
+		params.Host = &compute.SubResource{
+			ID: utils.String(v.(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("proximity_placement_group_id"); ok {
+		params.ProximityPlacementGroup = &compute.SubResource{
+			ID: utils.String(v.(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("zone"); ok {
+		params.Zones = &[]string{
+			v.(string),
+		}
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, params)
+	if err != nil {
+		return fmt.Errorf("Error creating Linux Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation of Linux Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Linux Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Error retrieving Linux Virtual Machine %q (Resource Group %q): `id` was nil", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+	return resourceArmLinuxVirtualMachineRead(d, meta)
+}
+
+func resourceArmLinuxVirtualMachineRead(d *schema.ResourceData, meta interface{}) error {
+	client := clients.FromMeta(meta).Compute.VMClient
+	ctx, cancel := timeouts.ForRead(clients.FromMeta(meta).StopContext, d)
+	defer cancel()
+
+	id, err := ParseVirtualMachineID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Linux Virtual Machine %q was not found in Resource Group %q - removing from state!", id.Name, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Linux Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	zone := ""
+	if resp.Zones != nil {
+		if z := *resp.Zones; len(z) > 0 {
+			zone = z[0]
+		}
+	}
+	d.Set("zone", zone)
+
+	if err := d.Set("identity", flattenVirtualMachineIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
+	if err := d.Set("plan", flattenPlan(resp.Plan)); err != nil {
+		return fmt.Errorf("Error setting `plan`: %+v", err)
+	}
+
+	if props := resp.VirtualMachineProperties; props != nil {
+		availabilitySetId := ""
+		if props.AvailabilitySet != nil && props.AvailabilitySet.ID != nil {
+			availabilitySetId = *props.AvailabilitySet.ID
+		}
+		d.Set("availability_set_id", availabilitySetId)
+
+		if err := d.Set("boot_diagnostics", flattenBootDiagnostics(props.DiagnosticsProfile)); err != nil {
+			return fmt.Errorf("Error setting `boot_diagnostics`: %+v", err)
+		}
+
+		d.Set("eviction_policy", string(props.EvictionPolicy))
+		d.Set("priority", string(props.Priority))
+
+		// the max bid price is only returned for Low Priority Virtual Machines
+		maxBidPrice := float64(-1.0)
+		if props.BillingProfile != nil && props.BillingProfile.MaxPrice != nil {
+			maxBidPrice = *props.BillingProfile.MaxPrice
+		}
+		d.Set("max_bid_price", maxBidPrice)
+
+		if profile := props.HardwareProfile; profile != nil {
+			d.Set("size", string(profile.VMSize))
+		}
+
+		if profile := props.NetworkProfile; profile != nil {
+			if err := d.Set("network_interface_ids", flattenVirtualMachineNetworkInterfaceIDs(profile.NetworkInterfaces)); err != nil {
+				return fmt.Errorf("Error setting `network_interface_ids`: %+v", err)
+			}
+		}
+
+		if profile := props.OsProfile; profile != nil {
+			d.Set("admin_username", profile.AdminUsername)
+			d.Set("allow_extension_operations", profile.AllowExtensionOperations)
+			d.Set("computer_name", profile.ComputerName)
+
+			if config := profile.LinuxConfiguration; config != nil {
+				d.Set("disable_password_authentication", config.DisablePasswordAuthentication)
+				d.Set("provision_vm_agent", config.ProvisionVMAgent)
+
+				flattenedSSHKeys, err := flattenSSHKeys(config.SSH)
+				if err != nil {
+					return fmt.Errorf("Error flattening `admin_ssh_key`: %+v", err)
+				}
+				if err := d.Set("admin_ssh_key", flattenedSSHKeys); err != nil {
+					return fmt.Errorf("Error setting `admin_ssh_key`: %+v", err)
+				}
+			}
+
+			if err := d.Set("secret", flattenVirtualMachineSecrets(profile.Secrets)); err != nil {
+				return fmt.Errorf("Error setting `secret`: %+v", err)
+			}
+		}
+
+		dedicatedHostId := ""
+		if props.Host != nil && props.Host.ID != nil {
+			dedicatedHostId = *props.Host.ID
+		}
+		d.Set("dedicated_host_id", dedicatedHostId)
+
+		proximityPlacementGroupId := ""
+		if props.ProximityPlacementGroup != nil && props.ProximityPlacementGroup.ID != nil {
+			proximityPlacementGroupId = *props.ProximityPlacementGroup.ID
+		}
+		d.Set("proximity_placement_group_id", proximityPlacementGroupId)
+
+		if profile := props.StorageProfile; profile != nil {
+			if err := d.Set("os_disk", flattenVirtualMachineOSDisk(profile.OsDisk)); err != nil {
+				return fmt.Errorf("Error settings `os_disk`: %+v", err)
+			}
+
+			var storageImageId string
+			if profile.ImageReference != nil && profile.ImageReference.ID != nil {
+				storageImageId = *profile.ImageReference.ID
+			}
+			d.Set("source_image_id", storageImageId)
+
+			if err := d.Set("source_image_reference", flattenSourceImageReference(profile.ImageReference)); err != nil {
+				return fmt.Errorf("Error setting `source_image_reference`: %+v", err)
+			}
+		}
+
+		d.Set("virtual_machine_id", props.VMID)
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceArmLinuxVirtualMachineUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := clients.FromMeta(meta).Compute.VMClient
+	disksClient := clients.FromMeta(meta).Compute.DisksClient
+	ctx, cancel := timeouts.ForUpdate(clients.FromMeta(meta).StopContext, d)
+	defer cancel()
+
+	id, err := ParseVirtualMachineID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := locks.ByNameWithContext(ctx, id.Name, virtualMachineResourceName); err != nil {
+		return err
+	}
+	defer locks.UnlockByName(id.Name, virtualMachineResourceName)
+
+	log.Printf("[DEBUG] Retrieving Linux Virtual Machine %q (Resource Group %q)..", id.Name, id.ResourceGroup)
+	existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Linux Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	log.Printf("[DEBUG] Retrieving InstanceView for Linux Virtual Machine %q (Resource Group %q)..", id.Name, id.ResourceGroup)
+	isRunning, err := virtualMachineIsRunning(ctx, client, id.ResourceGroup, id.Name)
+	if err != nil {
+		return err
+	}
+
+	shouldUpdate := false
+	shouldShutDown := false
+	shouldDeallocate := false
+
+	update := compute.VirtualMachineUpdate{
+		VirtualMachineProperties: &compute.VirtualMachineProperties{},
+	}
+
+	if d.HasChange("boot_diagnostics") {
+		shouldUpdate = true
+
+		bootDiagnosticsRaw := d.Get("boot_diagnostics").([]interface{})
+		update.VirtualMachineProperties.DiagnosticsProfile = expandBootDiagnostics(bootDiagnosticsRaw)
+	}
+
+	if d.HasChange("secret") || d.HasChange("allow_extension_operations") {
+		shouldUpdate = true
+
+		profile := compute.OSProfile{
+			AllowExtensionOperations: utils.Bool(d.Get("allow_extension_operations").(bool)),
+		}
+
+		if d.HasChange("secret") {
+			secretsRaw := d.Get("secret").([]interface{})
+			profile.Secrets = expandVirtualMachineSecrets(secretsRaw)
+		}
+
+		update.VirtualMachineProperties.OsProfile = &profile
+	}
+
+	if d.HasChange("identity") {
+		shouldUpdate = true
+
+		identityRaw := d.Get("identity").([]interface{})
+		identity, err := expandVirtualMachineIdentity(identityRaw)
+		if err != nil {
+			return fmt.Errorf("Error expanding `identity`: %+v", err)
+		}
+		update.Identity = identity
+	}
+
+	if d.HasChange("max_bid_price") {
+		shouldUpdate = true
+
+		// Low Priority VM's have to be deallocated to change the Max Bid Price
+		shouldShutDown = true
+		shouldDeallocate = true
+
+		maxBidPrice := d.Get("max_bid_price").(float64)
+		update.VirtualMachineProperties.BillingProfile = &compute.BillingProfile{
+			MaxPrice: utils.Float(maxBidPrice),
+		}
+	}
+
+	if d.HasChange("network_interface_ids") {
+		shouldUpdate = true
+
+		// Network Interfaces can only be changed whilst the Virtual Machine is deallocated
+		shouldShutDown = true
+		shouldDeallocate = true
+
+		networkInterfaceIdsRaw := d.Get("network_interface_ids").([]interface{})
+		networkInterfaceIds := expandVirtualMachineNetworkInterfaceIDs(networkInterfaceIdsRaw)
+
+		update.VirtualMachineProperties.NetworkProfile = &compute.NetworkProfile{
+			NetworkInterfaces: &networkInterfaceIds,
+		}
+	}
+
+	if d.HasChange("os_disk") {
+		shouldUpdate = true
+
+		// Updating the caching or write acceleration requires the Virtual Machine to be powered off
+		shouldShutDown = true
+
+		osDiskRaw := d.Get("os_disk").([]interface{})
+		osDisk := expandVirtualMachineOSDisk(osDiskRaw, compute.Linux)
+
+		// the OS Disk is resized via the Disks API below, so there's no need to send the size here
+		update.VirtualMachineProperties.StorageProfile = &compute.StorageProfile{
+			OsDisk: &compute.OSDisk{
+				Caching:                 osDisk.Caching,
+				WriteAcceleratorEnabled: osDisk.WriteAcceleratorEnabled,
+			},
+		}
+	}
+
+	if d.HasChange("size") {
+		shouldUpdate = true
+
+		// if the new size isn't available on the current hardware cluster the Virtual Machine has to be
+		// deallocated, so that it can be moved onto a cluster which supports it
+		requiresDeallocation, err := virtualMachineSizeRequiresDeallocation(ctx, client, id.ResourceGroup, id.Name, d.Get("size").(string))
+		if err != nil {
+			return err
+		}
+
+		if requiresDeallocation {
+			shouldShutDown = true
+			shouldDeallocate = true
+		}
+
+		update.VirtualMachineProperties.HardwareProfile = &compute.HardwareProfile{
+			VMSize: compute.VirtualMachineSizeTypes(d.Get("size").(string)),
+		}
+	}
+
+	if d.HasChange("tags") {
+		shouldUpdate = true
+
+		tagsRaw := d.Get("tags").(map[string]interface{})
+		update.Tags = tags.Expand(tagsRaw)
+	}
+
+	osDiskResized := false
+	if d.HasChange("os_disk.0.disk_size_gb") {
+		oldRaw, newRaw := d.GetChange("os_disk.0.disk_size_gb")
+		oldSize := oldRaw.(int)
+		newSize := newRaw.(int)
+
+		if newSize < oldSize {
+			return fmt.Errorf("`os_disk.0.disk_size_gb` can only be increased")
+		}
+
+		// the OS Disk can only be resized whilst the Virtual Machine is deallocated
+		osDiskResized = true
+		shouldShutDown = true
+		shouldDeallocate = true
+	}
+
+	if shouldShutDown {
+		if err := shutDownVirtualMachine(ctx, client, id.ResourceGroup, id.Name, shouldDeallocate); err != nil {
+			return err
+		}
+	}
+
+	if osDiskResized {
+		diskId := ""
+		if props := existing.VirtualMachineProperties; props != nil && props.StorageProfile != nil && props.StorageProfile.OsDisk != nil {
+			if disk := props.StorageProfile.OsDisk.ManagedDisk; disk != nil && disk.ID != nil {
+				diskId = *disk.ID
+			}
+		}
+
+		if diskId == "" {
+			return fmt.Errorf("Error determining the ID of the OS Disk for Linux Virtual Machine %q (Resource Group %q)", id.Name, id.ResourceGroup)
+		}
+
+		log.Printf("[DEBUG] Resizing OS Disk %q for Linux Virtual Machine %q (Resource Group %q)..", diskId, id.Name, id.ResourceGroup)
+		if err := resizeVirtualMachineOSDisk(ctx, disksClient, diskId, d.Get("os_disk.0.disk_size_gb").(int)); err != nil {
+			return err
+		}
+	}
+
+	if shouldUpdate {
+		log.Printf("[DEBUG] Updating Linux Virtual Machine %q (Resource Group %q)..", id.Name, id.ResourceGroup)
+		future, err := client.Update(ctx, id.ResourceGroup, id.Name, update)
+		if err != nil {
+			return fmt.Errorf("Error updating Linux Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for update of Linux Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+	}
+
+	// if we've shut the VM down to apply the change, we should only turn it back on if it was running previously
+	if shouldShutDown && isRunning {
+		if err := startVirtualMachine(ctx, client, id.ResourceGroup, id.Name); err != nil {
+			return err
+		}
+	}
+
+	return resourceArmLinuxVirtualMachineRead(d, meta)
+}
+
+func resourceArmLinuxVirtualMachineDelete(d *schema.ResourceData, meta interface{}) error {
+	client := clients.FromMeta(meta).Compute.VMClient
+	disksClient := clients.FromMeta(meta).Compute.DisksClient
+	ctx, cancel := timeouts.ForDelete(clients.FromMeta(meta).StopContext, d)
+	defer cancel()
+
+	id, err := ParseVirtualMachineID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := locks.ByNameWithContext(ctx, id.Name, virtualMachineResourceName); err != nil {
+		return err
+	}
+	defer locks.UnlockByName(id.Name, virtualMachineResourceName)
+
+	log.Printf("[DEBUG] Retrieving Linux Virtual Machine %q (Resource Group %q)..", id.Name, id.ResourceGroup)
+	existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Linux Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Linux Virtual Machine %q (Resource Group %q)..", id.Name, id.ResourceGroup)
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Linux Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of Linux Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	// the OS Disk is created alongside the Virtual Machine, so if opted in via the `features` block we remove it too
+	if clients.FromMeta(meta).Features.VirtualMachine.DeleteOSDiskOnDeletion {
+		if props := existing.VirtualMachineProperties; props != nil && props.StorageProfile != nil && props.StorageProfile.OsDisk != nil {
+			if disk := props.StorageProfile.OsDisk.ManagedDisk; disk != nil && disk.ID != nil {
+				log.Printf("[DEBUG] Deleting OS Disk %q for Linux Virtual Machine %q (Resource Group %q)..", *disk.ID, id.Name, id.ResourceGroup)
+				if err := deleteVirtualMachineOSDisk(ctx, disksClient, *disk.ID); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,935 @@
+package compute
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	networkSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmWindowsVirtualMachineScaleSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmWindowsVirtualMachineScaleSetCreate,
+		Read:   resourceArmWindowsVirtualMachineScaleSetRead,
+		Update: resourceArmWindowsVirtualMachineScaleSetUpdate,
+		Delete: resourceArmWindowsVirtualMachineScaleSetDelete,
+
+		Importer: azure.ValidateResourceIDPriorToImport(ValidateVirtualMachineScaleSetID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: ValidateWindowsName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			// Required
+			"admin_password": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"admin_username": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"instances": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 1000),
+			},
+
+			"network_interface": virtualMachineScaleSetNetworkInterfaceSchema(),
+
+			"os_disk": virtualMachineScaleSetOSDiskSchema(),
+
+			"sku": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			// Optional
+			"additional_unattend_content": additionalUnattendContentSchema(),
+
+			"automatic_os_upgrade_policy": virtualMachineScaleSetAutomatedOSUpgradePolicySchema(),
+
+			"boot_diagnostics": bootDiagnosticsSchema(),
+
+			"computer_name_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				// Computed since we reuse the `name` if this isn't specified
+				ValidateFunc: ValidateWindowsComputerNamePrefix,
+			},
+
+			"custom_data": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validate.Base64String(),
+			},
+
+			"data_disk": virtualMachineScaleSetDataDiskSchema(),
+
+			"do_not_run_extensions_on_overprovisioned_machines": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"enable_automatic_updates": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"eviction_policy": {
+				// only applicable when `priority` is set to `Low`
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(compute.Deallocate),
+					string(compute.Delete),
+				}, false),
+			},
+
+			"health_probe_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     networkSvc.ValidateLoadBalancerProbeID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
+			},
+
+			"identity": virtualMachineIdentitySchema(),
+
+			"license_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"None",
+					"Windows_Client",
+					"Windows_Server",
+				}, false),
+			},
+
+			"max_bid_price": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Default:      -1,
+				ValidateFunc: validate.FloatAtLeast(-1.0),
+			},
+
+			"overprovision": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"plan": planSchema(),
+
+			"priority": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(compute.Regular),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(compute.Regular),
+					string(compute.Low),
+				}, false),
+			},
+
+			"provision_vm_agent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				ForceNew: true,
+			},
+
+			"proximity_placement_group_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
+			},
+
+			"rolling_upgrade_policy": virtualMachineScaleSetRollingUpgradePolicySchema(),
+
+			"secret": windowsSecretSchema(),
+
+			"single_placement_group": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"source_image_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
+			},
+
+			"source_image_reference": sourceImageReferenceSchema(false),
+
+			"tags": tags.Schema(),
+
+			"terminate_notification": virtualMachineScaleSetTerminateNotificationSchema(),
+
+			"timezone": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.VirtualMachineTimeZone(),
+			},
+
+			"upgrade_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(compute.Manual),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(compute.Automatic),
+					string(compute.Manual),
+					string(compute.Rolling),
+				}, false),
+			},
+
+			"winrm_listener": winRmListenerSchema(),
+
+			"zone_balance": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"zones": azure.SchemaZones(),
+
+			// Computed
+			"unique_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmWindowsVirtualMachineScaleSetCreate(d *schema.ResourceData, meta interface{}) error {
+	client := clients.FromMeta(meta).Compute.VMScaleSetClient
+	ctx, cancel := timeouts.ForCreate(clients.FromMeta(meta).StopContext, d)
+	defer cancel()
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	name := d.Get("name").(string)
+
+	if clients.FromMeta(meta).Features.RequiresImport {
+		existing, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for existing Windows Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_windows_virtual_machine_scale_set", *existing.ID)
+		}
+	}
+
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	t := d.Get("tags").(map[string]interface{})
+
+	additionalCapabilities := &compute.AdditionalCapabilities{}
+
+	bootDiagnosticsRaw := d.Get("boot_diagnostics").([]interface{})
+	bootDiagnostics := expandBootDiagnostics(bootDiagnosticsRaw)
+
+	dataDisksRaw := d.Get("data_disk").([]interface{})
+	dataDisks := expandVirtualMachineScaleSetDataDisk(dataDisksRaw)
+	for _, disk := range *dataDisks {
+		if disk.ManagedDisk != nil && disk.ManagedDisk.StorageAccountType == compute.StorageAccountTypesUltraSSDLRS {
+			additionalCapabilities.UltraSSDEnabled = utils.Bool(true)
+		}
+	}
+
+	identityRaw := d.Get("identity").([]interface{})
+	identity, err := expandVirtualMachineScaleSetIdentity(identityRaw)
+	if err != nil {
+		return fmt.Errorf("Error expanding `identity`: %+v", err)
+	}
+
+	networkInterfacesRaw := d.Get("network_interface").([]interface{})
+	networkInterfaces, err := expandVirtualMachineScaleSetNetworkInterface(networkInterfacesRaw)
+	if err != nil {
+		return fmt.Errorf("Error expanding `network_interface`: %+v", err)
+	}
+
+	osDiskRaw := d.Get("os_disk").([]interface{})
+	osDisk := expandVirtualMachineScaleSetOSDisk(osDiskRaw, compute.Windows)
+
+	planRaw := d.Get("plan").([]interface{})
+	plan := expandPlan(planRaw)
+
+	sourceImageReferenceRaw := d.Get("source_image_reference").([]interface{})
+	sourceImageId := d.Get("source_image_id").(string)
+	sourceImageReference, err := expandSourceImageReference(sourceImageReferenceRaw, sourceImageId)
+	if err != nil {
+		return err
+	}
+
+	healthProbeId := d.Get("health_probe_id").(string)
+	upgradeMode := compute.UpgradeMode(d.Get("upgrade_mode").(string))
+	automaticOSUpgradePolicyRaw := d.Get("automatic_os_upgrade_policy").([]interface{})
+	automaticOSUpgradePolicy := expandVirtualMachineScaleSetAutomaticUpgradePolicy(automaticOSUpgradePolicyRaw)
+	rollingUpgradePolicyRaw := d.Get("rolling_upgrade_policy").([]interface{})
+	rollingUpgradePolicy := expandVirtualMachineScaleSetRollingUpgradePolicy(rollingUpgradePolicyRaw)
+
+	if upgradeMode != compute.Automatic && len(automaticOSUpgradePolicyRaw) > 0 {
+		return fmt.Errorf("An `automatic_os_upgrade_policy` block cannot be specified when `upgrade_mode` is not set to `Automatic`")
+	}
+
+	if upgradeMode != compute.Rolling && len(rollingUpgradePolicyRaw) > 0 {
+		return fmt.Errorf("A `rolling_upgrade_policy` block can only be specified when `upgrade_mode` is set to %q", string(compute.Rolling))
+	}
+	if upgradeMode == compute.Rolling && len(rollingUpgradePolicyRaw) == 0 {
+		return fmt.Errorf("A `rolling_upgrade_policy` block must be specified when `upgrade_mode` is set to %q", string(upgradeMode))
+	}
+	if upgradeMode == compute.Rolling && healthProbeId == "" {
+		return fmt.Errorf("A `health_probe_id` must be specified when `upgrade_mode` is set to %q", string(upgradeMode))
+	}
+
+	secretsRaw := d.Get("secret").([]interface{})
+	secrets := expandVirtualMachineSecrets(secretsRaw)
+
+	var computerNamePrefix string
+	if v, ok := d.GetOk("computer_name_prefix"); ok && len(v.(string)) > 0 {
+		computerNamePrefix = v.(string)
+	} else {
+		if _, errs := ValidateWindowsComputerNamePrefix(name, "computer_name_prefix"); len(errs) > 0 {
+			return fmt.Errorf("unable to assume default computer name prefix %q - please specify a valid `computer_name_prefix`: %+v", name, errs[0])
+		}
+		computerNamePrefix = name
+	}
+
+	enableAutomaticUpdates := d.Get("enable_automatic_updates").(bool)
+	if automaticOSUpgradePolicy != nil && automaticOSUpgradePolicy.EnableAutomaticOSUpgrade != nil && *automaticOSUpgradePolicy.EnableAutomaticOSUpgrade && enableAutomaticUpdates {
+		return fmt.Errorf("`enable_automatic_updates` must be set to `false` when `enable_automatic_os_upgrade` is set to `true`")
+	}
+
+	additionalUnattendContentRaw := d.Get("additional_unattend_content").([]interface{})
+	additionalUnattendContent := expandAdditionalUnattendContent(additionalUnattendContentRaw)
+	winRmListenersRaw := d.Get("winrm_listener").(*schema.Set).List()
+	winRmListeners := expandWinRMListener(winRmListenersRaw)
+
+	networkProfile := &compute.VirtualMachineScaleSetNetworkProfile{
+		NetworkInterfaceConfigurations: networkInterfaces,
+	}
+	if healthProbeId != "" {
+		networkProfile.HealthProbe = &compute.APIEntityReference{
+			ID: utils.String(healthProbeId),
+		}
+	}
+
+	priority := compute.VirtualMachinePriorityTypes(d.Get("priority").(string))
+	upgradePolicy := compute.UpgradePolicy{
+		Mode:                     upgradeMode,
+		AutomaticOSUpgradePolicy: automaticOSUpgradePolicy,
+		RollingUpgradePolicy:     rollingUpgradePolicy,
+	}
+
+	virtualMachineProfile := compute.VirtualMachineScaleSetVMProfile{
+		Priority: priority,
+		OsProfile: &compute.VirtualMachineScaleSetOSProfile{
+			AdminPassword:      utils.String(d.Get("admin_password").(string)),
+			AdminUsername:      utils.String(d.Get("admin_username").(string)),
+			ComputerNamePrefix: utils.String(computerNamePrefix),
+			WindowsConfiguration: &compute.WindowsConfiguration{
+				AdditionalUnattendContent: additionalUnattendContent,
+				EnableAutomaticUpdates:    utils.Bool(enableAutomaticUpdates),
+				ProvisionVMAgent:          utils.Bool(d.Get("provision_vm_agent").(bool)),
+				WinRM:                     winRmListeners,
+			},
+			Secrets: secrets,
+		},
+		DiagnosticsProfile: bootDiagnostics,
+		NetworkProfile:     networkProfile,
+		StorageProfile: &compute.VirtualMachineScaleSetStorageProfile{
+			ImageReference: sourceImageReference,
+			OsDisk:         osDisk,
+			DataDisks:      dataDisks,
+		},
+	}
+
+	if v, ok := d.GetOk("license_type"); ok {
+		virtualMachineProfile.LicenseType = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("timezone"); ok {
+		virtualMachineProfile.OsProfile.WindowsConfiguration.TimeZone = utils.String(v.(string))
+	}
+
+	maxBidPrice := d.Get("max_bid_price").(float64)
+	if maxBidPrice > -1 && priority != compute.Low {
+		return fmt.Errorf("`max_bid_price` can only be configured when `priority` is set to `Low`")
+	}
+	if priority == compute.Low {
+		virtualMachineProfile.BillingProfile = &compute.BillingProfile{
+			MaxPrice: utils.Float(maxBidPrice),
+		}
+	}
+
+	if v, ok := d.GetOk("custom_data"); ok {
+		virtualMachineProfile.OsProfile.CustomData = utils.String(v.(string))
+	}
+
+	if evictionPolicyRaw, ok := d.GetOk("eviction_policy"); ok {
+		if virtualMachineProfile.Priority != compute.Low {
+			return fmt.Errorf("An `eviction_policy` can only be specified when `priority` is set to `Low`")
+		}
+		virtualMachineProfile.EvictionPolicy = compute.VirtualMachineEvictionPolicyTypes(evictionPolicyRaw.(string))
+	} else if priority == compute.Low {
+		return fmt.Errorf("An `eviction_policy` must be specified when `priority` is set to `Low`")
+	}
+
+	if v, ok := d.GetOk("terminate_notification"); ok {
+		virtualMachineProfile.ScheduledEventsProfile = expandVirtualMachineScaleSetScheduledEventsProfile(v.([]interface{}))
+	}
+
+	props := compute.VirtualMachineScaleSet{
+		Location: utils.String(location),
+		Sku: &compute.Sku{
+			Name:     utils.String(d.Get("sku").(string)),
+			Capacity: utils.Int64(int64(d.Get("instances").(int))),
+
+			// doesn't appear this can be set to anything else, even Promo machines are Standard
+			Tier: utils.String("Standard"),
+		},
+		Identity: identity,
+		Plan:     plan,
+		Tags:     tags.Expand(t),
+		VirtualMachineScaleSetProperties: &compute.VirtualMachineScaleSetProperties{
+			AdditionalCapabilities:                 additionalCapabilities,
+			DoNotRunExtensionsOnOverprovisionedVMs: utils.Bool(d.Get("do_not_run_extensions_on_overprovisioned_machines").(bool)),
+			Overprovision:                          utils.Bool(d.Get("overprovision").(bool)),
+			SinglePlacementGroup:                   utils.Bool(d.Get("single_placement_group").(bool)),
+			VirtualMachineProfile:                  &virtualMachineProfile,
+			UpgradePolicy:                          &upgradePolicy,
+		},
+		Zones: azure.ExpandZones(d.Get("zones").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("proximity_placement_group_id"); ok {
+		props.VirtualMachineScaleSetProperties.ProximityPlacementGroup = &compute.SubResource{
+			ID: utils.String(v.(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("zone_balance"); ok && v.(bool) {
+		if props.Zones == nil || len(*props.Zones) == 0 {
+			return fmt.Errorf("`zone_balance` can only be set to `true` when zones are specified")
+		}
+
+		props.VirtualMachineScaleSetProperties.ZoneBalance = utils.Bool(v.(bool))
+	}
+
+	log.Printf("[DEBUG] Creating Windows Virtual Machine Scale Set %q (Resource Group %q)..", name, resourceGroup)
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, props)
+	if err != nil {
+		return fmt.Errorf("Error creating Windows Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	log.Printf("[DEBUG] Waiting for Windows Virtual Machine Scale Set %q (Resource Group %q) to be created..", name, resourceGroup)
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation of Windows Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	log.Printf("[DEBUG] Windows Virtual Machine Scale Set %q (Resource Group %q) was created", name, resourceGroup)
+
+	log.Printf("[DEBUG] Retrieving Windows Virtual Machine Scale Set %q (Resource Group %q)..", name, resourceGroup)
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Windows Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if resp.ID == nil || *resp.ID == "" {
+		return fmt.Errorf("Error retrieving Windows Virtual Machine Scale Set %q (Resource Group %q): ID was nil", name, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmWindowsVirtualMachineScaleSetRead(d, meta)
+}
+
+func resourceArmWindowsVirtualMachineScaleSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := clients.FromMeta(meta).Compute.VMScaleSetClient
+	ctx, cancel := timeouts.ForUpdate(clients.FromMeta(meta).StopContext, d)
+	defer cancel()
+
+	id, err := ParseVirtualMachineScaleSetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	updateInstances := false
+
+	// retrieve
+	existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Windows Virtual Machine Scale Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+	if existing.VirtualMachineScaleSetProperties == nil {
+		return fmt.Errorf("Error retrieving Windows Virtual Machine Scale Set %q (Resource Group %q): `properties` was nil", id.Name, id.ResourceGroup)
+	}
+	if existing.VirtualMachineScaleSetProperties.VirtualMachineProfile == nil {
+		return fmt.Errorf("Error retrieving Windows Virtual Machine Scale Set %q (Resource Group %q): `properties.virtualMachineProfile` was nil", id.Name, id.ResourceGroup)
+	}
+	if existing.VirtualMachineScaleSetProperties.VirtualMachineProfile.StorageProfile == nil {
+		return fmt.Errorf("Error retrieving Windows Virtual Machine Scale Set %q (Resource Group %q): `properties.virtualMachineProfile.storageProfile` was nil", id.Name, id.ResourceGroup)
+	}
+
+	updateProps := compute.VirtualMachineScaleSetUpdateProperties{
+		VirtualMachineProfile: &compute.VirtualMachineScaleSetUpdateVMProfile{
+			// if an image reference has been specified previously it must be specified again
+			StorageProfile: &compute.VirtualMachineScaleSetUpdateStorageProfile{
+				ImageReference: existing.VirtualMachineScaleSetProperties.VirtualMachineProfile.StorageProfile.ImageReference,
+			},
+		},
+	}
+	update := compute.VirtualMachineScaleSetUpdate{}
+
+	// pull this from the existing Scale Set, which covers no changes being made to this block
+	automaticOSUpgradeIsEnabled := false
+	if policy := existing.VirtualMachineScaleSetProperties.UpgradePolicy; policy != nil {
+		if policy.AutomaticOSUpgradePolicy != nil && policy.AutomaticOSUpgradePolicy.EnableAutomaticOSUpgrade != nil {
+			automaticOSUpgradeIsEnabled = *policy.AutomaticOSUpgradePolicy.EnableAutomaticOSUpgrade
+		}
+	}
+
+	// the Upgrade Policy is always sent, since it's used to determine how the instances should be rolled
+	upgradeMode := compute.UpgradeMode(d.Get("upgrade_mode").(string))
+	automaticOSUpgradePolicyRaw := d.Get("automatic_os_upgrade_policy").([]interface{})
+	automaticOSUpgradePolicy := expandVirtualMachineScaleSetAutomaticUpgradePolicy(automaticOSUpgradePolicyRaw)
+	rollingUpgradePolicyRaw := d.Get("rolling_upgrade_policy").([]interface{})
+	rollingUpgradePolicy := expandVirtualMachineScaleSetRollingUpgradePolicy(rollingUpgradePolicyRaw)
+
+	if upgradeMode != compute.Automatic && len(automaticOSUpgradePolicyRaw) > 0 {
+		return fmt.Errorf("An `automatic_os_upgrade_policy` block cannot be specified when `upgrade_mode` is not set to `Automatic`")
+	}
+	if upgradeMode != compute.Rolling && len(rollingUpgradePolicyRaw) > 0 {
+		return fmt.Errorf("A `rolling_upgrade_policy` block can only be specified when `upgrade_mode` is set to %q", string(compute.Rolling))
+	}
+	if upgradeMode == compute.Rolling && len(rollingUpgradePolicyRaw) == 0 {
+		return fmt.Errorf("A `rolling_upgrade_policy` block must be specified when `upgrade_mode` is set to %q", string(upgradeMode))
+	}
+
+	updateProps.UpgradePolicy = &compute.UpgradePolicy{
+		Mode:                     upgradeMode,
+		AutomaticOSUpgradePolicy: automaticOSUpgradePolicy,
+		RollingUpgradePolicy:     rollingUpgradePolicy,
+	}
+
+	if d.HasChange("automatic_os_upgrade_policy") {
+		// if this has been changed, the value from the config is the source of truth
+		automaticOSUpgradeIsEnabled = automaticOSUpgradePolicy != nil && automaticOSUpgradePolicy.EnableAutomaticOSUpgrade != nil && *automaticOSUpgradePolicy.EnableAutomaticOSUpgrade
+	}
+
+	if d.HasChange("single_placement_group") {
+		singlePlacementGroup := d.Get("single_placement_group").(bool)
+		if singlePlacementGroup {
+			return fmt.Errorf("`single_placement_group` cannot be changed from `false` to `true`")
+		}
+		updateProps.SinglePlacementGroup = utils.Bool(singlePlacementGroup)
+	}
+
+	if d.HasChange("custom_data") || d.HasChange("enable_automatic_updates") || d.HasChange("secret") {
+		updateInstances = true
+
+		osProfile := compute.VirtualMachineScaleSetUpdateOSProfile{}
+
+		if d.HasChange("enable_automatic_updates") {
+			osProfile.WindowsConfiguration = &compute.WindowsConfiguration{
+				EnableAutomaticUpdates: utils.Bool(d.Get("enable_automatic_updates").(bool)),
+			}
+		}
+
+		if d.HasChange("custom_data") {
+			// customData can only be sent if it's a base64 encoded string,
+			// so it's not possible to remove this without tainting the resource
+			osProfile.CustomData = utils.String(d.Get("custom_data").(string))
+		}
+
+		if d.HasChange("secret") {
+			secretsRaw := d.Get("secret").([]interface{})
+			osProfile.Secrets = expandVirtualMachineSecrets(secretsRaw)
+		}
+
+		updateProps.VirtualMachineProfile.OsProfile = &osProfile
+	}
+
+	if d.HasChange("data_disk") || d.HasChange("os_disk") || d.HasChange("source_image_id") || d.HasChange("source_image_reference") {
+		updateInstances = true
+
+		if d.HasChange("data_disk") {
+			dataDisksRaw := d.Get("data_disk").([]interface{})
+			updateProps.VirtualMachineProfile.StorageProfile.DataDisks = expandVirtualMachineScaleSetDataDisk(dataDisksRaw)
+		}
+
+		if d.HasChange("os_disk") {
+			osDiskRaw := d.Get("os_disk").([]interface{})
+			updateProps.VirtualMachineProfile.StorageProfile.OsDisk = expandVirtualMachineScaleSetOSDiskUpdate(osDiskRaw)
+		}
+
+		if d.HasChange("source_image_id") || d.HasChange("source_image_reference") {
+			sourceImageReferenceRaw := d.Get("source_image_reference").([]interface{})
+			sourceImageId := d.Get("source_image_id").(string)
+			sourceImageReference, err := expandSourceImageReference(sourceImageReferenceRaw, sourceImageId)
+			if err != nil {
+				return err
+			}
+
+			updateProps.VirtualMachineProfile.StorageProfile.ImageReference = sourceImageReference
+		}
+	}
+
+	if d.HasChange("network_interface") {
+		updateInstances = true
+
+		networkInterfacesRaw := d.Get("network_interface").([]interface{})
+		networkInterfaces, err := expandVirtualMachineScaleSetNetworkInterfaceUpdate(networkInterfacesRaw)
+		if err != nil {
+			return fmt.Errorf("Error expanding `network_interface`: %+v", err)
+		}
+
+		updateProps.VirtualMachineProfile.NetworkProfile = &compute.VirtualMachineScaleSetUpdateNetworkProfile{
+			NetworkInterfaceConfigurations: networkInterfaces,
+		}
+	}
+
+	if d.HasChange("boot_diagnostics") {
+		updateInstances = true
+
+		bootDiagnosticsRaw := d.Get("boot_diagnostics").([]interface{})
+		updateProps.VirtualMachineProfile.DiagnosticsProfile = expandBootDiagnostics(bootDiagnosticsRaw)
+	}
+
+	if d.HasChange("terminate_notification") {
+		notificationRaw := d.Get("terminate_notification").([]interface{})
+		updateProps.VirtualMachineProfile.ScheduledEventsProfile = expandVirtualMachineScaleSetScheduledEventsProfile(notificationRaw)
+	}
+
+	if d.HasChange("license_type") {
+		updateInstances = true
+
+		license := d.Get("license_type").(string)
+		if license == "" {
+			// the API doesn't allow an empty value during an update, so `None` is used to remove the License Type
+			license = "None"
+		}
+		updateProps.VirtualMachineProfile.LicenseType = utils.String(license)
+	}
+
+	if d.HasChange("max_bid_price") {
+		maxBidPrice := d.Get("max_bid_price").(float64)
+		updateProps.VirtualMachineProfile.BillingProfile = &compute.BillingProfile{
+			MaxPrice: utils.Float(maxBidPrice),
+		}
+	}
+
+	if d.HasChange("overprovision") {
+		updateProps.Overprovision = utils.Bool(d.Get("overprovision").(bool))
+	}
+
+	if d.HasChange("identity") {
+		identityRaw := d.Get("identity").([]interface{})
+		identity, err := expandVirtualMachineScaleSetIdentity(identityRaw)
+		if err != nil {
+			return fmt.Errorf("Error expanding `identity`: %+v", err)
+		}
+
+		update.Identity = identity
+	}
+
+	if d.HasChange("sku") || d.HasChange("instances") {
+		// in-case ignore_changes is being used, since both fields are required
+		// look up the current values and override them as needed
+		sku := existing.Sku
+		if sku == nil {
+			sku = &compute.Sku{
+				Name:     utils.String(d.Get("sku").(string)),
+				Capacity: utils.Int64(int64(d.Get("instances").(int))),
+				Tier:     utils.String("Standard"),
+			}
+		}
+
+		if d.HasChange("sku") {
+			updateInstances = true
+
+			sku.Name = utils.String(d.Get("sku").(string))
+		}
+
+		if d.HasChange("instances") {
+			sku.Capacity = utils.Int64(int64(d.Get("instances").(int)))
+		}
+
+		update.Sku = sku
+	}
+
+	if d.HasChange("tags") {
+		update.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	}
+
+	update.VirtualMachineScaleSetUpdateProperties = &updateProps
+
+	metaData := virtualMachineScaleSetUpdateMetaData{
+		AutomaticOSUpgradeIsEnabled:  automaticOSUpgradeIsEnabled,
+		CanRollInstancesWhenRequired: updateInstances,
+		UpdateToLatestImageVersion:   d.HasChange("source_image_reference") && d.Get("source_image_reference.0.version").(string) == "latest",
+		Client:                       clients.FromMeta(meta),
+		ID:                           id,
+		OSType:                       compute.Windows,
+	}
+
+	if err := metaData.performUpdate(ctx, update); err != nil {
+		return err
+	}
+
+	return resourceArmWindowsVirtualMachineScaleSetRead(d, meta)
+}
+
+func resourceArmWindowsVirtualMachineScaleSetRead(d *schema.ResourceData, meta interface{}) error {
+	client := clients.FromMeta(meta).Compute.VMScaleSetClient
+	ctx, cancel := timeouts.ForRead(clients.FromMeta(meta).StopContext, d)
+	defer cancel()
+
+	id, err := ParseVirtualMachineScaleSetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Windows Virtual Machine Scale Set %q was not found in Resource Group %q - removing from state!", id.Name, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Windows Virtual Machine Scale Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	var skuName *string
+	var instances int
+	if resp.Sku != nil {
+		skuName = resp.Sku.Name
+		if resp.Sku.Capacity != nil {
+			instances = int(*resp.Sku.Capacity)
+		}
+	}
+	d.Set("instances", instances)
+	d.Set("sku", skuName)
+
+	if err := d.Set("identity", flattenVirtualMachineScaleSetIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
+	if err := d.Set("plan", flattenPlan(resp.Plan)); err != nil {
+		return fmt.Errorf("Error setting `plan`: %+v", err)
+	}
+
+	if resp.VirtualMachineScaleSetProperties == nil {
+		return fmt.Errorf("Error retrieving Windows Virtual Machine Scale Set %q (Resource Group %q): `properties` was nil", id.Name, id.ResourceGroup)
+	}
+	props := *resp.VirtualMachineScaleSetProperties
+
+	d.Set("do_not_run_extensions_on_overprovisioned_machines", props.DoNotRunExtensionsOnOverprovisionedVMs)
+	d.Set("overprovision", props.Overprovision)
+	proximityPlacementGroupId := ""
+	if props.ProximityPlacementGroup != nil && props.ProximityPlacementGroup.ID != nil {
+		proximityPlacementGroupId = *props.ProximityPlacementGroup.ID
+	}
+	d.Set("proximity_placement_group_id", proximityPlacementGroupId)
+	d.Set("single_placement_group", props.SinglePlacementGroup)
+	d.Set("unique_id", props.UniqueID)
+	d.Set("zone_balance", props.ZoneBalance)
+
+	rollingUpgradePolicy := make([]interface{}, 0)
+	if policy := props.UpgradePolicy; policy != nil {
+		d.Set("upgrade_mode", string(policy.Mode))
+		if err := d.Set("automatic_os_upgrade_policy", flattenVirtualMachineScaleSetAutomaticOSUpgradePolicy(policy.AutomaticOSUpgradePolicy)); err != nil {
+			return fmt.Errorf("Error setting `automatic_os_upgrade_policy`: %+v", err)
+		}
+
+		// the API returns a default Rolling Upgrade Policy for Scale Sets which don't use Rolling Upgrades
+		if policy.Mode == compute.Rolling {
+			rollingUpgradePolicy = flattenVirtualMachineScaleSetRollingUpgradePolicy(policy.RollingUpgradePolicy)
+		}
+	}
+	if err := d.Set("rolling_upgrade_policy", rollingUpgradePolicy); err != nil {
+		return fmt.Errorf("Error setting `rolling_upgrade_policy`: %+v", err)
+	}
+
+	if profile := props.VirtualMachineProfile; profile != nil {
+		if err := d.Set("boot_diagnostics", flattenBootDiagnostics(profile.DiagnosticsProfile)); err != nil {
+			return fmt.Errorf("Error setting `boot_diagnostics`: %+v", err)
+		}
+
+		// defaulted since BillingProfile isn't returned if it's unset
+		maxBidPrice := float64(-1.0)
+		if profile.BillingProfile != nil && profile.BillingProfile.MaxPrice != nil {
+			maxBidPrice = *profile.BillingProfile.MaxPrice
+		}
+		d.Set("max_bid_price", maxBidPrice)
+
+		d.Set("eviction_policy", string(profile.EvictionPolicy))
+
+		licenseType := ""
+		if profile.LicenseType != nil && *profile.LicenseType != "None" {
+			licenseType = *profile.LicenseType
+		}
+		d.Set("license_type", licenseType)
+
+		// the API doesn't return the Priority when it's set to the default (Regular)
+		priority := compute.Regular
+		if profile.Priority != "" {
+			priority = profile.Priority
+		}
+		d.Set("priority", string(priority))
+
+		if storageProfile := profile.StorageProfile; storageProfile != nil {
+			if err := d.Set("os_disk", flattenVirtualMachineScaleSetOSDisk(storageProfile.OsDisk)); err != nil {
+				return fmt.Errorf("Error setting `os_disk`: %+v", err)
+			}
+
+			if err := d.Set("data_disk", flattenVirtualMachineScaleSetDataDisk(storageProfile.DataDisks)); err != nil {
+				return fmt.Errorf("Error setting `data_disk`: %+v", err)
+			}
+
+			if err := d.Set("source_image_reference", flattenSourceImageReference(storageProfile.ImageReference)); err != nil {
+				return fmt.Errorf("Error setting `source_image_reference`: %+v", err)
+			}
+
+			var storageImageId string
+			if storageProfile.ImageReference != nil && storageProfile.ImageReference.ID != nil {
+				storageImageId = *storageProfile.ImageReference.ID
+			}
+			d.Set("source_image_id", storageImageId)
+		}
+
+		if osProfile := profile.OsProfile; osProfile != nil {
+			// admin_password isn't returned, but it's a top level field so we can ignore it without consequence
+			d.Set("admin_username", osProfile.AdminUsername)
+			d.Set("computer_name_prefix", osProfile.ComputerNamePrefix)
+
+			if windows := osProfile.WindowsConfiguration; windows != nil {
+				if err := d.Set("additional_unattend_content", flattenAdditionalUnattendContent(windows.AdditionalUnattendContent, d)); err != nil {
+					return fmt.Errorf("Error setting `additional_unattend_content`: %+v", err)
+				}
+				d.Set("enable_automatic_updates", windows.EnableAutomaticUpdates)
+				d.Set("provision_vm_agent", windows.ProvisionVMAgent)
+				d.Set("timezone", windows.TimeZone)
+
+				if err := d.Set("winrm_listener", flattenWinRMListener(windows.WinRM)); err != nil {
+					return fmt.Errorf("Error setting `winrm_listener`: %+v", err)
+				}
+			}
+
+			if err := d.Set("secret", flattenVirtualMachineSecrets(osProfile.Secrets)); err != nil {
+				return fmt.Errorf("Error setting `secret`: %+v", err)
+			}
+		}
+
+		if nwProfile := profile.NetworkProfile; nwProfile != nil {
+			flattenedNics := flattenVirtualMachineScaleSetNetworkInterface(nwProfile.NetworkInterfaceConfigurations)
+			if err := d.Set("network_interface", flattenedNics); err != nil {
+				return fmt.Errorf("Error setting `network_interface`: %+v", err)
+			}
+
+			healthProbeId := ""
+			if nwProfile.HealthProbe != nil && nwProfile.HealthProbe.ID != nil {
+				healthProbeId = *nwProfile.HealthProbe.ID
+			}
+			d.Set("health_probe_id", healthProbeId)
+		}
+
+		if err := d.Set("terminate_notification", flattenVirtualMachineScaleSetScheduledEventsProfile(profile.ScheduledEventsProfile)); err != nil {
+			return fmt.Errorf("Error setting `terminate_notification`: %+v", err)
+		}
+	}
+
+	if err := d.Set("zones", resp.Zones); err != nil {
+		return fmt.Errorf("Error setting `zones`: %+v", err)
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceArmWindowsVirtualMachineScaleSetDelete(d *schema.ResourceData, meta interface{}) error {
+	client := clients.FromMeta(meta).Compute.VMScaleSetClient
+	ctx, cancel := timeouts.ForDelete(clients.FromMeta(meta).StopContext, d)
+	defer cancel()
+
+	id, err := ParseVirtualMachineScaleSetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Windows Virtual Machine Scale Set %q (Resource Group %q)..", id.Name, id.ResourceGroup)
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Windows Virtual Machine Scale Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	log.Printf("[DEBUG] Waiting for deletion of Windows Virtual Machine Scale Set %q (Resource Group %q)..", id.Name, id.ResourceGroup)
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of Windows Virtual Machine Scale Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+	log.Printf("[DEBUG] Deleted Windows Virtual Machine Scale Set %q (Resource Group %q).", id.Name, id.ResourceGroup)
+
+	return nil
+}
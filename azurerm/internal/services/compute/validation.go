@@ -0,0 +1,69 @@
+package compute
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ValidateLinuxName validates the name of a Linux Virtual Machine (or Scale Set)
+func ValidateLinuxName(i interface{}, k string) (warnings []string, errors []error) {
+	return validateVirtualMachineName(i, k, 64, false)
+}
+
+// ValidateLinuxComputerName validates the Computer Name (hostname) of a Linux Virtual Machine
+func ValidateLinuxComputerName(i interface{}, k string) (warnings []string, errors []error) {
+	return validateVirtualMachineName(i, k, 64, false)
+}
+
+// ValidateWindowsName validates the name of a Windows Virtual Machine (or Scale Set)
+func ValidateWindowsName(i interface{}, k string) (warnings []string, errors []error) {
+	return validateVirtualMachineName(i, k, 64, true)
+}
+
+// ValidateWindowsComputerName validates the Computer Name of a Windows Virtual Machine, which is
+// limited to 15 characters (the NetBIOS limit)
+func ValidateWindowsComputerName(i interface{}, k string) (warnings []string, errors []error) {
+	return validateVirtualMachineName(i, k, 15, true)
+}
+
+// ValidateWindowsComputerNamePrefix validates the Computer Name Prefix of a Windows Virtual Machine Scale Set, which
+// is limited to 9 characters since Azure appends a 6 character suffix to each instance
+func ValidateWindowsComputerNamePrefix(i interface{}, k string) (warnings []string, errors []error) {
+	return validateVirtualMachineName(i, k, 9, true)
+}
+
+func validateVirtualMachineName(i interface{}, k string, maxLength int, isWindows bool) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string but it wasn't!", k))
+		return
+	}
+
+	if strings.TrimSpace(v) == "" {
+		errors = append(errors, fmt.Errorf("%q must not be empty", k))
+		return
+	}
+
+	if len(v) > maxLength {
+		errors = append(errors, fmt.Errorf("%q can be at most %d characters, got %d", k, maxLength, len(v)))
+	}
+
+	if strings.HasPrefix(v, "_") {
+		errors = append(errors, fmt.Errorf("%q cannot begin with an underscore", k))
+	}
+
+	if strings.HasSuffix(v, ".") || strings.HasSuffix(v, "-") {
+		errors = append(errors, fmt.Errorf("%q cannot end with a period or a hyphen", k))
+	}
+
+	if regexp.MustCompile(`[\\/"\[\]:|<>+=;,?*@&\s]`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q cannot contain whitespace or the special characters: `\\/\"[]:|<>+=;,?*@&`", k))
+	}
+
+	if isWindows && regexp.MustCompile(`^[0-9]+$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q cannot contain only numbers", k))
+	}
+
+	return warnings, errors
+}
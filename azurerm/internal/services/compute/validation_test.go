@@ -0,0 +1,156 @@
+package compute
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateLinuxName(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			input:    "",
+			expected: false,
+		},
+		{
+			input:    "hello",
+			expected: true,
+		},
+		{
+			input:    "hello-world.01",
+			expected: true,
+		},
+		{
+			input:    "hello_world",
+			expected: true,
+		},
+		{
+			input:    "_hello",
+			expected: false,
+		},
+		{
+			input:    "hello-",
+			expected: false,
+		},
+		{
+			input:    "hello.",
+			expected: false,
+		},
+		{
+			input:    "hello world",
+			expected: false,
+		},
+		{
+			input:    "hello@world",
+			expected: false,
+		},
+		{
+			input:    "12345",
+			expected: true,
+		},
+		{
+			input:    strings.Repeat("a", 64),
+			expected: true,
+		},
+		{
+			input:    strings.Repeat("a", 65),
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := ValidateLinuxName(v.input, "name")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
+
+func TestValidateWindowsComputerName(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			input:    "",
+			expected: false,
+		},
+		{
+			input:    "hello",
+			expected: true,
+		},
+		{
+			input:    "hello-world",
+			expected: true,
+		},
+		{
+			input:    "12345",
+			expected: false,
+		},
+		{
+			input:    "hello/world",
+			expected: false,
+		},
+		{
+			input:    strings.Repeat("a", 15),
+			expected: true,
+		},
+		{
+			input:    strings.Repeat("a", 16),
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := ValidateWindowsComputerName(v.input, "computer_name")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
+
+func TestValidateWindowsComputerNamePrefix(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			input:    "",
+			expected: false,
+		},
+		{
+			input:    "hello",
+			expected: true,
+		},
+		{
+			input:    "123",
+			expected: false,
+		},
+		{
+			input:    strings.Repeat("a", 9),
+			expected: true,
+		},
+		{
+			input:    strings.Repeat("a", 10),
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := ValidateWindowsComputerNamePrefix(v.input, "computer_name_prefix")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
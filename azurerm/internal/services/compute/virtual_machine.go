@@ -0,0 +1,1051 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// virtualMachineResourceName is the name used to lock a Virtual Machine - this matches the name used by
+// `azurerm_virtual_machine` & `azurerm_virtual_machine_data_disk_attachment`, so that Data Disks can be
+// attached to these Virtual Machines without conflicting updates
+var virtualMachineResourceName = "azurerm_virtual_machine"
+
+func virtualMachineOSDiskSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"caching": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(compute.CachingTypesNone),
+						string(compute.CachingTypesReadOnly),
+						string(compute.CachingTypesReadWrite),
+					}, false),
+				},
+
+				"storage_account_type": {
+					Type:     schema.TypeString,
+					Required: true,
+					// whilst this appears in the Update block the API returns this when changing:
+					// Changing property 'osDisk.managedDisk.storageAccountType' is not allowed
+					ForceNew: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(compute.StorageAccountTypesPremiumLRS),
+						string(compute.StorageAccountTypesStandardLRS),
+						string(compute.StorageAccountTypesStandardSSDLRS),
+					}, false),
+				},
+
+				"diff_disk_settings": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"option": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(compute.Local),
+								}, false),
+							},
+						},
+					},
+				},
+
+				"disk_size_gb": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntBetween(0, 4095),
+				},
+
+				"name": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+					Computed: true,
+				},
+
+				"write_accelerator_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func expandVirtualMachineOSDisk(input []interface{}, osType compute.OperatingSystemTypes) *compute.OSDisk {
+	raw := input[0].(map[string]interface{})
+	disk := compute.OSDisk{
+		Caching: compute.CachingTypes(raw["caching"].(string)),
+		ManagedDisk: &compute.ManagedDiskParameters{
+			StorageAccountType: compute.StorageAccountTypes(raw["storage_account_type"].(string)),
+		},
+		WriteAcceleratorEnabled: utils.Bool(raw["write_accelerator_enabled"].(bool)),
+
+		// these have to be hard-coded so there's no point exposing them
+		CreateOption: compute.DiskCreateOptionTypesFromImage,
+		OsType:       osType,
+	}
+
+	if osDiskSize := raw["disk_size_gb"].(int); osDiskSize > 0 {
+		disk.DiskSizeGB = utils.Int32(int32(osDiskSize))
+	}
+
+	if name := raw["name"].(string); name != "" {
+		disk.Name = utils.String(name)
+	}
+
+	if diffDiskSettingsRaw := raw["diff_disk_settings"].([]interface{}); len(diffDiskSettingsRaw) > 0 {
+		diffDiskRaw := diffDiskSettingsRaw[0].(map[string]interface{})
+		disk.DiffDiskSettings = &compute.DiffDiskSettings{
+			Option: compute.DiffDiskOptions(diffDiskRaw["option"].(string)),
+		}
+	}
+
+	return &disk
+}
+
+func flattenVirtualMachineOSDisk(input *compute.OSDisk) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	diffDiskSettings := make([]interface{}, 0)
+	if input.DiffDiskSettings != nil {
+		diffDiskSettings = append(diffDiskSettings, map[string]interface{}{
+			"option": string(input.DiffDiskSettings.Option),
+		})
+	}
+
+	diskSizeGb := 0
+	if input.DiskSizeGB != nil {
+		diskSizeGb = int(*input.DiskSizeGB)
+	}
+
+	name := ""
+	if input.Name != nil {
+		name = *input.Name
+	}
+
+	id := ""
+	storageAccountType := ""
+	if input.ManagedDisk != nil {
+		storageAccountType = string(input.ManagedDisk.StorageAccountType)
+		if input.ManagedDisk.ID != nil {
+			id = *input.ManagedDisk.ID
+		}
+	}
+
+	writeAcceleratorEnabled := false
+	if input.WriteAcceleratorEnabled != nil {
+		writeAcceleratorEnabled = *input.WriteAcceleratorEnabled
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"caching":                   string(input.Caching),
+			"disk_size_gb":              diskSizeGb,
+			"diff_disk_settings":        diffDiskSettings,
+			"id":                        id,
+			"name":                      name,
+			"storage_account_type":      storageAccountType,
+			"write_accelerator_enabled": writeAcceleratorEnabled,
+		},
+	}
+}
+
+// sourceImageReferenceSchema returns the schema for the `source_image_reference` block - which can be updated
+// in-place for a Virtual Machine Scale Set (rolling the instances onto the new image), but not for a Virtual Machine
+func sourceImageReferenceSchema(isVirtualMachine bool) *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		ForceNew:      isVirtualMachine,
+		MaxItems:      1,
+		ConflictsWith: []string{"source_image_id"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"publisher": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     isVirtualMachine,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+				"offer": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     isVirtualMachine,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+				"sku": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     isVirtualMachine,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+				"version": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     isVirtualMachine,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
+		},
+	}
+}
+
+func expandSourceImageReference(referenceInput []interface{}, imageId string) (*compute.ImageReference, error) {
+	if imageId != "" {
+		return &compute.ImageReference{
+			ID: utils.String(imageId),
+		}, nil
+	}
+
+	if len(referenceInput) == 0 {
+		return nil, fmt.Errorf("Either a `source_image_id` or a `source_image_reference` block must be specified!")
+	}
+
+	raw := referenceInput[0].(map[string]interface{})
+	return &compute.ImageReference{
+		Publisher: utils.String(raw["publisher"].(string)),
+		Offer:     utils.String(raw["offer"].(string)),
+		Sku:       utils.String(raw["sku"].(string)),
+		Version:   utils.String(raw["version"].(string)),
+	}, nil
+}
+
+func flattenSourceImageReference(input *compute.ImageReference) []interface{} {
+	// since the image id is pulled out as a separate field, if that's set we should return an empty block here
+	if input == nil || input.ID != nil {
+		return []interface{}{}
+	}
+
+	var publisher, offer, sku, version string
+
+	if input.Publisher != nil {
+		publisher = *input.Publisher
+	}
+	if input.Offer != nil {
+		offer = *input.Offer
+	}
+	if input.Sku != nil {
+		sku = *input.Sku
+	}
+	if input.Version != nil {
+		version = *input.Version
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"publisher": publisher,
+			"offer":     offer,
+			"sku":       sku,
+			"version":   version,
+		},
+	}
+}
+
+func sshKeysSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		ForceNew: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"public_key": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				"username": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
+		},
+	}
+}
+
+func expandSSHKeys(input []interface{}) []compute.SSHPublicKey {
+	output := make([]compute.SSHPublicKey, 0)
+
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+
+		username := raw["username"].(string)
+		output = append(output, compute.SSHPublicKey{
+			KeyData: utils.String(raw["public_key"].(string)),
+			Path:    utils.String(formatUsernameForAuthorizedKeysPath(username)),
+		})
+	}
+
+	return output
+}
+
+func flattenSSHKeys(input *compute.SSHConfiguration) ([]interface{}, error) {
+	if input == nil || input.PublicKeys == nil {
+		return []interface{}{}, nil
+	}
+
+	output := make([]interface{}, 0)
+	for _, v := range *input.PublicKeys {
+		if v.KeyData == nil || v.Path == nil {
+			continue
+		}
+
+		username := parseUsernameFromAuthorizedKeysPath(*v.Path)
+		if username == nil {
+			return nil, fmt.Errorf("Error parsing username from %q", *v.Path)
+		}
+
+		output = append(output, map[string]interface{}{
+			"public_key": *v.KeyData,
+			"username":   *username,
+		})
+	}
+
+	return output, nil
+}
+
+func formatUsernameForAuthorizedKeysPath(username string) string {
+	return fmt.Sprintf("/home/%s/.ssh/authorized_keys", username)
+}
+
+func parseUsernameFromAuthorizedKeysPath(input string) *string {
+	// the Azure VM agent hard-codes this to `/home/username/.ssh/authorized_keys`
+	// as such we can hard-code this for a better UX
+	prefix := "/home/"
+	suffix := "/.ssh/authorized_keys"
+	if !strings.HasPrefix(input, prefix) || !strings.HasSuffix(input, suffix) {
+		return nil
+	}
+
+	username := strings.TrimSuffix(strings.TrimPrefix(input, prefix), suffix)
+	if username == "" || strings.Contains(username, "/") {
+		return nil
+	}
+
+	return &username
+}
+
+func bootDiagnosticsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"storage_account_uri": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
+		},
+	}
+}
+
+func expandBootDiagnostics(input []interface{}) *compute.DiagnosticsProfile {
+	if len(input) == 0 {
+		return &compute.DiagnosticsProfile{
+			BootDiagnostics: &compute.BootDiagnostics{
+				Enabled: utils.Bool(false),
+			},
+		}
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &compute.DiagnosticsProfile{
+		BootDiagnostics: &compute.BootDiagnostics{
+			Enabled:    utils.Bool(true),
+			StorageURI: utils.String(raw["storage_account_uri"].(string)),
+		},
+	}
+}
+
+func flattenBootDiagnostics(input *compute.DiagnosticsProfile) []interface{} {
+	if input == nil || input.BootDiagnostics == nil || input.BootDiagnostics.Enabled == nil || !*input.BootDiagnostics.Enabled {
+		return []interface{}{}
+	}
+
+	storageAccountUri := ""
+	if input.BootDiagnostics.StorageURI != nil {
+		storageAccountUri = *input.BootDiagnostics.StorageURI
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"storage_account_uri": storageAccountUri,
+		},
+	}
+}
+
+func virtualMachineIdentitySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(compute.ResourceIdentityTypeSystemAssigned),
+						string(compute.ResourceIdentityTypeUserAssigned),
+						string(compute.ResourceIdentityTypeSystemAssignedUserAssigned),
+					}, false),
+				},
+
+				"identity_ids": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: azure.ValidateResourceID,
+					},
+				},
+
+				"principal_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"tenant_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func expandVirtualMachineIdentity(input []interface{}) (*compute.VirtualMachineIdentity, error) {
+	if len(input) == 0 {
+		// explicitly sending `None` removes any existing Identity during an update
+		return &compute.VirtualMachineIdentity{
+			Type: compute.ResourceIdentityTypeNone,
+		}, nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	identity := compute.VirtualMachineIdentity{
+		Type: compute.ResourceIdentityType(raw["type"].(string)),
+	}
+
+	identityIdsRaw := raw["identity_ids"].([]interface{})
+	identityIds := make(map[string]*compute.VirtualMachineIdentityUserAssignedIdentitiesValue)
+	for _, v := range identityIdsRaw {
+		identityIds[v.(string)] = &compute.VirtualMachineIdentityUserAssignedIdentitiesValue{}
+	}
+
+	if identity.Type == compute.ResourceIdentityTypeUserAssigned || identity.Type == compute.ResourceIdentityTypeSystemAssignedUserAssigned {
+		if len(identityIds) == 0 {
+			return nil, fmt.Errorf("`identity_ids` must be specified when `type` is set to %q", string(identity.Type))
+		}
+
+		identity.UserAssignedIdentities = identityIds
+	} else if len(identityIds) > 0 {
+		return nil, fmt.Errorf("`identity_ids` can only be specified when `type` includes `UserAssigned`")
+	}
+
+	return &identity, nil
+}
+
+func flattenVirtualMachineIdentity(input *compute.VirtualMachineIdentity) []interface{} {
+	if input == nil || input.Type == compute.ResourceIdentityTypeNone {
+		return []interface{}{}
+	}
+
+	identityIds := make([]interface{}, 0)
+	for key := range input.UserAssignedIdentities {
+		identityIds = append(identityIds, key)
+	}
+
+	principalId := ""
+	if input.PrincipalID != nil {
+		principalId = *input.PrincipalID
+	}
+
+	tenantId := ""
+	if input.TenantID != nil {
+		tenantId = *input.TenantID
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":         string(input.Type),
+			"identity_ids": identityIds,
+			"principal_id": principalId,
+			"tenant_id":    tenantId,
+		},
+	}
+}
+
+func planSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				"product": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				"publisher": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
+		},
+	}
+}
+
+func expandPlan(input []interface{}) *compute.Plan {
+	if len(input) == 0 {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &compute.Plan{
+		Name:      utils.String(raw["name"].(string)),
+		Product:   utils.String(raw["product"].(string)),
+		Publisher: utils.String(raw["publisher"].(string)),
+	}
+}
+
+func flattenPlan(input *compute.Plan) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	var name, product, publisher string
+
+	if input.Name != nil {
+		name = *input.Name
+	}
+	if input.Product != nil {
+		product = *input.Product
+	}
+	if input.Publisher != nil {
+		publisher = *input.Publisher
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"name":      name,
+			"product":   product,
+			"publisher": publisher,
+		},
+	}
+}
+
+func linuxSecretSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key_vault_id": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateFunc:     azure.ValidateResourceID,
+					DiffSuppressFunc: suppress.ResourceIDDifference,
+				},
+
+				"certificate": {
+					Type:     schema.TypeSet,
+					Required: true,
+					MinItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"url": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: azure.ValidateKeyVaultChildId,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func windowsSecretSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key_vault_id": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateFunc:     azure.ValidateResourceID,
+					DiffSuppressFunc: suppress.ResourceIDDifference,
+				},
+
+				"certificate": {
+					Type:     schema.TypeSet,
+					Required: true,
+					MinItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"store": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+
+							"url": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: azure.ValidateKeyVaultChildId,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandVirtualMachineSecrets(input []interface{}) *[]compute.VaultSecretGroup {
+	output := make([]compute.VaultSecretGroup, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		keyVaultId := v["key_vault_id"].(string)
+		certificatesRaw := v["certificate"].(*schema.Set).List()
+		certificates := make([]compute.VaultCertificate, 0)
+		for _, certificateRaw := range certificatesRaw {
+			certificateV := certificateRaw.(map[string]interface{})
+
+			certificate := compute.VaultCertificate{
+				CertificateURL: utils.String(certificateV["url"].(string)),
+			}
+
+			// this is only present (and therefore required) for Windows Virtual Machines
+			if store, ok := certificateV["store"]; ok {
+				certificate.CertificateStore = utils.String(store.(string))
+			}
+
+			certificates = append(certificates, certificate)
+		}
+
+		output = append(output, compute.VaultSecretGroup{
+			SourceVault: &compute.SubResource{
+				ID: utils.String(keyVaultId),
+			},
+			VaultCertificates: &certificates,
+		})
+	}
+
+	return &output
+}
+
+func flattenVirtualMachineSecrets(input *[]compute.VaultSecretGroup) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make([]interface{}, 0)
+
+	for _, v := range *input {
+		keyVaultId := ""
+		if v.SourceVault != nil && v.SourceVault.ID != nil {
+			keyVaultId = *v.SourceVault.ID
+		}
+
+		certificates := make([]interface{}, 0)
+
+		if v.VaultCertificates != nil {
+			for _, c := range *v.VaultCertificates {
+				certificate := make(map[string]interface{})
+
+				if c.CertificateURL != nil {
+					certificate["url"] = *c.CertificateURL
+				}
+
+				if c.CertificateStore != nil {
+					certificate["store"] = *c.CertificateStore
+				}
+
+				certificates = append(certificates, certificate)
+			}
+		}
+
+		output = append(output, map[string]interface{}{
+			"key_vault_id": keyVaultId,
+			"certificate":  certificates,
+		})
+	}
+
+	return output
+}
+
+func expandVirtualMachineNetworkInterfaceIDs(input []interface{}) []compute.NetworkInterfaceReference {
+	output := make([]compute.NetworkInterfaceReference, 0)
+
+	for i, v := range input {
+		output = append(output, compute.NetworkInterfaceReference{
+			ID: utils.String(v.(string)),
+			NetworkInterfaceReferenceProperties: &compute.NetworkInterfaceReferenceProperties{
+				// the first Network Interface is the Primary
+				Primary: utils.Bool(i == 0),
+			},
+		})
+	}
+
+	return output
+}
+
+func flattenVirtualMachineNetworkInterfaceIDs(input *[]compute.NetworkInterfaceReference) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make([]interface{}, 0)
+
+	for _, v := range *input {
+		if v.ID == nil {
+			continue
+		}
+
+		output = append(output, *v.ID)
+	}
+
+	return output
+}
+
+// virtualMachineIsRunning determines if the specified Virtual Machine is currently running - used to determine
+// whether the Virtual Machine should be started again after it's been shut down for an update
+func virtualMachineIsRunning(ctx context.Context, client *compute.VirtualMachinesClient, resourceGroup, name string) (bool, error) {
+	instanceView, err := client.InstanceView(ctx, resourceGroup, name)
+	if err != nil {
+		return false, fmt.Errorf("Error retrieving InstanceView for Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if instanceView.Statuses == nil {
+		return false, nil
+	}
+
+	for _, status := range *instanceView.Statuses {
+		if status.Code == nil {
+			continue
+		}
+
+		// could also be the provisioning state which we're not bothered with here
+		state := strings.ToLower(*status.Code)
+		if !strings.HasPrefix(state, "powerstate/") {
+			continue
+		}
+
+		return strings.TrimPrefix(state, "powerstate/") == "running", nil
+	}
+
+	return false, nil
+}
+
+// virtualMachineSizeRequiresDeallocation determines if the Virtual Machine needs to be deallocated to be
+// resized to the specified size - which is the case when the size isn't available on the current hardware cluster
+func virtualMachineSizeRequiresDeallocation(ctx context.Context, client *compute.VirtualMachinesClient, resourceGroup, name, newSize string) (bool, error) {
+	sizes, err := client.ListAvailableSizes(ctx, resourceGroup, name)
+	if err != nil {
+		return false, fmt.Errorf("Error retrieving available sizes for Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if sizes.Value != nil {
+		for _, size := range *sizes.Value {
+			if size.Name != nil && strings.EqualFold(*size.Name, newSize) {
+				return false, nil
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] Size %q is unavailable for Virtual Machine %q (Resource Group %q) on the current cluster - the Virtual Machine needs to be deallocated to resize", newSize, name, resourceGroup)
+	return true, nil
+}
+
+// shutDownVirtualMachine powers off the specified Virtual Machine - optionally deallocating it
+func shutDownVirtualMachine(ctx context.Context, client *compute.VirtualMachinesClient, resourceGroup, name string, deallocate bool) error {
+	if deallocate {
+		log.Printf("[DEBUG] Deallocating Virtual Machine %q (Resource Group %q)..", name, resourceGroup)
+		future, err := client.Deallocate(ctx, resourceGroup, name)
+		if err != nil {
+			return fmt.Errorf("Error deallocating Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for deallocation of Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		return nil
+	}
+
+	log.Printf("[DEBUG] Powering Off Virtual Machine %q (Resource Group %q)..", name, resourceGroup)
+	future, err := client.PowerOff(ctx, resourceGroup, name, utils.Bool(false))
+	if err != nil {
+		return fmt.Errorf("Error powering off Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Virtual Machine %q (Resource Group %q) to power off: %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func startVirtualMachine(ctx context.Context, client *compute.VirtualMachinesClient, resourceGroup, name string) error {
+	log.Printf("[DEBUG] Starting Virtual Machine %q (Resource Group %q)..", name, resourceGroup)
+	future, err := client.Start(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error starting Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Virtual Machine %q (Resource Group %q) to start: %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+// resizeVirtualMachineOSDisk resizes the Managed Disk used as the OS Disk - which requires that the
+// Virtual Machine is deallocated
+func resizeVirtualMachineOSDisk(ctx context.Context, client *compute.DisksClient, diskId string, newSize int) error {
+	id, err := azure.ParseAzureResourceID(diskId)
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["disks"]
+
+	update := compute.DiskUpdate{
+		DiskUpdateProperties: &compute.DiskUpdateProperties{
+			DiskSizeGB: utils.Int32(int32(newSize)),
+		},
+	}
+
+	future, err := client.Update(ctx, resourceGroup, name, update)
+	if err != nil {
+		return fmt.Errorf("Error resizing OS Disk %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for resize of OS Disk %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+// deleteVirtualMachineOSDisk deletes the Managed Disk used as the OS Disk for a Virtual Machine
+func deleteVirtualMachineOSDisk(ctx context.Context, client *compute.DisksClient, diskId string) error {
+	id, err := azure.ParseAzureResourceID(diskId)
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["disks"]
+
+	future, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error deleting OS Disk %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of OS Disk %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func additionalUnattendContentSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"content": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					Sensitive:    true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				"setting": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(compute.AutoLogon),
+						string(compute.FirstLogonCommands),
+					}, false),
+				},
+			},
+		},
+	}
+}
+
+func expandAdditionalUnattendContent(input []interface{}) *[]compute.AdditionalUnattendContent {
+	output := make([]compute.AdditionalUnattendContent, 0)
+
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+
+		output = append(output, compute.AdditionalUnattendContent{
+			SettingName: compute.SettingNames(raw["setting"].(string)),
+			Content:     utils.String(raw["content"].(string)),
+
+			// no other possible values
+			PassName:      compute.OobeSystem,
+			ComponentName: compute.MicrosoftWindowsShellSetup,
+		})
+	}
+
+	return &output
+}
+
+func flattenAdditionalUnattendContent(input *[]compute.AdditionalUnattendContent, d *schema.ResourceData) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	// the Content isn't returned from the API, so we pull this from the existing value in the state
+	existing := d.Get("additional_unattend_content").([]interface{})
+
+	output := make([]interface{}, 0)
+	for i, v := range *input {
+		content := ""
+		if len(existing) > i {
+			existingRaw := existing[i].(map[string]interface{})
+			if existingContent, ok := existingRaw["content"]; ok {
+				content = existingContent.(string)
+			}
+		}
+
+		output = append(output, map[string]interface{}{
+			"content": content,
+			"setting": string(v.SettingName),
+		})
+	}
+
+	return output
+}
+
+func winRmListenerSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		ForceNew: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"protocol": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(compute.HTTP),
+						string(compute.HTTPS),
+					}, false),
+				},
+
+				"certificate_url": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: azure.ValidateKeyVaultChildId,
+				},
+			},
+		},
+	}
+}
+
+func expandWinRMListener(input []interface{}) *compute.WinRMConfiguration {
+	listeners := make([]compute.WinRMListener, 0)
+
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+
+		listener := compute.WinRMListener{
+			Protocol: compute.ProtocolTypes(raw["protocol"].(string)),
+		}
+
+		if certificateUrl := raw["certificate_url"].(string); certificateUrl != "" {
+			listener.CertificateURL = utils.String(certificateUrl)
+		}
+
+		listeners = append(listeners, listener)
+	}
+
+	return &compute.WinRMConfiguration{
+		Listeners: &listeners,
+	}
+}
+
+func flattenWinRMListener(input *compute.WinRMConfiguration) []interface{} {
+	if input == nil || input.Listeners == nil {
+		return []interface{}{}
+	}
+
+	output := make([]interface{}, 0)
+
+	for _, v := range *input.Listeners {
+		certificateUrl := ""
+		if v.CertificateURL != nil {
+			certificateUrl = *v.CertificateURL
+		}
+
+		output = append(output, map[string]interface{}{
+			"certificate_url": certificateUrl,
+			"protocol":        string(v.Protocol),
+		})
+	}
+
+	return output
+}
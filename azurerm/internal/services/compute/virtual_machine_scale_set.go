@@ -0,0 +1,1119 @@
+package compute
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	networkSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func virtualMachineScaleSetNetworkInterfaceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				"ip_configuration": virtualMachineScaleSetIPConfigurationSchema(),
+
+				"dns_servers": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validate.NoEmptyStrings,
+					},
+				},
+
+				"enable_accelerated_networking": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"enable_ip_forwarding": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"network_security_group_id": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateFunc:     azure.ValidateResourceIDOrEmpty,
+					DiffSuppressFunc: suppress.ResourceIDDifference,
+				},
+
+				"primary": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+			},
+		},
+	}
+}
+
+func virtualMachineScaleSetIPConfigurationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				// Optional
+				"application_gateway_backend_address_pool_ids": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+					Set:      schema.HashString,
+				},
+
+				"application_security_group_ids": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: networkSvc.ValidateApplicationSecurityGroupID,
+					},
+					Set:      schema.HashString,
+					MaxItems: 20,
+				},
+
+				"load_balancer_backend_address_pool_ids": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+					Set:      schema.HashString,
+				},
+
+				"load_balancer_inbound_nat_rules_ids": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+					Set:      schema.HashString,
+				},
+
+				"primary": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"public_ip_address": virtualMachineScaleSetPublicIPAddressSchema(),
+
+				"subnet_id": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: networkSvc.ValidateSubnetID,
+				},
+
+				"version": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  string(compute.IPv4),
+					ValidateFunc: validation.StringInSlice([]string{
+						string(compute.IPv4),
+						string(compute.IPv6),
+					}, false),
+				},
+			},
+		},
+	}
+}
+
+func virtualMachineScaleSetPublicIPAddressSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				// Optional
+				"domain_name_label": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				"idle_timeout_in_minutes": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntBetween(4, 32),
+				},
+
+				"ip_tag": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"tag": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+							"type": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+						},
+					},
+				},
+
+				"public_ip_prefix_id": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: networkSvc.ValidatePublicIPPrefixID,
+				},
+			},
+		},
+	}
+}
+
+func expandVirtualMachineScaleSetNetworkInterface(input []interface{}) (*[]compute.VirtualMachineScaleSetNetworkConfiguration, error) {
+	output := make([]compute.VirtualMachineScaleSetNetworkConfiguration, 0)
+
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+
+		dnsServers := utils.ExpandStringSlice(raw["dns_servers"].([]interface{}))
+
+		ipConfigurations := make([]compute.VirtualMachineScaleSetIPConfiguration, 0)
+		ipConfigurationsRaw := raw["ip_configuration"].([]interface{})
+		for _, configV := range ipConfigurationsRaw {
+			configRaw := configV.(map[string]interface{})
+			ipConfiguration, err := expandVirtualMachineScaleSetIPConfiguration(configRaw)
+			if err != nil {
+				return nil, err
+			}
+
+			ipConfigurations = append(ipConfigurations, *ipConfiguration)
+		}
+
+		config := compute.VirtualMachineScaleSetNetworkConfiguration{
+			Name: utils.String(raw["name"].(string)),
+			VirtualMachineScaleSetNetworkConfigurationProperties: &compute.VirtualMachineScaleSetNetworkConfigurationProperties{
+				DNSSettings: &compute.VirtualMachineScaleSetNetworkConfigurationDNSSettings{
+					DNSServers: dnsServers,
+				},
+				EnableAcceleratedNetworking: utils.Bool(raw["enable_accelerated_networking"].(bool)),
+				EnableIPForwarding:          utils.Bool(raw["enable_ip_forwarding"].(bool)),
+				IPConfigurations:            &ipConfigurations,
+				Primary:                     utils.Bool(raw["primary"].(bool)),
+			},
+		}
+
+		if nsgId := raw["network_security_group_id"].(string); nsgId != "" {
+			config.VirtualMachineScaleSetNetworkConfigurationProperties.NetworkSecurityGroup = &compute.SubResource{
+				ID: utils.String(nsgId),
+			}
+		}
+
+		output = append(output, config)
+	}
+
+	return &output, nil
+}
+
+func expandVirtualMachineScaleSetIPConfiguration(raw map[string]interface{}) (*compute.VirtualMachineScaleSetIPConfiguration, error) {
+	applicationGatewayBackendAddressPoolIdsRaw := raw["application_gateway_backend_address_pool_ids"].(*schema.Set).List()
+	applicationGatewayBackendAddressPoolIds := expandIDsToSubResources(applicationGatewayBackendAddressPoolIdsRaw)
+
+	applicationSecurityGroupIdsRaw := raw["application_security_group_ids"].(*schema.Set).List()
+	applicationSecurityGroupIds := expandIDsToSubResources(applicationSecurityGroupIdsRaw)
+
+	loadBalancerBackendAddressPoolIdsRaw := raw["load_balancer_backend_address_pool_ids"].(*schema.Set).List()
+	loadBalancerBackendAddressPoolIds := expandIDsToSubResources(loadBalancerBackendAddressPoolIdsRaw)
+
+	loadBalancerInboundNatPoolIdsRaw := raw["load_balancer_inbound_nat_rules_ids"].(*schema.Set).List()
+	loadBalancerInboundNatPoolIds := expandIDsToSubResources(loadBalancerInboundNatPoolIdsRaw)
+
+	primary := raw["primary"].(bool)
+	version := compute.IPVersion(raw["version"].(string))
+	if primary && version == compute.IPv6 {
+		return nil, fmt.Errorf("An IPv6 Primary IP Configuration is unsupported - instead add a IPv4 IP Configuration as the Primary and make the IPv6 IP Configuration the secondary")
+	}
+
+	ipConfiguration := compute.VirtualMachineScaleSetIPConfiguration{
+		Name: utils.String(raw["name"].(string)),
+		VirtualMachineScaleSetIPConfigurationProperties: &compute.VirtualMachineScaleSetIPConfigurationProperties{
+			Primary:                               utils.Bool(primary),
+			PrivateIPAddressVersion:               version,
+			ApplicationGatewayBackendAddressPools: applicationGatewayBackendAddressPoolIds,
+			ApplicationSecurityGroups:             applicationSecurityGroupIds,
+			LoadBalancerBackendAddressPools:       loadBalancerBackendAddressPoolIds,
+			LoadBalancerInboundNatPools:           loadBalancerInboundNatPoolIds,
+		},
+	}
+
+	if subnetId := raw["subnet_id"].(string); subnetId != "" {
+		ipConfiguration.VirtualMachineScaleSetIPConfigurationProperties.Subnet = &compute.APIEntityReference{
+			ID: utils.String(subnetId),
+		}
+	}
+
+	publicIPConfigsRaw := raw["public_ip_address"].([]interface{})
+	if len(publicIPConfigsRaw) > 0 {
+		publicIPConfigRaw := publicIPConfigsRaw[0].(map[string]interface{})
+		publicIPAddressConfig := expandVirtualMachineScaleSetPublicIPAddress(publicIPConfigRaw)
+		ipConfiguration.VirtualMachineScaleSetIPConfigurationProperties.PublicIPAddressConfiguration = publicIPAddressConfig
+	}
+
+	return &ipConfiguration, nil
+}
+
+func expandVirtualMachineScaleSetPublicIPAddress(raw map[string]interface{}) *compute.VirtualMachineScaleSetPublicIPAddressConfiguration {
+	ipTags := make([]compute.VirtualMachineScaleSetIPTag, 0)
+	for _, ipTagV := range raw["ip_tag"].([]interface{}) {
+		ipTagRaw := ipTagV.(map[string]interface{})
+		ipTags = append(ipTags, compute.VirtualMachineScaleSetIPTag{
+			Tag:       utils.String(ipTagRaw["tag"].(string)),
+			IPTagType: utils.String(ipTagRaw["type"].(string)),
+		})
+	}
+
+	publicIPAddressConfig := compute.VirtualMachineScaleSetPublicIPAddressConfiguration{
+		Name: utils.String(raw["name"].(string)),
+		VirtualMachineScaleSetPublicIPAddressConfigurationProperties: &compute.VirtualMachineScaleSetPublicIPAddressConfigurationProperties{
+			IPTags: &ipTags,
+		},
+	}
+
+	if domainNameLabel := raw["domain_name_label"].(string); domainNameLabel != "" {
+		dns := &compute.VirtualMachineScaleSetPublicIPAddressConfigurationDNSSettings{
+			DomainNameLabel: utils.String(domainNameLabel),
+		}
+		publicIPAddressConfig.VirtualMachineScaleSetPublicIPAddressConfigurationProperties.DNSSettings = dns
+	}
+
+	if idleTimeout := raw["idle_timeout_in_minutes"].(int); idleTimeout > 0 {
+		publicIPAddressConfig.VirtualMachineScaleSetPublicIPAddressConfigurationProperties.IdleTimeoutInMinutes = utils.Int32(int32(idleTimeout))
+	}
+
+	if publicIPPrefixID := raw["public_ip_prefix_id"].(string); publicIPPrefixID != "" {
+		publicIPAddressConfig.VirtualMachineScaleSetPublicIPAddressConfigurationProperties.PublicIPPrefix = &compute.SubResource{
+			ID: utils.String(publicIPPrefixID),
+		}
+	}
+
+	return &publicIPAddressConfig
+}
+
+func expandVirtualMachineScaleSetNetworkInterfaceUpdate(input []interface{}) (*[]compute.VirtualMachineScaleSetUpdateNetworkConfiguration, error) {
+	output := make([]compute.VirtualMachineScaleSetUpdateNetworkConfiguration, 0)
+
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+
+		dnsServers := utils.ExpandStringSlice(raw["dns_servers"].([]interface{}))
+
+		ipConfigurations := make([]compute.VirtualMachineScaleSetUpdateIPConfiguration, 0)
+		ipConfigurationsRaw := raw["ip_configuration"].([]interface{})
+		for _, configV := range ipConfigurationsRaw {
+			configRaw := configV.(map[string]interface{})
+			ipConfiguration, err := expandVirtualMachineScaleSetIPConfigurationUpdate(configRaw)
+			if err != nil {
+				return nil, err
+			}
+
+			ipConfigurations = append(ipConfigurations, *ipConfiguration)
+		}
+
+		config := compute.VirtualMachineScaleSetUpdateNetworkConfiguration{
+			Name: utils.String(raw["name"].(string)),
+			VirtualMachineScaleSetUpdateNetworkConfigurationProperties: &compute.VirtualMachineScaleSetUpdateNetworkConfigurationProperties{
+				DNSSettings: &compute.VirtualMachineScaleSetNetworkConfigurationDNSSettings{
+					DNSServers: dnsServers,
+				},
+				EnableAcceleratedNetworking: utils.Bool(raw["enable_accelerated_networking"].(bool)),
+				EnableIPForwarding:          utils.Bool(raw["enable_ip_forwarding"].(bool)),
+				IPConfigurations:            &ipConfigurations,
+				Primary:                     utils.Bool(raw["primary"].(bool)),
+			},
+		}
+
+		if nsgId := raw["network_security_group_id"].(string); nsgId != "" {
+			config.VirtualMachineScaleSetUpdateNetworkConfigurationProperties.NetworkSecurityGroup = &compute.SubResource{
+				ID: utils.String(nsgId),
+			}
+		}
+
+		output = append(output, config)
+	}
+
+	return &output, nil
+}
+
+func expandVirtualMachineScaleSetIPConfigurationUpdate(raw map[string]interface{}) (*compute.VirtualMachineScaleSetUpdateIPConfiguration, error) {
+	applicationGatewayBackendAddressPoolIdsRaw := raw["application_gateway_backend_address_pool_ids"].(*schema.Set).List()
+	applicationGatewayBackendAddressPoolIds := expandIDsToSubResources(applicationGatewayBackendAddressPoolIdsRaw)
+
+	applicationSecurityGroupIdsRaw := raw["application_security_group_ids"].(*schema.Set).List()
+	applicationSecurityGroupIds := expandIDsToSubResources(applicationSecurityGroupIdsRaw)
+
+	loadBalancerBackendAddressPoolIdsRaw := raw["load_balancer_backend_address_pool_ids"].(*schema.Set).List()
+	loadBalancerBackendAddressPoolIds := expandIDsToSubResources(loadBalancerBackendAddressPoolIdsRaw)
+
+	loadBalancerInboundNatPoolIdsRaw := raw["load_balancer_inbound_nat_rules_ids"].(*schema.Set).List()
+	loadBalancerInboundNatPoolIds := expandIDsToSubResources(loadBalancerInboundNatPoolIdsRaw)
+
+	primary := raw["primary"].(bool)
+	version := compute.IPVersion(raw["version"].(string))
+	if primary && version == compute.IPv6 {
+		return nil, fmt.Errorf("An IPv6 Primary IP Configuration is unsupported - instead add a IPv4 IP Configuration as the Primary and make the IPv6 IP Configuration the secondary")
+	}
+
+	ipConfiguration := compute.VirtualMachineScaleSetUpdateIPConfiguration{
+		Name: utils.String(raw["name"].(string)),
+		VirtualMachineScaleSetUpdateIPConfigurationProperties: &compute.VirtualMachineScaleSetUpdateIPConfigurationProperties{
+			Primary:                               utils.Bool(primary),
+			PrivateIPAddressVersion:               version,
+			ApplicationGatewayBackendAddressPools: applicationGatewayBackendAddressPoolIds,
+			ApplicationSecurityGroups:             applicationSecurityGroupIds,
+			LoadBalancerBackendAddressPools:       loadBalancerBackendAddressPoolIds,
+			LoadBalancerInboundNatPools:           loadBalancerInboundNatPoolIds,
+		},
+	}
+
+	if subnetId := raw["subnet_id"].(string); subnetId != "" {
+		ipConfiguration.VirtualMachineScaleSetUpdateIPConfigurationProperties.Subnet = &compute.APIEntityReference{
+			ID: utils.String(subnetId),
+		}
+	}
+
+	publicIPConfigsRaw := raw["public_ip_address"].([]interface{})
+	if len(publicIPConfigsRaw) > 0 {
+		publicIPConfigRaw := publicIPConfigsRaw[0].(map[string]interface{})
+		publicIPAddressConfig := expandVirtualMachineScaleSetPublicIPAddress(publicIPConfigRaw)
+
+		// the Update model doesn't support IP Tags or a Public IP Prefix, which are both ForceNew
+		ipConfiguration.VirtualMachineScaleSetUpdateIPConfigurationProperties.PublicIPAddressConfiguration = &compute.VirtualMachineScaleSetUpdatePublicIPAddressConfiguration{
+			Name: publicIPAddressConfig.Name,
+			VirtualMachineScaleSetUpdatePublicIPAddressConfigurationProperties: &compute.VirtualMachineScaleSetUpdatePublicIPAddressConfigurationProperties{
+				DNSSettings:          publicIPAddressConfig.DNSSettings,
+				IdleTimeoutInMinutes: publicIPAddressConfig.IdleTimeoutInMinutes,
+			},
+		}
+	}
+
+	return &ipConfiguration, nil
+}
+
+func expandIDsToSubResources(input []interface{}) *[]compute.SubResource {
+	ids := make([]compute.SubResource, 0)
+
+	for _, v := range input {
+		ids = append(ids, compute.SubResource{
+			ID: utils.String(v.(string)),
+		})
+	}
+
+	return &ids
+}
+
+func flattenVirtualMachineScaleSetNetworkInterface(input *[]compute.VirtualMachineScaleSetNetworkConfiguration) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	results := make([]interface{}, 0)
+	for _, v := range *input {
+		var name, networkSecurityGroupId string
+		if v.Name != nil {
+			name = *v.Name
+		}
+		if v.NetworkSecurityGroup != nil && v.NetworkSecurityGroup.ID != nil {
+			networkSecurityGroupId = *v.NetworkSecurityGroup.ID
+		}
+
+		var enableAcceleratedNetworking, enableIPForwarding, primary bool
+		if v.EnableAcceleratedNetworking != nil {
+			enableAcceleratedNetworking = *v.EnableAcceleratedNetworking
+		}
+		if v.EnableIPForwarding != nil {
+			enableIPForwarding = *v.EnableIPForwarding
+		}
+		if v.Primary != nil {
+			primary = *v.Primary
+		}
+
+		var dnsServers []interface{}
+		if settings := v.DNSSettings; settings != nil {
+			dnsServers = utils.FlattenStringSlice(v.DNSSettings.DNSServers)
+		}
+
+		var ipConfigurations []interface{}
+		if v.IPConfigurations != nil {
+			for _, configRaw := range *v.IPConfigurations {
+				config := flattenVirtualMachineScaleSetIPConfiguration(configRaw)
+				ipConfigurations = append(ipConfigurations, config)
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                          name,
+			"dns_servers":                   dnsServers,
+			"enable_accelerated_networking": enableAcceleratedNetworking,
+			"enable_ip_forwarding":          enableIPForwarding,
+			"ip_configuration":              ipConfigurations,
+			"network_security_group_id":     networkSecurityGroupId,
+			"primary":                       primary,
+		})
+	}
+
+	return results
+}
+
+func flattenVirtualMachineScaleSetIPConfiguration(input compute.VirtualMachineScaleSetIPConfiguration) map[string]interface{} {
+	var name, subnetId string
+	if input.Name != nil {
+		name = *input.Name
+	}
+	if input.Subnet != nil && input.Subnet.ID != nil {
+		subnetId = *input.Subnet.ID
+	}
+
+	var primary bool
+	if input.Primary != nil {
+		primary = *input.Primary
+	}
+
+	var publicIPAddresses []interface{}
+	if input.PublicIPAddressConfiguration != nil {
+		publicIPAddresses = append(publicIPAddresses, flattenVirtualMachineScaleSetPublicIPAddress(*input.PublicIPAddressConfiguration))
+	}
+
+	applicationGatewayBackendAddressPoolIds := flattenSubResourcesToIDs(input.ApplicationGatewayBackendAddressPools)
+	applicationSecurityGroupIds := flattenSubResourcesToIDs(input.ApplicationSecurityGroups)
+	loadBalancerBackendAddressPoolIds := flattenSubResourcesToIDs(input.LoadBalancerBackendAddressPools)
+	loadBalancerInboundNatRuleIds := flattenSubResourcesToIDs(input.LoadBalancerInboundNatPools)
+
+	return map[string]interface{}{
+		"name":              name,
+		"primary":           primary,
+		"public_ip_address": publicIPAddresses,
+		"subnet_id":         subnetId,
+		"version":           string(input.PrivateIPAddressVersion),
+		"application_gateway_backend_address_pool_ids": schema.NewSet(schema.HashString, applicationGatewayBackendAddressPoolIds),
+		"application_security_group_ids":               schema.NewSet(schema.HashString, applicationSecurityGroupIds),
+		"load_balancer_backend_address_pool_ids":       schema.NewSet(schema.HashString, loadBalancerBackendAddressPoolIds),
+		"load_balancer_inbound_nat_rules_ids":          schema.NewSet(schema.HashString, loadBalancerInboundNatRuleIds),
+	}
+}
+
+func flattenVirtualMachineScaleSetPublicIPAddress(input compute.VirtualMachineScaleSetPublicIPAddressConfiguration) map[string]interface{} {
+	ipTags := make([]interface{}, 0)
+	if input.IPTags != nil {
+		for _, rawTag := range *input.IPTags {
+			var tag, tagType string
+
+			if rawTag.IPTagType != nil {
+				tagType = *rawTag.IPTagType
+			}
+
+			if rawTag.Tag != nil {
+				tag = *rawTag.Tag
+			}
+
+			ipTags = append(ipTags, map[string]interface{}{
+				"tag":  tag,
+				"type": tagType,
+			})
+		}
+	}
+
+	var domainNameLabel, name, publicIPPrefixId string
+	if input.DNSSettings != nil && input.DNSSettings.DomainNameLabel != nil {
+		domainNameLabel = *input.DNSSettings.DomainNameLabel
+	}
+	if input.Name != nil {
+		name = *input.Name
+	}
+	if input.PublicIPPrefix != nil && input.PublicIPPrefix.ID != nil {
+		publicIPPrefixId = *input.PublicIPPrefix.ID
+	}
+
+	var idleTimeoutInMinutes int
+	if input.IdleTimeoutInMinutes != nil {
+		idleTimeoutInMinutes = int(*input.IdleTimeoutInMinutes)
+	}
+
+	return map[string]interface{}{
+		"name":                    name,
+		"domain_name_label":       domainNameLabel,
+		"idle_timeout_in_minutes": idleTimeoutInMinutes,
+		"ip_tag":                  ipTags,
+		"public_ip_prefix_id":     publicIPPrefixId,
+	}
+}
+
+func flattenSubResourcesToIDs(input *[]compute.SubResource) []interface{} {
+	ids := make([]interface{}, 0)
+	if input == nil {
+		return ids
+	}
+
+	for _, v := range *input {
+		if v.ID == nil {
+			continue
+		}
+
+		ids = append(ids, *v.ID)
+	}
+
+	return ids
+}
+
+func virtualMachineScaleSetDataDiskSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"caching": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(compute.CachingTypesNone),
+						string(compute.CachingTypesReadOnly),
+						string(compute.CachingTypesReadWrite),
+					}, false),
+				},
+
+				"disk_size_gb": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(1, 4095),
+				},
+
+				"lun": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(0, 2000),
+				},
+
+				"storage_account_type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(compute.StorageAccountTypesPremiumLRS),
+						string(compute.StorageAccountTypesStandardLRS),
+						string(compute.StorageAccountTypesStandardSSDLRS),
+						string(compute.StorageAccountTypesUltraSSDLRS),
+					}, false),
+				},
+
+				"write_accelerator_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+			},
+		},
+	}
+}
+
+func expandVirtualMachineScaleSetDataDisk(input []interface{}) *[]compute.VirtualMachineScaleSetDataDisk {
+	disks := make([]compute.VirtualMachineScaleSetDataDisk, 0)
+
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+
+		disks = append(disks, compute.VirtualMachineScaleSetDataDisk{
+			Caching:    compute.CachingTypes(raw["caching"].(string)),
+			DiskSizeGB: utils.Int32(int32(raw["disk_size_gb"].(int))),
+			Lun:        utils.Int32(int32(raw["lun"].(int))),
+			ManagedDisk: &compute.VirtualMachineScaleSetManagedDiskParameters{
+				StorageAccountType: compute.StorageAccountTypes(raw["storage_account_type"].(string)),
+			},
+			WriteAcceleratorEnabled: utils.Bool(raw["write_accelerator_enabled"].(bool)),
+
+			// Data Disks within a Scale Set are always created as Empty disks
+			CreateOption: compute.DiskCreateOptionTypesEmpty,
+		})
+	}
+
+	return &disks
+}
+
+func flattenVirtualMachineScaleSetDataDisk(input *[]compute.VirtualMachineScaleSetDataDisk) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make([]interface{}, 0)
+
+	for _, v := range *input {
+		diskSizeGb := 0
+		if v.DiskSizeGB != nil && *v.DiskSizeGB != 0 {
+			diskSizeGb = int(*v.DiskSizeGB)
+		}
+
+		lun := 0
+		if v.Lun != nil {
+			lun = int(*v.Lun)
+		}
+
+		storageAccountType := ""
+		if v.ManagedDisk != nil {
+			storageAccountType = string(v.ManagedDisk.StorageAccountType)
+		}
+
+		writeAcceleratorEnabled := false
+		if v.WriteAcceleratorEnabled != nil {
+			writeAcceleratorEnabled = *v.WriteAcceleratorEnabled
+		}
+
+		output = append(output, map[string]interface{}{
+			"caching":                   string(v.Caching),
+			"lun":                       lun,
+			"disk_size_gb":              diskSizeGb,
+			"storage_account_type":      storageAccountType,
+			"write_accelerator_enabled": writeAcceleratorEnabled,
+		})
+	}
+
+	return output
+}
+
+func virtualMachineScaleSetOSDiskSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"caching": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(compute.CachingTypesNone),
+						string(compute.CachingTypesReadOnly),
+						string(compute.CachingTypesReadWrite),
+					}, false),
+				},
+
+				"storage_account_type": {
+					Type:     schema.TypeString,
+					Required: true,
+					// whilst this appears in the Update block the API returns this when changing:
+					// Changing property 'osDisk.managedDisk.storageAccountType' is not allowed
+					ForceNew: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(compute.StorageAccountTypesPremiumLRS),
+						string(compute.StorageAccountTypesStandardLRS),
+						string(compute.StorageAccountTypesStandardSSDLRS),
+					}, false),
+				},
+
+				"diff_disk_settings": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"option": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(compute.Local),
+								}, false),
+							},
+						},
+					},
+				},
+
+				"disk_size_gb": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntBetween(0, 4095),
+				},
+
+				"write_accelerator_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+			},
+		},
+	}
+}
+
+func expandVirtualMachineScaleSetOSDisk(input []interface{}, osType compute.OperatingSystemTypes) *compute.VirtualMachineScaleSetOSDisk {
+	raw := input[0].(map[string]interface{})
+	disk := compute.VirtualMachineScaleSetOSDisk{
+		Caching: compute.CachingTypes(raw["caching"].(string)),
+		ManagedDisk: &compute.VirtualMachineScaleSetManagedDiskParameters{
+			StorageAccountType: compute.StorageAccountTypes(raw["storage_account_type"].(string)),
+		},
+		WriteAcceleratorEnabled: utils.Bool(raw["write_accelerator_enabled"].(bool)),
+
+		// these have to be hard-coded so there's no point exposing them
+		CreateOption: compute.DiskCreateOptionTypesFromImage,
+		OsType:       osType,
+	}
+
+	if osDiskSize := raw["disk_size_gb"].(int); osDiskSize > 0 {
+		disk.DiskSizeGB = utils.Int32(int32(osDiskSize))
+	}
+
+	if diffDiskSettingsRaw := raw["diff_disk_settings"].([]interface{}); len(diffDiskSettingsRaw) > 0 {
+		diffDiskRaw := diffDiskSettingsRaw[0].(map[string]interface{})
+		disk.DiffDiskSettings = &compute.DiffDiskSettings{
+			Option: compute.DiffDiskOptions(diffDiskRaw["option"].(string)),
+		}
+	}
+
+	return &disk
+}
+
+func expandVirtualMachineScaleSetOSDiskUpdate(input []interface{}) *compute.VirtualMachineScaleSetUpdateOSDisk {
+	raw := input[0].(map[string]interface{})
+	disk := compute.VirtualMachineScaleSetUpdateOSDisk{
+		Caching: compute.CachingTypes(raw["caching"].(string)),
+		ManagedDisk: &compute.VirtualMachineScaleSetManagedDiskParameters{
+			StorageAccountType: compute.StorageAccountTypes(raw["storage_account_type"].(string)),
+		},
+		WriteAcceleratorEnabled: utils.Bool(raw["write_accelerator_enabled"].(bool)),
+	}
+
+	if osDiskSize := raw["disk_size_gb"].(int); osDiskSize > 0 {
+		disk.DiskSizeGB = utils.Int32(int32(osDiskSize))
+	}
+
+	return &disk
+}
+
+func flattenVirtualMachineScaleSetOSDisk(input *compute.VirtualMachineScaleSetOSDisk) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	diffDiskSettings := make([]interface{}, 0)
+	if input.DiffDiskSettings != nil {
+		diffDiskSettings = append(diffDiskSettings, map[string]interface{}{
+			"option": string(input.DiffDiskSettings.Option),
+		})
+	}
+
+	diskSizeGb := 0
+	if input.DiskSizeGB != nil && *input.DiskSizeGB != 0 {
+		diskSizeGb = int(*input.DiskSizeGB)
+	}
+
+	storageAccountType := ""
+	if input.ManagedDisk != nil {
+		storageAccountType = string(input.ManagedDisk.StorageAccountType)
+	}
+
+	writeAcceleratorEnabled := false
+	if input.WriteAcceleratorEnabled != nil {
+		writeAcceleratorEnabled = *input.WriteAcceleratorEnabled
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"caching":                   string(input.Caching),
+			"disk_size_gb":              diskSizeGb,
+			"diff_disk_settings":        diffDiskSettings,
+			"storage_account_type":      storageAccountType,
+			"write_accelerator_enabled": writeAcceleratorEnabled,
+		},
+	}
+}
+
+func expandVirtualMachineScaleSetIdentity(input []interface{}) (*compute.VirtualMachineScaleSetIdentity, error) {
+	if len(input) == 0 {
+		// explicitly sending `None` removes any existing Identity during an update
+		return &compute.VirtualMachineScaleSetIdentity{
+			Type: compute.ResourceIdentityTypeNone,
+		}, nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	identity := compute.VirtualMachineScaleSetIdentity{
+		Type: compute.ResourceIdentityType(raw["type"].(string)),
+	}
+
+	identityIdsRaw := raw["identity_ids"].([]interface{})
+	identityIds := make(map[string]*compute.VirtualMachineScaleSetIdentityUserAssignedIdentitiesValue)
+	for _, v := range identityIdsRaw {
+		identityIds[v.(string)] = &compute.VirtualMachineScaleSetIdentityUserAssignedIdentitiesValue{}
+	}
+
+	if identity.Type == compute.ResourceIdentityTypeUserAssigned || identity.Type == compute.ResourceIdentityTypeSystemAssignedUserAssigned {
+		if len(identityIds) == 0 {
+			return nil, fmt.Errorf("`identity_ids` must be specified when `type` is set to %q", string(identity.Type))
+		}
+
+		identity.UserAssignedIdentities = identityIds
+	} else if len(identityIds) > 0 {
+		return nil, fmt.Errorf("`identity_ids` can only be specified when `type` includes `UserAssigned`")
+	}
+
+	return &identity, nil
+}
+
+func flattenVirtualMachineScaleSetIdentity(input *compute.VirtualMachineScaleSetIdentity) []interface{} {
+	if input == nil || input.Type == compute.ResourceIdentityTypeNone {
+		return []interface{}{}
+	}
+
+	identityIds := make([]interface{}, 0)
+	for key := range input.UserAssignedIdentities {
+		identityIds = append(identityIds, key)
+	}
+
+	principalId := ""
+	if input.PrincipalID != nil {
+		principalId = *input.PrincipalID
+	}
+
+	tenantId := ""
+	if input.TenantID != nil {
+		tenantId = *input.TenantID
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":         string(input.Type),
+			"identity_ids": identityIds,
+			"principal_id": principalId,
+			"tenant_id":    tenantId,
+		},
+	}
+}
+
+func virtualMachineScaleSetAutomatedOSUpgradePolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"disable_automatic_rollback": {
+					Type:     schema.TypeBool,
+					Required: true,
+				},
+
+				"enable_automatic_os_upgrade": {
+					Type:     schema.TypeBool,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func expandVirtualMachineScaleSetAutomaticUpgradePolicy(input []interface{}) *compute.AutomaticOSUpgradePolicy {
+	if len(input) == 0 {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &compute.AutomaticOSUpgradePolicy{
+		DisableAutomaticRollback: utils.Bool(raw["disable_automatic_rollback"].(bool)),
+		EnableAutomaticOSUpgrade: utils.Bool(raw["enable_automatic_os_upgrade"].(bool)),
+	}
+}
+
+func flattenVirtualMachineScaleSetAutomaticOSUpgradePolicy(input *compute.AutomaticOSUpgradePolicy) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	disableAutomaticRollback := false
+	if input.DisableAutomaticRollback != nil {
+		disableAutomaticRollback = *input.DisableAutomaticRollback
+	}
+
+	enableAutomaticOSUpgrade := false
+	if input.EnableAutomaticOSUpgrade != nil {
+		enableAutomaticOSUpgrade = *input.EnableAutomaticOSUpgrade
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"disable_automatic_rollback":  disableAutomaticRollback,
+			"enable_automatic_os_upgrade": enableAutomaticOSUpgrade,
+		},
+	}
+}
+
+func virtualMachineScaleSetRollingUpgradePolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_batch_instance_percent": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(5, 100),
+				},
+
+				"max_unhealthy_instance_percent": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(5, 100),
+				},
+
+				"max_unhealthy_upgraded_instance_percent": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(0, 100),
+				},
+
+				"pause_time_between_batches": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validate.ISO8601Duration,
+				},
+			},
+		},
+	}
+}
+
+func expandVirtualMachineScaleSetRollingUpgradePolicy(input []interface{}) *compute.RollingUpgradePolicy {
+	if len(input) == 0 {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+
+	return &compute.RollingUpgradePolicy{
+		MaxBatchInstancePercent:             utils.Int32(int32(raw["max_batch_instance_percent"].(int))),
+		MaxUnhealthyInstancePercent:         utils.Int32(int32(raw["max_unhealthy_instance_percent"].(int))),
+		MaxUnhealthyUpgradedInstancePercent: utils.Int32(int32(raw["max_unhealthy_upgraded_instance_percent"].(int))),
+		PauseTimeBetweenBatches:             utils.String(raw["pause_time_between_batches"].(string)),
+	}
+}
+
+func flattenVirtualMachineScaleSetRollingUpgradePolicy(input *compute.RollingUpgradePolicy) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	maxBatchInstancePercent := 0
+	if input.MaxBatchInstancePercent != nil {
+		maxBatchInstancePercent = int(*input.MaxBatchInstancePercent)
+	}
+
+	maxUnhealthyInstancePercent := 0
+	if input.MaxUnhealthyInstancePercent != nil {
+		maxUnhealthyInstancePercent = int(*input.MaxUnhealthyInstancePercent)
+	}
+
+	maxUnhealthyUpgradedInstancePercent := 0
+	if input.MaxUnhealthyUpgradedInstancePercent != nil {
+		maxUnhealthyUpgradedInstancePercent = int(*input.MaxUnhealthyUpgradedInstancePercent)
+	}
+
+	pauseTimeBetweenBatches := ""
+	if input.PauseTimeBetweenBatches != nil {
+		pauseTimeBetweenBatches = *input.PauseTimeBetweenBatches
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"max_batch_instance_percent":              maxBatchInstancePercent,
+			"max_unhealthy_instance_percent":          maxUnhealthyInstancePercent,
+			"max_unhealthy_upgraded_instance_percent": maxUnhealthyUpgradedInstancePercent,
+			"pause_time_between_batches":              pauseTimeBetweenBatches,
+		},
+	}
+}
+
+func virtualMachineScaleSetTerminateNotificationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enabled": {
+					Type:     schema.TypeBool,
+					Required: true,
+				},
+
+				"timeout": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validate.ISO8601Duration,
+					Default:      "PT5M",
+				},
+			},
+		},
+	}
+}
+
+func expandVirtualMachineScaleSetScheduledEventsProfile(input []interface{}) *compute.ScheduledEventsProfile {
+	if len(input) == 0 {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	enabled := raw["enabled"].(bool)
+	timeout := raw["timeout"].(string)
+
+	return &compute.ScheduledEventsProfile{
+		TerminateNotificationProfile: &compute.TerminateNotificationProfile{
+			Enable:           &enabled,
+			NotBeforeTimeout: &timeout,
+		},
+	}
+}
+
+func flattenVirtualMachineScaleSetScheduledEventsProfile(input *compute.ScheduledEventsProfile) []interface{} {
+	// the API omits the ScheduledEventsProfile when Terminate Notifications are disabled, so that explicitly
+	// disabling them doesn't show a diff we return the default values here
+	enabled := false
+	if input != nil && input.TerminateNotificationProfile != nil && input.TerminateNotificationProfile.Enable != nil {
+		enabled = *input.TerminateNotificationProfile.Enable
+	}
+
+	timeout := "PT5M"
+	if input != nil && input.TerminateNotificationProfile != nil && input.TerminateNotificationProfile.NotBeforeTimeout != nil {
+		timeout = *input.TerminateNotificationProfile.NotBeforeTimeout
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"enabled": enabled,
+			"timeout": timeout,
+		},
+	}
+}
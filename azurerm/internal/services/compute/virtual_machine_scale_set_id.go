@@ -0,0 +1,78 @@
+package compute
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// VirtualMachineScaleSetID is a typed representation of the ID of a Virtual Machine Scale Set
+type VirtualMachineScaleSetID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewVirtualMachineScaleSetID returns a new VirtualMachineScaleSetID from the specified segments
+func NewVirtualMachineScaleSetID(subscriptionId, resourceGroup, name string) VirtualMachineScaleSetID {
+	return VirtualMachineScaleSetID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Virtual Machine Scale Set
+func (id VirtualMachineScaleSetID) String() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/virtualMachineScaleSets/%s", id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseVirtualMachineScaleSetID parses the specified Resource ID into a VirtualMachineScaleSetID, returning an error
+// if the ID isn't a valid Virtual Machine Scale Set ID
+func ParseVirtualMachineScaleSetID(input string) (*VirtualMachineScaleSetID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Machine Scale Set ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Compute") {
+		return nil, fmt.Errorf("Error parsing Virtual Machine Scale Set ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Compute", id.Provider)
+	}
+
+	resourceId := VirtualMachineScaleSetID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("Error parsing Virtual Machine Scale Set ID %q: ID was missing the `resourceGroups` element", input)
+	}
+
+	if resourceId.Name, err = id.PopSegment("virtualMachineScaleSets"); err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Machine Scale Set ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoRemainingSegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Machine Scale Set ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateVirtualMachineScaleSetID validates that the specified value is a valid Virtual Machine Scale Set ID
+func ValidateVirtualMachineScaleSetID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseVirtualMachineScaleSetID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Virtual Machine Scale Set ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,120 @@
+package compute
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"testing"
+)
+
+func TestVirtualMachineScaleSetIDFormatter(t *testing.T) {
+	actual := NewVirtualMachineScaleSetID("12345678-1234-9876-4563-123456789012", "resourceGroup1", "name1").String()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resourceGroup1/providers/Microsoft.Compute/virtualMachineScaleSets/name1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestParseVirtualMachineScaleSetID(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    string
+		Expected *VirtualMachineScaleSetID
+	}{
+		{
+			Name:     "Empty",
+			Input:    "",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing subscriptions Segment",
+			Input:    "/resourceGroups/resourceGroup1/providers/Microsoft.Compute/virtualMachineScaleSets/name1",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing subscriptions Value",
+			Input:    "/subscriptions//resourceGroups/resourceGroup1/providers/Microsoft.Compute/virtualMachineScaleSets/name1",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing resourceGroups Segment",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Compute/virtualMachineScaleSets/name1",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing resourceGroups Value",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups//providers/Microsoft.Compute/virtualMachineScaleSets/name1",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing virtualMachineScaleSets Segment",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resourceGroup1/providers/Microsoft.Compute",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing virtualMachineScaleSets Value",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resourceGroup1/providers/Microsoft.Compute/virtualMachineScaleSets/",
+			Expected: nil,
+		},
+		{
+			Name:     "Wrong Resource Provider",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resourceGroup1/providers/Microsoft.Example/virtualMachineScaleSets/name1",
+			Expected: nil,
+		},
+		{
+			Name:     "Unexpected Segment",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resourceGroup1/providers/Microsoft.Compute/virtualMachineScaleSets/name1/extra/value1",
+			Expected: nil,
+		},
+		{
+			Name:  "Valid",
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resourceGroup1/providers/Microsoft.Compute/virtualMachineScaleSets/name1",
+			Expected: &VirtualMachineScaleSetID{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "resourceGroup1",
+				Name:           "name1",
+			},
+		},
+		{
+			Name:  "Different Casing",
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/resourceGroup1/providers/microsoft.compute/VIRTUALMACHINESCALESETS/name1",
+			Expected: &VirtualMachineScaleSetID{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "resourceGroup1",
+				Name:           "name1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := ParseVirtualMachineScaleSetID(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Expected == nil {
+			t.Fatalf("Expected an error but got a value for %q", v.Input)
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+
+		if _, errors := ValidateVirtualMachineScaleSetID(v.Input, "id"); len(errors) > 0 {
+			t.Fatalf("Expected %q to be a valid ID but got: %+v", v.Input, errors)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// virtualMachineScaleSetUpdateMetaData contains the information needed to apply an update to a Virtual Machine
+// Scale Set - and then (where required) to roll the instances within it onto the latest model
+type virtualMachineScaleSetUpdateMetaData struct {
+	// is `enable_automatic_os_upgrade` enabled within the `automatic_os_upgrade_policy` block?
+	AutomaticOSUpgradeIsEnabled bool
+
+	// does this update change the model of the instances within the Scale Set?
+	CanRollInstancesWhenRequired bool
+
+	// is the image being updated to a `latest` version - which the platform won't roll out automatically?
+	UpdateToLatestImageVersion bool
+
+	Client *clients.Client
+	ID     *VirtualMachineScaleSetID
+	OSType compute.OperatingSystemTypes
+}
+
+// performUpdate applies the update to the Virtual Machine Scale Set - the `update` must contain the `UpgradePolicy`
+func (metadata virtualMachineScaleSetUpdateMetaData) performUpdate(ctx context.Context, update compute.VirtualMachineScaleSetUpdate) error {
+	upgradePolicy := *update.VirtualMachineScaleSetUpdateProperties.UpgradePolicy
+	toggleAutomaticOSUpgrade := metadata.AutomaticOSUpgradeIsEnabled && metadata.CanRollInstancesWhenRequired
+	if toggleAutomaticOSUpgrade {
+		// Virtual Machine Scale Sets with Automatic OS Upgrade enabled must have all instances upgraded to the same
+		// Platform Image - as such we temporarily disable it, roll the instances onto the latest model and
+		// then enable it again
+		update.VirtualMachineScaleSetUpdateProperties.UpgradePolicy = &compute.UpgradePolicy{
+			Mode:                 upgradePolicy.Mode,
+			RollingUpgradePolicy: upgradePolicy.RollingUpgradePolicy,
+			AutomaticOSUpgradePolicy: &compute.AutomaticOSUpgradePolicy{
+				DisableAutomaticRollback: upgradePolicy.AutomaticOSUpgradePolicy.DisableAutomaticRollback,
+				EnableAutomaticOSUpgrade: utils.Bool(false),
+			},
+		}
+	}
+
+	if err := metadata.updateVmss(ctx, update); err != nil {
+		return err
+	}
+
+	if metadata.CanRollInstancesWhenRequired && metadata.Client.Features.VirtualMachineScaleSet.RollInstancesWhenRequired {
+		switch upgradePolicy.Mode {
+		case compute.Automatic:
+			// changes to the model are rolled out by the platform automatically - other than an image using
+			// the `latest` version, which needs an OS Upgrade to be triggered
+			if metadata.UpdateToLatestImageVersion {
+				if err := metadata.upgradeInstancesForAutomaticUpgradePolicy(ctx); err != nil {
+					return err
+				}
+			}
+
+		case compute.Manual:
+			if err := metadata.upgradeInstancesForManualUpgradePolicy(ctx); err != nil {
+				return err
+			}
+
+		case compute.Rolling:
+			// changes to the model are rolled out by the platform in batches, as defined in the `rolling_upgrade_policy`
+			log.Printf("[DEBUG] Instances within %s Virtual Machine Scale Set %q (Resource Group %q) will be upgraded using the Rolling Upgrade Policy", metadata.OSType, metadata.ID.Name, metadata.ID.ResourceGroup)
+		}
+	}
+
+	if toggleAutomaticOSUpgrade {
+		// the instances have been upgraded, so we can now re-enable Automatic OS Upgrades
+		update := compute.VirtualMachineScaleSetUpdate{
+			VirtualMachineScaleSetUpdateProperties: &compute.VirtualMachineScaleSetUpdateProperties{
+				UpgradePolicy: &upgradePolicy,
+			},
+		}
+
+		if err := metadata.updateVmss(ctx, update); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (metadata virtualMachineScaleSetUpdateMetaData) updateVmss(ctx context.Context, update compute.VirtualMachineScaleSetUpdate) error {
+	client := metadata.Client.Compute.VMScaleSetClient
+	id := metadata.ID
+
+	log.Printf("[DEBUG] Updating %s Virtual Machine Scale Set %q (Resource Group %q)..", metadata.OSType, id.Name, id.ResourceGroup)
+	future, err := client.Update(ctx, id.ResourceGroup, id.Name, update)
+	if err != nil {
+		return fmt.Errorf("Error updating %s Virtual Machine Scale Set %q (Resource Group %q): %+v", metadata.OSType, id.Name, id.ResourceGroup, err)
+	}
+
+	log.Printf("[DEBUG] Waiting for update of %s Virtual Machine Scale Set %q (Resource Group %q)..", metadata.OSType, id.Name, id.ResourceGroup)
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for update of %s Virtual Machine Scale Set %q (Resource Group %q): %+v", metadata.OSType, id.Name, id.ResourceGroup, err)
+	}
+	log.Printf("[DEBUG] Updated %s Virtual Machine Scale Set %q (Resource Group %q).", metadata.OSType, id.Name, id.ResourceGroup)
+
+	return nil
+}
+
+func (metadata virtualMachineScaleSetUpdateMetaData) upgradeInstancesForAutomaticUpgradePolicy(ctx context.Context) error {
+	client := metadata.Client.Compute.VMScaleSetRollingUpgradesClient
+	id := metadata.ID
+
+	log.Printf("[DEBUG] Triggering an OS Upgrade for %s Virtual Machine Scale Set %q (Resource Group %q)..", metadata.OSType, id.Name, id.ResourceGroup)
+	future, err := client.StartOSUpgrade(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("Error triggering an OS Upgrade for %s Virtual Machine Scale Set %q (Resource Group %q): %+v", metadata.OSType, id.Name, id.ResourceGroup, err)
+	}
+
+	log.Printf("[DEBUG] Waiting for the OS Upgrade of %s Virtual Machine Scale Set %q (Resource Group %q)..", metadata.OSType, id.Name, id.ResourceGroup)
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for the OS Upgrade of %s Virtual Machine Scale Set %q (Resource Group %q): %+v", metadata.OSType, id.Name, id.ResourceGroup, err)
+	}
+
+	return nil
+}
+
+func (metadata virtualMachineScaleSetUpdateMetaData) upgradeInstancesForManualUpgradePolicy(ctx context.Context) error {
+	client := metadata.Client.Compute.VMScaleSetClient
+	instancesClient := metadata.Client.Compute.VMScaleSetVMsClient
+	id := metadata.ID
+
+	log.Printf("[DEBUG] Listing the Instances within %s Virtual Machine Scale Set %q (Resource Group %q)..", metadata.OSType, id.Name, id.ResourceGroup)
+	instances, err := instancesClient.ListComplete(ctx, id.ResourceGroup, id.Name, "", "", "")
+	if err != nil {
+		return fmt.Errorf("Error listing the Instances within %s Virtual Machine Scale Set %q (Resource Group %q): %+v", metadata.OSType, id.Name, id.ResourceGroup, err)
+	}
+
+	instanceIds := make([]string, 0)
+	for instances.NotDone() {
+		instance := instances.Value()
+		if props := instance.VirtualMachineScaleSetVMProperties; props != nil && instance.InstanceID != nil {
+			latestModel := props.LatestModelApplied
+			if latestModel == nil || !*latestModel {
+				instanceIds = append(instanceIds, *instance.InstanceID)
+			}
+		}
+
+		if err := instances.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("Error enumerating the Instances within %s Virtual Machine Scale Set %q (Resource Group %q): %+v", metadata.OSType, id.Name, id.ResourceGroup, err)
+		}
+	}
+
+	// the instances are upgraded one at a time, so that the Scale Set remains available throughout
+	for _, instanceId := range instanceIds {
+		log.Printf("[DEBUG] Upgrading Instance %q within %s Virtual Machine Scale Set %q (Resource Group %q)..", instanceId, metadata.OSType, id.Name, id.ResourceGroup)
+		ids := compute.VirtualMachineScaleSetVMInstanceRequiredIDs{
+			InstanceIds: &[]string{instanceId},
+		}
+		future, err := client.UpdateInstances(ctx, id.ResourceGroup, id.Name, ids)
+		if err != nil {
+			return fmt.Errorf("Error upgrading Instance %q within %s Virtual Machine Scale Set %q (Resource Group %q): %+v", instanceId, metadata.OSType, id.Name, id.ResourceGroup, err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for the upgrade of Instance %q within %s Virtual Machine Scale Set %q (Resource Group %q): %+v", instanceId, metadata.OSType, id.Name, id.ResourceGroup, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package network
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ApplicationGatewayID is a typed representation of the ID of a Application Gateway
+type ApplicationGatewayID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewApplicationGatewayID returns a new ApplicationGatewayID from the specified segments
+func NewApplicationGatewayID(subscriptionId, resourceGroup, name string) ApplicationGatewayID {
+	return ApplicationGatewayID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Application Gateway
+func (id ApplicationGatewayID) String() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/applicationGateways/%s", id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseApplicationGatewayID parses the specified Resource ID into a ApplicationGatewayID, returning an error
+// if the ID isn't a valid Application Gateway ID
+func ParseApplicationGatewayID(input string) (*ApplicationGatewayID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Application Gateway ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Application Gateway ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := ApplicationGatewayID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("Error parsing Application Gateway ID %q: ID was missing the `resourceGroups` element", input)
+	}
+
+	if resourceId.Name, err = id.PopSegment("applicationGateways"); err != nil {
+		return nil, fmt.Errorf("Error parsing Application Gateway ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoRemainingSegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Application Gateway ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateApplicationGatewayID validates that the specified value is a valid Application Gateway ID
+func ValidateApplicationGatewayID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseApplicationGatewayID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Application Gateway ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
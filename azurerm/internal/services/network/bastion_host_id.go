@@ -0,0 +1,78 @@
+package network
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// BastionHostID is a typed representation of the ID of a Bastion Host
+type BastionHostID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewBastionHostID returns a new BastionHostID from the specified segments
+func NewBastionHostID(subscriptionId, resourceGroup, name string) BastionHostID {
+	return BastionHostID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Bastion Host
+func (id BastionHostID) String() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/bastionHosts/%s", id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseBastionHostID parses the specified Resource ID into a BastionHostID, returning an error
+// if the ID isn't a valid Bastion Host ID
+func ParseBastionHostID(input string) (*BastionHostID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Bastion Host ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Bastion Host ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := BastionHostID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("Error parsing Bastion Host ID %q: ID was missing the `resourceGroups` element", input)
+	}
+
+	if resourceId.Name, err = id.PopSegment("bastionHosts"); err != nil {
+		return nil, fmt.Errorf("Error parsing Bastion Host ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoRemainingSegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Bastion Host ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateBastionHostID validates that the specified value is a valid Bastion Host ID
+func ValidateBastionHostID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseBastionHostID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Bastion Host ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,84 @@
+package network
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ConnectionMonitorID is a typed representation of the ID of a Connection Monitor
+type ConnectionMonitorID struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	NetworkWatcherName string
+	Name               string
+}
+
+// NewConnectionMonitorID returns a new ConnectionMonitorID from the specified segments
+func NewConnectionMonitorID(subscriptionId, resourceGroup, networkWatcherName, name string) ConnectionMonitorID {
+	return ConnectionMonitorID{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		NetworkWatcherName: networkWatcherName,
+		Name:               name,
+	}
+}
+
+// String returns the Resource ID for this Connection Monitor
+func (id ConnectionMonitorID) String() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/networkWatchers/%s/NetworkConnectionMonitors/%s", id.SubscriptionId, id.ResourceGroup, id.NetworkWatcherName, id.Name)
+}
+
+// ParseConnectionMonitorID parses the specified Resource ID into a ConnectionMonitorID, returning an error
+// if the ID isn't a valid Connection Monitor ID
+func ParseConnectionMonitorID(input string) (*ConnectionMonitorID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Connection Monitor ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Connection Monitor ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := ConnectionMonitorID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("Error parsing Connection Monitor ID %q: ID was missing the `resourceGroups` element", input)
+	}
+
+	if resourceId.NetworkWatcherName, err = id.PopSegment("networkWatchers"); err != nil {
+		return nil, fmt.Errorf("Error parsing Connection Monitor ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("NetworkConnectionMonitors"); err != nil {
+		return nil, fmt.Errorf("Error parsing Connection Monitor ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoRemainingSegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Connection Monitor ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateConnectionMonitorID validates that the specified value is a valid Connection Monitor ID
+func ValidateConnectionMonitorID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseConnectionMonitorID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Connection Monitor ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
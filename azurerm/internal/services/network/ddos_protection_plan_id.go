@@ -0,0 +1,78 @@
+package network
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DdosProtectionPlanID is a typed representation of the ID of a Ddos Protection Plan
+type DdosProtectionPlanID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewDdosProtectionPlanID returns a new DdosProtectionPlanID from the specified segments
+func NewDdosProtectionPlanID(subscriptionId, resourceGroup, name string) DdosProtectionPlanID {
+	return DdosProtectionPlanID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Ddos Protection Plan
+func (id DdosProtectionPlanID) String() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/ddosProtectionPlans/%s", id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseDdosProtectionPlanID parses the specified Resource ID into a DdosProtectionPlanID, returning an error
+// if the ID isn't a valid Ddos Protection Plan ID
+func ParseDdosProtectionPlanID(input string) (*DdosProtectionPlanID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Ddos Protection Plan ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Ddos Protection Plan ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := DdosProtectionPlanID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("Error parsing Ddos Protection Plan ID %q: ID was missing the `resourceGroups` element", input)
+	}
+
+	if resourceId.Name, err = id.PopSegment("ddosProtectionPlans"); err != nil {
+		return nil, fmt.Errorf("Error parsing Ddos Protection Plan ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoRemainingSegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Ddos Protection Plan ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDdosProtectionPlanID validates that the specified value is a valid Ddos Protection Plan ID
+func ValidateDdosProtectionPlanID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseDdosProtectionPlanID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Ddos Protection Plan ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
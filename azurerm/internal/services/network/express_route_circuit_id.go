@@ -0,0 +1,78 @@
+package network
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ExpressRouteCircuitID is a typed representation of the ID of a Express Route Circuit
+type ExpressRouteCircuitID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewExpressRouteCircuitID returns a new ExpressRouteCircuitID from the specified segments
+func NewExpressRouteCircuitID(subscriptionId, resourceGroup, name string) ExpressRouteCircuitID {
+	return ExpressRouteCircuitID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Express Route Circuit
+func (id ExpressRouteCircuitID) String() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/expressRouteCircuits/%s", id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseExpressRouteCircuitID parses the specified Resource ID into a ExpressRouteCircuitID, returning an error
+// if the ID isn't a valid Express Route Circuit ID
+func ParseExpressRouteCircuitID(input string) (*ExpressRouteCircuitID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Express Route Circuit ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Express Route Circuit ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := ExpressRouteCircuitID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("Error parsing Express Route Circuit ID %q: ID was missing the `resourceGroups` element", input)
+	}
+
+	if resourceId.Name, err = id.PopSegment("expressRouteCircuits"); err != nil {
+		return nil, fmt.Errorf("Error parsing Express Route Circuit ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoRemainingSegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Express Route Circuit ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateExpressRouteCircuitID validates that the specified value is a valid Express Route Circuit ID
+func ValidateExpressRouteCircuitID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseExpressRouteCircuitID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Express Route Circuit ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
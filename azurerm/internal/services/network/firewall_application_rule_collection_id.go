@@ -0,0 +1,84 @@
+package network
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// FirewallApplicationRuleCollectionID is a typed representation of the ID of a Firewall Application Rule Collection
+type FirewallApplicationRuleCollectionID struct {
+	SubscriptionId    string
+	ResourceGroup     string
+	AzureFirewallName string
+	Name              string
+}
+
+// NewFirewallApplicationRuleCollectionID returns a new FirewallApplicationRuleCollectionID from the specified segments
+func NewFirewallApplicationRuleCollectionID(subscriptionId, resourceGroup, azureFirewallName, name string) FirewallApplicationRuleCollectionID {
+	return FirewallApplicationRuleCollectionID{
+		SubscriptionId:    subscriptionId,
+		ResourceGroup:     resourceGroup,
+		AzureFirewallName: azureFirewallName,
+		Name:              name,
+	}
+}
+
+// String returns the Resource ID for this Firewall Application Rule Collection
+func (id FirewallApplicationRuleCollectionID) String() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/azureFirewalls/%s/applicationRuleCollections/%s", id.SubscriptionId, id.ResourceGroup, id.AzureFirewallName, id.Name)
+}
+
+// ParseFirewallApplicationRuleCollectionID parses the specified Resource ID into a FirewallApplicationRuleCollectionID, returning an error
+// if the ID isn't a valid Firewall Application Rule Collection ID
+func ParseFirewallApplicationRuleCollectionID(input string) (*FirewallApplicationRuleCollectionID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Firewall Application Rule Collection ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Firewall Application Rule Collection ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := FirewallApplicationRuleCollectionID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("Error parsing Firewall Application Rule Collection ID %q: ID was missing the `resourceGroups` element", input)
+	}
+
+	if resourceId.AzureFirewallName, err = id.PopSegment("azureFirewalls"); err != nil {
+		return nil, fmt.Errorf("Error parsing Firewall Application Rule Collection ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("applicationRuleCollections"); err != nil {
+		return nil, fmt.Errorf("Error parsing Firewall Application Rule Collection ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoRemainingSegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Firewall Application Rule Collection ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateFirewallApplicationRuleCollectionID validates that the specified value is a valid Firewall Application Rule Collection ID
+func ValidateFirewallApplicationRuleCollectionID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseFirewallApplicationRuleCollectionID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Firewall Application Rule Collection ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
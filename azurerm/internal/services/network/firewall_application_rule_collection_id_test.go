@@ -0,0 +1,136 @@
+package network
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"testing"
+)
+
+func TestFirewallApplicationRuleCollectionIDFormatter(t *testing.T) {
+	actual := NewFirewallApplicationRuleCollectionID("12345678-1234-9876-4563-123456789012", "resourceGroup1", "azureFirewall1", "name1").String()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resourceGroup1/providers/Microsoft.Network/azureFirewalls/azureFirewall1/applicationRuleCollections/name1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestParseFirewallApplicationRuleCollectionID(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    string
+		Expected *FirewallApplicationRuleCollectionID
+	}{
+		{
+			Name:     "Empty",
+			Input:    "",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing subscriptions Segment",
+			Input:    "/resourceGroups/resourceGroup1/providers/Microsoft.Network/azureFirewalls/azureFirewall1/applicationRuleCollections/name1",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing subscriptions Value",
+			Input:    "/subscriptions//resourceGroups/resourceGroup1/providers/Microsoft.Network/azureFirewalls/azureFirewall1/applicationRuleCollections/name1",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing resourceGroups Segment",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Network/azureFirewalls/azureFirewall1/applicationRuleCollections/name1",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing resourceGroups Value",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups//providers/Microsoft.Network/azureFirewalls/azureFirewall1/applicationRuleCollections/name1",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing azureFirewalls Segment",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resourceGroup1/providers/Microsoft.Network/applicationRuleCollections/name1",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing azureFirewalls Value",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resourceGroup1/providers/Microsoft.Network/azureFirewalls//applicationRuleCollections/name1",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing applicationRuleCollections Segment",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resourceGroup1/providers/Microsoft.Network/azureFirewalls/azureFirewall1",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing applicationRuleCollections Value",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resourceGroup1/providers/Microsoft.Network/azureFirewalls/azureFirewall1/applicationRuleCollections/",
+			Expected: nil,
+		},
+		{
+			Name:     "Wrong Resource Provider",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resourceGroup1/providers/Microsoft.Example/azureFirewalls/azureFirewall1/applicationRuleCollections/name1",
+			Expected: nil,
+		},
+		{
+			Name:     "Unexpected Segment",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resourceGroup1/providers/Microsoft.Network/azureFirewalls/azureFirewall1/applicationRuleCollections/name1/extra/value1",
+			Expected: nil,
+		},
+		{
+			Name:  "Valid",
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resourceGroup1/providers/Microsoft.Network/azureFirewalls/azureFirewall1/applicationRuleCollections/name1",
+			Expected: &FirewallApplicationRuleCollectionID{
+				SubscriptionId:    "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:     "resourceGroup1",
+				AzureFirewallName: "azureFirewall1",
+				Name:              "name1",
+			},
+		},
+		{
+			Name:  "Different Casing",
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/resourceGroup1/providers/microsoft.network/AZUREFIREWALLS/azureFirewall1/APPLICATIONRULECOLLECTIONS/name1",
+			Expected: &FirewallApplicationRuleCollectionID{
+				SubscriptionId:    "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:     "resourceGroup1",
+				AzureFirewallName: "azureFirewall1",
+				Name:              "name1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := ParseFirewallApplicationRuleCollectionID(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Expected == nil {
+			t.Fatalf("Expected an error but got a value for %q", v.Input)
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+
+		if actual.AzureFirewallName != v.Expected.AzureFirewallName {
+			t.Fatalf("Expected %q but got %q for AzureFirewallName", v.Expected.AzureFirewallName, actual.AzureFirewallName)
+		}
+
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+
+		if _, errors := ValidateFirewallApplicationRuleCollectionID(v.Input, "id"); len(errors) > 0 {
+			t.Fatalf("Expected %q to be a valid ID but got: %+v", v.Input, errors)
+		}
+	}
+}
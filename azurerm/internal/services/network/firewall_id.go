@@ -0,0 +1,78 @@
+package network
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// FirewallID is a typed representation of the ID of a Firewall
+type FirewallID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewFirewallID returns a new FirewallID from the specified segments
+func NewFirewallID(subscriptionId, resourceGroup, name string) FirewallID {
+	return FirewallID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Firewall
+func (id FirewallID) String() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/azureFirewalls/%s", id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseFirewallID parses the specified Resource ID into a FirewallID, returning an error
+// if the ID isn't a valid Firewall ID
+func ParseFirewallID(input string) (*FirewallID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Firewall ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Firewall ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := FirewallID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("Error parsing Firewall ID %q: ID was missing the `resourceGroups` element", input)
+	}
+
+	if resourceId.Name, err = id.PopSegment("azureFirewalls"); err != nil {
+		return nil, fmt.Errorf("Error parsing Firewall ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoRemainingSegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Firewall ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateFirewallID validates that the specified value is a valid Firewall ID
+func ValidateFirewallID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseFirewallID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Firewall ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
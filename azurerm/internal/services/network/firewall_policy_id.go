@@ -0,0 +1,78 @@
+package network
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// FirewallPolicyID is a typed representation of the ID of a Firewall Policy
+type FirewallPolicyID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewFirewallPolicyID returns a new FirewallPolicyID from the specified segments
+func NewFirewallPolicyID(subscriptionId, resourceGroup, name string) FirewallPolicyID {
+	return FirewallPolicyID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Firewall Policy
+func (id FirewallPolicyID) String() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/firewallPolicies/%s", id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseFirewallPolicyID parses the specified Resource ID into a FirewallPolicyID, returning an error
+// if the ID isn't a valid Firewall Policy ID
+func ParseFirewallPolicyID(input string) (*FirewallPolicyID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Firewall Policy ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Firewall Policy ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := FirewallPolicyID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("Error parsing Firewall Policy ID %q: ID was missing the `resourceGroups` element", input)
+	}
+
+	if resourceId.Name, err = id.PopSegment("firewallPolicies"); err != nil {
+		return nil, fmt.Errorf("Error parsing Firewall Policy ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoRemainingSegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Firewall Policy ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateFirewallPolicyID validates that the specified value is a valid Firewall Policy ID
+func ValidateFirewallPolicyID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseFirewallPolicyID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Firewall Policy ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
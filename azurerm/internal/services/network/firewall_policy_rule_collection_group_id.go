@@ -0,0 +1,84 @@
+package network
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// FirewallPolicyRuleCollectionGroupID is a typed representation of the ID of a Firewall Policy Rule Collection Group
+type FirewallPolicyRuleCollectionGroupID struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	FirewallPolicyName string
+	Name               string
+}
+
+// NewFirewallPolicyRuleCollectionGroupID returns a new FirewallPolicyRuleCollectionGroupID from the specified segments
+func NewFirewallPolicyRuleCollectionGroupID(subscriptionId, resourceGroup, firewallPolicyName, name string) FirewallPolicyRuleCollectionGroupID {
+	return FirewallPolicyRuleCollectionGroupID{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		FirewallPolicyName: firewallPolicyName,
+		Name:               name,
+	}
+}
+
+// String returns the Resource ID for this Firewall Policy Rule Collection Group
+func (id FirewallPolicyRuleCollectionGroupID) String() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/firewallPolicies/%s/ruleGroups/%s", id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName, id.Name)
+}
+
+// ParseFirewallPolicyRuleCollectionGroupID parses the specified Resource ID into a FirewallPolicyRuleCollectionGroupID, returning an error
+// if the ID isn't a valid Firewall Policy Rule Collection Group ID
+func ParseFirewallPolicyRuleCollectionGroupID(input string) (*FirewallPolicyRuleCollectionGroupID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Firewall Policy Rule Collection Group ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Firewall Policy Rule Collection Group ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := FirewallPolicyRuleCollectionGroupID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("Error parsing Firewall Policy Rule Collection Group ID %q: ID was missing the `resourceGroups` element", input)
+	}
+
+	if resourceId.FirewallPolicyName, err = id.PopSegment("firewallPolicies"); err != nil {
+		return nil, fmt.Errorf("Error parsing Firewall Policy Rule Collection Group ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("ruleGroups"); err != nil {
+		return nil, fmt.Errorf("Error parsing Firewall Policy Rule Collection Group ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoRemainingSegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Firewall Policy Rule Collection Group ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateFirewallPolicyRuleCollectionGroupID validates that the specified value is a valid Firewall Policy Rule Collection Group ID
+func ValidateFirewallPolicyRuleCollectionGroupID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseFirewallPolicyRuleCollectionGroupID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Firewall Policy Rule Collection Group ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,84 @@
+package network
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// LoadBalancerFrontendIPConfigurationID is a typed representation of the ID of a Load Balancer Frontend IP Configuration
+type LoadBalancerFrontendIPConfigurationID struct {
+	SubscriptionId   string
+	ResourceGroup    string
+	LoadBalancerName string
+	Name             string
+}
+
+// NewLoadBalancerFrontendIPConfigurationID returns a new LoadBalancerFrontendIPConfigurationID from the specified segments
+func NewLoadBalancerFrontendIPConfigurationID(subscriptionId, resourceGroup, loadBalancerName, name string) LoadBalancerFrontendIPConfigurationID {
+	return LoadBalancerFrontendIPConfigurationID{
+		SubscriptionId:   subscriptionId,
+		ResourceGroup:    resourceGroup,
+		LoadBalancerName: loadBalancerName,
+		Name:             name,
+	}
+}
+
+// String returns the Resource ID for this Load Balancer Frontend IP Configuration
+func (id LoadBalancerFrontendIPConfigurationID) String() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/loadBalancers/%s/frontendIPConfigurations/%s", id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName, id.Name)
+}
+
+// ParseLoadBalancerFrontendIPConfigurationID parses the specified Resource ID into a LoadBalancerFrontendIPConfigurationID, returning an error
+// if the ID isn't a valid Load Balancer Frontend IP Configuration ID
+func ParseLoadBalancerFrontendIPConfigurationID(input string) (*LoadBalancerFrontendIPConfigurationID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Load Balancer Frontend IP Configuration ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Load Balancer Frontend IP Configuration ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := LoadBalancerFrontendIPConfigurationID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("Error parsing Load Balancer Frontend IP Configuration ID %q: ID was missing the `resourceGroups` element", input)
+	}
+
+	if resourceId.LoadBalancerName, err = id.PopSegment("loadBalancers"); err != nil {
+		return nil, fmt.Errorf("Error parsing Load Balancer Frontend IP Configuration ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("frontendIPConfigurations"); err != nil {
+		return nil, fmt.Errorf("Error parsing Load Balancer Frontend IP Configuration ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoRemainingSegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Load Balancer Frontend IP Configuration ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateLoadBalancerFrontendIPConfigurationID validates that the specified value is a valid Load Balancer Frontend IP Configuration ID
+func ValidateLoadBalancerFrontendIPConfigurationID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseLoadBalancerFrontendIPConfigurationID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Load Balancer Frontend IP Configuration ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,84 @@
+package network
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// LoadBalancerInboundNatPoolID is a typed representation of the ID of a Load Balancer Inbound Nat Pool
+type LoadBalancerInboundNatPoolID struct {
+	SubscriptionId   string
+	ResourceGroup    string
+	LoadBalancerName string
+	Name             string
+}
+
+// NewLoadBalancerInboundNatPoolID returns a new LoadBalancerInboundNatPoolID from the specified segments
+func NewLoadBalancerInboundNatPoolID(subscriptionId, resourceGroup, loadBalancerName, name string) LoadBalancerInboundNatPoolID {
+	return LoadBalancerInboundNatPoolID{
+		SubscriptionId:   subscriptionId,
+		ResourceGroup:    resourceGroup,
+		LoadBalancerName: loadBalancerName,
+		Name:             name,
+	}
+}
+
+// String returns the Resource ID for this Load Balancer Inbound Nat Pool
+func (id LoadBalancerInboundNatPoolID) String() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/loadBalancers/%s/inboundNatPools/%s", id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName, id.Name)
+}
+
+// ParseLoadBalancerInboundNatPoolID parses the specified Resource ID into a LoadBalancerInboundNatPoolID, returning an error
+// if the ID isn't a valid Load Balancer Inbound Nat Pool ID
+func ParseLoadBalancerInboundNatPoolID(input string) (*LoadBalancerInboundNatPoolID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Load Balancer Inbound Nat Pool ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Load Balancer Inbound Nat Pool ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := LoadBalancerInboundNatPoolID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("Error parsing Load Balancer Inbound Nat Pool ID %q: ID was missing the `resourceGroups` element", input)
+	}
+
+	if resourceId.LoadBalancerName, err = id.PopSegment("loadBalancers"); err != nil {
+		return nil, fmt.Errorf("Error parsing Load Balancer Inbound Nat Pool ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("inboundNatPools"); err != nil {
+		return nil, fmt.Errorf("Error parsing Load Balancer Inbound Nat Pool ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoRemainingSegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Load Balancer Inbound Nat Pool ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateLoadBalancerInboundNatPoolID validates that the specified value is a valid Load Balancer Inbound Nat Pool ID
+func ValidateLoadBalancerInboundNatPoolID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseLoadBalancerInboundNatPoolID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Load Balancer Inbound Nat Pool ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
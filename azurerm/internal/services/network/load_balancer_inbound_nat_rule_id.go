@@ -0,0 +1,84 @@
+package network
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// LoadBalancerInboundNatRuleID is a typed representation of the ID of a Load Balancer Inbound Nat Rule
+type LoadBalancerInboundNatRuleID struct {
+	SubscriptionId   string
+	ResourceGroup    string
+	LoadBalancerName string
+	Name             string
+}
+
+// NewLoadBalancerInboundNatRuleID returns a new LoadBalancerInboundNatRuleID from the specified segments
+func NewLoadBalancerInboundNatRuleID(subscriptionId, resourceGroup, loadBalancerName, name string) LoadBalancerInboundNatRuleID {
+	return LoadBalancerInboundNatRuleID{
+		SubscriptionId:   subscriptionId,
+		ResourceGroup:    resourceGroup,
+		LoadBalancerName: loadBalancerName,
+		Name:             name,
+	}
+}
+
+// String returns the Resource ID for this Load Balancer Inbound Nat Rule
+func (id LoadBalancerInboundNatRuleID) String() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/loadBalancers/%s/inboundNatRules/%s", id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName, id.Name)
+}
+
+// ParseLoadBalancerInboundNatRuleID parses the specified Resource ID into a LoadBalancerInboundNatRuleID, returning an error
+// if the ID isn't a valid Load Balancer Inbound Nat Rule ID
+func ParseLoadBalancerInboundNatRuleID(input string) (*LoadBalancerInboundNatRuleID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Load Balancer Inbound Nat Rule ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Load Balancer Inbound Nat Rule ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := LoadBalancerInboundNatRuleID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("Error parsing Load Balancer Inbound Nat Rule ID %q: ID was missing the `resourceGroups` element", input)
+	}
+
+	if resourceId.LoadBalancerName, err = id.PopSegment("loadBalancers"); err != nil {
+		return nil, fmt.Errorf("Error parsing Load Balancer Inbound Nat Rule ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("inboundNatRules"); err != nil {
+		return nil, fmt.Errorf("Error parsing Load Balancer Inbound Nat Rule ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoRemainingSegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Load Balancer Inbound Nat Rule ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateLoadBalancerInboundNatRuleID validates that the specified value is a valid Load Balancer Inbound Nat Rule ID
+func ValidateLoadBalancerInboundNatRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseLoadBalancerInboundNatRuleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Load Balancer Inbound Nat Rule ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
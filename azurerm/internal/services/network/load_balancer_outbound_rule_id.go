@@ -0,0 +1,84 @@
+package network
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// LoadBalancerOutboundRuleID is a typed representation of the ID of a Load Balancer Outbound Rule
+type LoadBalancerOutboundRuleID struct {
+	SubscriptionId   string
+	ResourceGroup    string
+	LoadBalancerName string
+	Name             string
+}
+
+// NewLoadBalancerOutboundRuleID returns a new LoadBalancerOutboundRuleID from the specified segments
+func NewLoadBalancerOutboundRuleID(subscriptionId, resourceGroup, loadBalancerName, name string) LoadBalancerOutboundRuleID {
+	return LoadBalancerOutboundRuleID{
+		SubscriptionId:   subscriptionId,
+		ResourceGroup:    resourceGroup,
+		LoadBalancerName: loadBalancerName,
+		Name:             name,
+	}
+}
+
+// String returns the Resource ID for this Load Balancer Outbound Rule
+func (id LoadBalancerOutboundRuleID) String() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/loadBalancers/%s/outboundRules/%s", id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName, id.Name)
+}
+
+// ParseLoadBalancerOutboundRuleID parses the specified Resource ID into a LoadBalancerOutboundRuleID, returning an error
+// if the ID isn't a valid Load Balancer Outbound Rule ID
+func ParseLoadBalancerOutboundRuleID(input string) (*LoadBalancerOutboundRuleID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Load Balancer Outbound Rule ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Load Balancer Outbound Rule ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := LoadBalancerOutboundRuleID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("Error parsing Load Balancer Outbound Rule ID %q: ID was missing the `resourceGroups` element", input)
+	}
+
+	if resourceId.LoadBalancerName, err = id.PopSegment("loadBalancers"); err != nil {
+		return nil, fmt.Errorf("Error parsing Load Balancer Outbound Rule ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("outboundRules"); err != nil {
+		return nil, fmt.Errorf("Error parsing Load Balancer Outbound Rule ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoRemainingSegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Load Balancer Outbound Rule ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateLoadBalancerOutboundRuleID validates that the specified value is a valid Load Balancer Outbound Rule ID
+func ValidateLoadBalancerOutboundRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseLoadBalancerOutboundRuleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Load Balancer Outbound Rule ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,78 @@
+package network
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// LocalNetworkGatewayID is a typed representation of the ID of a Local Network Gateway
+type LocalNetworkGatewayID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewLocalNetworkGatewayID returns a new LocalNetworkGatewayID from the specified segments
+func NewLocalNetworkGatewayID(subscriptionId, resourceGroup, name string) LocalNetworkGatewayID {
+	return LocalNetworkGatewayID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Local Network Gateway
+func (id LocalNetworkGatewayID) String() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/localNetworkGateways/%s", id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseLocalNetworkGatewayID parses the specified Resource ID into a LocalNetworkGatewayID, returning an error
+// if the ID isn't a valid Local Network Gateway ID
+func ParseLocalNetworkGatewayID(input string) (*LocalNetworkGatewayID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Local Network Gateway ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Local Network Gateway ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := LocalNetworkGatewayID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("Error parsing Local Network Gateway ID %q: ID was missing the `resourceGroups` element", input)
+	}
+
+	if resourceId.Name, err = id.PopSegment("localNetworkGateways"); err != nil {
+		return nil, fmt.Errorf("Error parsing Local Network Gateway ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoRemainingSegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Local Network Gateway ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateLocalNetworkGatewayID validates that the specified value is a valid Local Network Gateway ID
+func ValidateLocalNetworkGatewayID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseLocalNetworkGatewayID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Local Network Gateway ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,78 @@
+package network
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// NatGatewayID is a typed representation of the ID of a Nat Gateway
+type NatGatewayID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewNatGatewayID returns a new NatGatewayID from the specified segments
+func NewNatGatewayID(subscriptionId, resourceGroup, name string) NatGatewayID {
+	return NatGatewayID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Nat Gateway
+func (id NatGatewayID) String() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/natGateways/%s", id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseNatGatewayID parses the specified Resource ID into a NatGatewayID, returning an error
+// if the ID isn't a valid Nat Gateway ID
+func ParseNatGatewayID(input string) (*NatGatewayID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Nat Gateway ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Nat Gateway ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := NatGatewayID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("Error parsing Nat Gateway ID %q: ID was missing the `resourceGroups` element", input)
+	}
+
+	if resourceId.Name, err = id.PopSegment("natGateways"); err != nil {
+		return nil, fmt.Errorf("Error parsing Nat Gateway ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoRemainingSegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Nat Gateway ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateNatGatewayID validates that the specified value is a valid Nat Gateway ID
+func ValidateNatGatewayID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseNatGatewayID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Nat Gateway ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
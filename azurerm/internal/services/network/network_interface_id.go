@@ -0,0 +1,78 @@
+package network
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// NetworkInterfaceID is a typed representation of the ID of a Network Interface
+type NetworkInterfaceID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewNetworkInterfaceID returns a new NetworkInterfaceID from the specified segments
+func NewNetworkInterfaceID(subscriptionId, resourceGroup, name string) NetworkInterfaceID {
+	return NetworkInterfaceID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Network Interface
+func (id NetworkInterfaceID) String() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/networkInterfaces/%s", id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseNetworkInterfaceID parses the specified Resource ID into a NetworkInterfaceID, returning an error
+// if the ID isn't a valid Network Interface ID
+func ParseNetworkInterfaceID(input string) (*NetworkInterfaceID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Network Interface ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Network Interface ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := NetworkInterfaceID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("Error parsing Network Interface ID %q: ID was missing the `resourceGroups` element", input)
+	}
+
+	if resourceId.Name, err = id.PopSegment("networkInterfaces"); err != nil {
+		return nil, fmt.Errorf("Error parsing Network Interface ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoRemainingSegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Network Interface ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateNetworkInterfaceID validates that the specified value is a valid Network Interface ID
+func ValidateNetworkInterfaceID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseNetworkInterfaceID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Network Interface ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,84 @@
+package network
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// NetworkInterfaceIPConfigurationID is a typed representation of the ID of a Network Interface IP Configuration
+type NetworkInterfaceIPConfigurationID struct {
+	SubscriptionId       string
+	ResourceGroup        string
+	NetworkInterfaceName string
+	Name                 string
+}
+
+// NewNetworkInterfaceIPConfigurationID returns a new NetworkInterfaceIPConfigurationID from the specified segments
+func NewNetworkInterfaceIPConfigurationID(subscriptionId, resourceGroup, networkInterfaceName, name string) NetworkInterfaceIPConfigurationID {
+	return NetworkInterfaceIPConfigurationID{
+		SubscriptionId:       subscriptionId,
+		ResourceGroup:        resourceGroup,
+		NetworkInterfaceName: networkInterfaceName,
+		Name:                 name,
+	}
+}
+
+// String returns the Resource ID for this Network Interface IP Configuration
+func (id NetworkInterfaceIPConfigurationID) String() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/networkInterfaces/%s/ipConfigurations/%s", id.SubscriptionId, id.ResourceGroup, id.NetworkInterfaceName, id.Name)
+}
+
+// ParseNetworkInterfaceIPConfigurationID parses the specified Resource ID into a NetworkInterfaceIPConfigurationID, returning an error
+// if the ID isn't a valid Network Interface IP Configuration ID
+func ParseNetworkInterfaceIPConfigurationID(input string) (*NetworkInterfaceIPConfigurationID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Network Interface IP Configuration ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Network Interface IP Configuration ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := NetworkInterfaceIPConfigurationID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("Error parsing Network Interface IP Configuration ID %q: ID was missing the `resourceGroups` element", input)
+	}
+
+	if resourceId.NetworkInterfaceName, err = id.PopSegment("networkInterfaces"); err != nil {
+		return nil, fmt.Errorf("Error parsing Network Interface IP Configuration ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("ipConfigurations"); err != nil {
+		return nil, fmt.Errorf("Error parsing Network Interface IP Configuration ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoRemainingSegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Network Interface IP Configuration ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateNetworkInterfaceIPConfigurationID validates that the specified value is a valid Network Interface IP Configuration ID
+func ValidateNetworkInterfaceIPConfigurationID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseNetworkInterfaceIPConfigurationID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Network Interface IP Configuration ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
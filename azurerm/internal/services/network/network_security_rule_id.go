@@ -0,0 +1,84 @@
+package network
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// NetworkSecurityRuleID is a typed representation of the ID of a Network Security Rule
+type NetworkSecurityRuleID struct {
+	SubscriptionId           string
+	ResourceGroup            string
+	NetworkSecurityGroupName string
+	Name                     string
+}
+
+// NewNetworkSecurityRuleID returns a new NetworkSecurityRuleID from the specified segments
+func NewNetworkSecurityRuleID(subscriptionId, resourceGroup, networkSecurityGroupName, name string) NetworkSecurityRuleID {
+	return NetworkSecurityRuleID{
+		SubscriptionId:           subscriptionId,
+		ResourceGroup:            resourceGroup,
+		NetworkSecurityGroupName: networkSecurityGroupName,
+		Name:                     name,
+	}
+}
+
+// String returns the Resource ID for this Network Security Rule
+func (id NetworkSecurityRuleID) String() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/networkSecurityGroups/%s/securityRules/%s", id.SubscriptionId, id.ResourceGroup, id.NetworkSecurityGroupName, id.Name)
+}
+
+// ParseNetworkSecurityRuleID parses the specified Resource ID into a NetworkSecurityRuleID, returning an error
+// if the ID isn't a valid Network Security Rule ID
+func ParseNetworkSecurityRuleID(input string) (*NetworkSecurityRuleID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Network Security Rule ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Network Security Rule ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := NetworkSecurityRuleID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("Error parsing Network Security Rule ID %q: ID was missing the `resourceGroups` element", input)
+	}
+
+	if resourceId.NetworkSecurityGroupName, err = id.PopSegment("networkSecurityGroups"); err != nil {
+		return nil, fmt.Errorf("Error parsing Network Security Rule ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("securityRules"); err != nil {
+		return nil, fmt.Errorf("Error parsing Network Security Rule ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoRemainingSegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Network Security Rule ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateNetworkSecurityRuleID validates that the specified value is a valid Network Security Rule ID
+func ValidateNetworkSecurityRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseNetworkSecurityRuleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Network Security Rule ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,78 @@
+package network
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// NetworkWatcherID is a typed representation of the ID of a Network Watcher
+type NetworkWatcherID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewNetworkWatcherID returns a new NetworkWatcherID from the specified segments
+func NewNetworkWatcherID(subscriptionId, resourceGroup, name string) NetworkWatcherID {
+	return NetworkWatcherID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Network Watcher
+func (id NetworkWatcherID) String() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/networkWatchers/%s", id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseNetworkWatcherID parses the specified Resource ID into a NetworkWatcherID, returning an error
+// if the ID isn't a valid Network Watcher ID
+func ParseNetworkWatcherID(input string) (*NetworkWatcherID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Network Watcher ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Network Watcher ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := NetworkWatcherID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("Error parsing Network Watcher ID %q: ID was missing the `resourceGroups` element", input)
+	}
+
+	if resourceId.Name, err = id.PopSegment("networkWatchers"); err != nil {
+		return nil, fmt.Errorf("Error parsing Network Watcher ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoRemainingSegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Network Watcher ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateNetworkWatcherID validates that the specified value is a valid Network Watcher ID
+func ValidateNetworkWatcherID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseNetworkWatcherID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Network Watcher ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
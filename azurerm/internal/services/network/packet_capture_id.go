@@ -0,0 +1,84 @@
+package network
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// PacketCaptureID is a typed representation of the ID of a Packet Capture
+type PacketCaptureID struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	NetworkWatcherName string
+	Name               string
+}
+
+// NewPacketCaptureID returns a new PacketCaptureID from the specified segments
+func NewPacketCaptureID(subscriptionId, resourceGroup, networkWatcherName, name string) PacketCaptureID {
+	return PacketCaptureID{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		NetworkWatcherName: networkWatcherName,
+		Name:               name,
+	}
+}
+
+// String returns the Resource ID for this Packet Capture
+func (id PacketCaptureID) String() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/networkWatchers/%s/NetworkPacketCaptures/%s", id.SubscriptionId, id.ResourceGroup, id.NetworkWatcherName, id.Name)
+}
+
+// ParsePacketCaptureID parses the specified Resource ID into a PacketCaptureID, returning an error
+// if the ID isn't a valid Packet Capture ID
+func ParsePacketCaptureID(input string) (*PacketCaptureID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Packet Capture ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Packet Capture ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := PacketCaptureID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("Error parsing Packet Capture ID %q: ID was missing the `resourceGroups` element", input)
+	}
+
+	if resourceId.NetworkWatcherName, err = id.PopSegment("networkWatchers"); err != nil {
+		return nil, fmt.Errorf("Error parsing Packet Capture ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("NetworkPacketCaptures"); err != nil {
+		return nil, fmt.Errorf("Error parsing Packet Capture ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoRemainingSegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Packet Capture ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidatePacketCaptureID validates that the specified value is a valid Packet Capture ID
+func ValidatePacketCaptureID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParsePacketCaptureID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Packet Capture ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
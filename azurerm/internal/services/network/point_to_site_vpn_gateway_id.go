@@ -0,0 +1,78 @@
+package network
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// PointToSiteVpnGatewayID is a typed representation of the ID of a Point To Site Vpn Gateway
+type PointToSiteVpnGatewayID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewPointToSiteVpnGatewayID returns a new PointToSiteVpnGatewayID from the specified segments
+func NewPointToSiteVpnGatewayID(subscriptionId, resourceGroup, name string) PointToSiteVpnGatewayID {
+	return PointToSiteVpnGatewayID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Point To Site Vpn Gateway
+func (id PointToSiteVpnGatewayID) String() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/p2sVpnGateways/%s", id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParsePointToSiteVpnGatewayID parses the specified Resource ID into a PointToSiteVpnGatewayID, returning an error
+// if the ID isn't a valid Point To Site Vpn Gateway ID
+func ParsePointToSiteVpnGatewayID(input string) (*PointToSiteVpnGatewayID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Point To Site Vpn Gateway ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Point To Site Vpn Gateway ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := PointToSiteVpnGatewayID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("Error parsing Point To Site Vpn Gateway ID %q: ID was missing the `resourceGroups` element", input)
+	}
+
+	if resourceId.Name, err = id.PopSegment("p2sVpnGateways"); err != nil {
+		return nil, fmt.Errorf("Error parsing Point To Site Vpn Gateway ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoRemainingSegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Point To Site Vpn Gateway ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidatePointToSiteVpnGatewayID validates that the specified value is a valid Point To Site Vpn Gateway ID
+func ValidatePointToSiteVpnGatewayID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParsePointToSiteVpnGatewayID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Point To Site Vpn Gateway ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
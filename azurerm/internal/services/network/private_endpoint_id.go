@@ -0,0 +1,78 @@
+package network
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// PrivateEndpointID is a typed representation of the ID of a Private Endpoint
+type PrivateEndpointID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewPrivateEndpointID returns a new PrivateEndpointID from the specified segments
+func NewPrivateEndpointID(subscriptionId, resourceGroup, name string) PrivateEndpointID {
+	return PrivateEndpointID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Private Endpoint
+func (id PrivateEndpointID) String() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/privateEndpoints/%s", id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParsePrivateEndpointID parses the specified Resource ID into a PrivateEndpointID, returning an error
+// if the ID isn't a valid Private Endpoint ID
+func ParsePrivateEndpointID(input string) (*PrivateEndpointID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Private Endpoint ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Private Endpoint ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := PrivateEndpointID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("Error parsing Private Endpoint ID %q: ID was missing the `resourceGroups` element", input)
+	}
+
+	if resourceId.Name, err = id.PopSegment("privateEndpoints"); err != nil {
+		return nil, fmt.Errorf("Error parsing Private Endpoint ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoRemainingSegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Private Endpoint ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidatePrivateEndpointID validates that the specified value is a valid Private Endpoint ID
+func ValidatePrivateEndpointID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParsePrivateEndpointID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Private Endpoint ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
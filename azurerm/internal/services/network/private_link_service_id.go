@@ -0,0 +1,78 @@
+package network
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// PrivateLinkServiceID is a typed representation of the ID of a Private Link Service
+type PrivateLinkServiceID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewPrivateLinkServiceID returns a new PrivateLinkServiceID from the specified segments
+func NewPrivateLinkServiceID(subscriptionId, resourceGroup, name string) PrivateLinkServiceID {
+	return PrivateLinkServiceID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Private Link Service
+func (id PrivateLinkServiceID) String() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/privateLinkServices/%s", id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParsePrivateLinkServiceID parses the specified Resource ID into a PrivateLinkServiceID, returning an error
+// if the ID isn't a valid Private Link Service ID
+func ParsePrivateLinkServiceID(input string) (*PrivateLinkServiceID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Private Link Service ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Private Link Service ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := PrivateLinkServiceID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("Error parsing Private Link Service ID %q: ID was missing the `resourceGroups` element", input)
+	}
+
+	if resourceId.Name, err = id.PopSegment("privateLinkServices"); err != nil {
+		return nil, fmt.Errorf("Error parsing Private Link Service ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoRemainingSegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Private Link Service ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidatePrivateLinkServiceID validates that the specified value is a valid Private Link Service ID
+func ValidatePrivateLinkServiceID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParsePrivateLinkServiceID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Private Link Service ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,78 @@
+package network
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// PublicIPAddressID is a typed representation of the ID of a Public IP Address
+type PublicIPAddressID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewPublicIPAddressID returns a new PublicIPAddressID from the specified segments
+func NewPublicIPAddressID(subscriptionId, resourceGroup, name string) PublicIPAddressID {
+	return PublicIPAddressID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Public IP Address
+func (id PublicIPAddressID) String() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/publicIPAddresses/%s", id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParsePublicIPAddressID parses the specified Resource ID into a PublicIPAddressID, returning an error
+// if the ID isn't a valid Public IP Address ID
+func ParsePublicIPAddressID(input string) (*PublicIPAddressID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Public IP Address ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Public IP Address ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := PublicIPAddressID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("Error parsing Public IP Address ID %q: ID was missing the `resourceGroups` element", input)
+	}
+
+	if resourceId.Name, err = id.PopSegment("publicIPAddresses"); err != nil {
+		return nil, fmt.Errorf("Error parsing Public IP Address ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoRemainingSegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Public IP Address ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidatePublicIPAddressID validates that the specified value is a valid Public IP Address ID
+func ValidatePublicIPAddressID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParsePublicIPAddressID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Public IP Address ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,84 @@
+package network
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// RouteID is a typed representation of the ID of a Route
+type RouteID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	RouteTableName string
+	Name           string
+}
+
+// NewRouteID returns a new RouteID from the specified segments
+func NewRouteID(subscriptionId, resourceGroup, routeTableName, name string) RouteID {
+	return RouteID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		RouteTableName: routeTableName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Route
+func (id RouteID) String() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/routeTables/%s/routes/%s", id.SubscriptionId, id.ResourceGroup, id.RouteTableName, id.Name)
+}
+
+// ParseRouteID parses the specified Resource ID into a RouteID, returning an error
+// if the ID isn't a valid Route ID
+func ParseRouteID(input string) (*RouteID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Route ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Route ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := RouteID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("Error parsing Route ID %q: ID was missing the `resourceGroups` element", input)
+	}
+
+	if resourceId.RouteTableName, err = id.PopSegment("routeTables"); err != nil {
+		return nil, fmt.Errorf("Error parsing Route ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("routes"); err != nil {
+		return nil, fmt.Errorf("Error parsing Route ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoRemainingSegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Route ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateRouteID validates that the specified value is a valid Route ID
+func ValidateRouteID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseRouteID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Route ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,78 @@
+package network
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// RouteTableID is a typed representation of the ID of a Route Table
+type RouteTableID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewRouteTableID returns a new RouteTableID from the specified segments
+func NewRouteTableID(subscriptionId, resourceGroup, name string) RouteTableID {
+	return RouteTableID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Route Table
+func (id RouteTableID) String() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/routeTables/%s", id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseRouteTableID parses the specified Resource ID into a RouteTableID, returning an error
+// if the ID isn't a valid Route Table ID
+func ParseRouteTableID(input string) (*RouteTableID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Route Table ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Route Table ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := RouteTableID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("Error parsing Route Table ID %q: ID was missing the `resourceGroups` element", input)
+	}
+
+	if resourceId.Name, err = id.PopSegment("routeTables"); err != nil {
+		return nil, fmt.Errorf("Error parsing Route Table ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoRemainingSegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Route Table ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateRouteTableID validates that the specified value is a valid Route Table ID
+func ValidateRouteTableID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseRouteTableID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Route Table ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
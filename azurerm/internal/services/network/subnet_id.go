@@ -0,0 +1,84 @@
+package network
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// SubnetID is a typed representation of the ID of a Subnet
+type SubnetID struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	VirtualNetworkName string
+	Name               string
+}
+
+// NewSubnetID returns a new SubnetID from the specified segments
+func NewSubnetID(subscriptionId, resourceGroup, virtualNetworkName, name string) SubnetID {
+	return SubnetID{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		VirtualNetworkName: virtualNetworkName,
+		Name:               name,
+	}
+}
+
+// String returns the Resource ID for this Subnet
+func (id SubnetID) String() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualNetworks/%s/subnets/%s", id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName, id.Name)
+}
+
+// ParseSubnetID parses the specified Resource ID into a SubnetID, returning an error
+// if the ID isn't a valid Subnet ID
+func ParseSubnetID(input string) (*SubnetID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Subnet ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Subnet ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := SubnetID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("Error parsing Subnet ID %q: ID was missing the `resourceGroups` element", input)
+	}
+
+	if resourceId.VirtualNetworkName, err = id.PopSegment("virtualNetworks"); err != nil {
+		return nil, fmt.Errorf("Error parsing Subnet ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("subnets"); err != nil {
+		return nil, fmt.Errorf("Error parsing Subnet ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoRemainingSegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Subnet ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateSubnetID validates that the specified value is a valid Subnet ID
+func ValidateSubnetID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseSubnetID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Subnet ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
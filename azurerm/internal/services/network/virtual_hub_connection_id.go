@@ -0,0 +1,84 @@
+package network
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// VirtualHubConnectionID is a typed representation of the ID of a Virtual Hub Connection
+type VirtualHubConnectionID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	VirtualHubName string
+	Name           string
+}
+
+// NewVirtualHubConnectionID returns a new VirtualHubConnectionID from the specified segments
+func NewVirtualHubConnectionID(subscriptionId, resourceGroup, virtualHubName, name string) VirtualHubConnectionID {
+	return VirtualHubConnectionID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		VirtualHubName: virtualHubName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Virtual Hub Connection
+func (id VirtualHubConnectionID) String() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualHubs/%s/hubVirtualNetworkConnections/%s", id.SubscriptionId, id.ResourceGroup, id.VirtualHubName, id.Name)
+}
+
+// ParseVirtualHubConnectionID parses the specified Resource ID into a VirtualHubConnectionID, returning an error
+// if the ID isn't a valid Virtual Hub Connection ID
+func ParseVirtualHubConnectionID(input string) (*VirtualHubConnectionID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Hub Connection ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Virtual Hub Connection ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := VirtualHubConnectionID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("Error parsing Virtual Hub Connection ID %q: ID was missing the `resourceGroups` element", input)
+	}
+
+	if resourceId.VirtualHubName, err = id.PopSegment("virtualHubs"); err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Hub Connection ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("hubVirtualNetworkConnections"); err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Hub Connection ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoRemainingSegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Hub Connection ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateVirtualHubConnectionID validates that the specified value is a valid Virtual Hub Connection ID
+func ValidateVirtualHubConnectionID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseVirtualHubConnectionID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Virtual Hub Connection ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
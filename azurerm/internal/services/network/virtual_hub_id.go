@@ -0,0 +1,78 @@
+package network
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// VirtualHubID is a typed representation of the ID of a Virtual Hub
+type VirtualHubID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewVirtualHubID returns a new VirtualHubID from the specified segments
+func NewVirtualHubID(subscriptionId, resourceGroup, name string) VirtualHubID {
+	return VirtualHubID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Virtual Hub
+func (id VirtualHubID) String() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualHubs/%s", id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseVirtualHubID parses the specified Resource ID into a VirtualHubID, returning an error
+// if the ID isn't a valid Virtual Hub ID
+func ParseVirtualHubID(input string) (*VirtualHubID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Hub ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Virtual Hub ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := VirtualHubID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("Error parsing Virtual Hub ID %q: ID was missing the `resourceGroups` element", input)
+	}
+
+	if resourceId.Name, err = id.PopSegment("virtualHubs"); err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Hub ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoRemainingSegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Hub ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateVirtualHubID validates that the specified value is a valid Virtual Hub ID
+func ValidateVirtualHubID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseVirtualHubID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Virtual Hub ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,78 @@
+package network
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// VirtualNetworkGatewayConnectionID is a typed representation of the ID of a Virtual Network Gateway Connection
+type VirtualNetworkGatewayConnectionID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewVirtualNetworkGatewayConnectionID returns a new VirtualNetworkGatewayConnectionID from the specified segments
+func NewVirtualNetworkGatewayConnectionID(subscriptionId, resourceGroup, name string) VirtualNetworkGatewayConnectionID {
+	return VirtualNetworkGatewayConnectionID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Virtual Network Gateway Connection
+func (id VirtualNetworkGatewayConnectionID) String() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/connections/%s", id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseVirtualNetworkGatewayConnectionID parses the specified Resource ID into a VirtualNetworkGatewayConnectionID, returning an error
+// if the ID isn't a valid Virtual Network Gateway Connection ID
+func ParseVirtualNetworkGatewayConnectionID(input string) (*VirtualNetworkGatewayConnectionID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Network Gateway Connection ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Virtual Network Gateway Connection ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := VirtualNetworkGatewayConnectionID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("Error parsing Virtual Network Gateway Connection ID %q: ID was missing the `resourceGroups` element", input)
+	}
+
+	if resourceId.Name, err = id.PopSegment("connections"); err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Network Gateway Connection ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoRemainingSegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Network Gateway Connection ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateVirtualNetworkGatewayConnectionID validates that the specified value is a valid Virtual Network Gateway Connection ID
+func ValidateVirtualNetworkGatewayConnectionID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseVirtualNetworkGatewayConnectionID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Virtual Network Gateway Connection ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
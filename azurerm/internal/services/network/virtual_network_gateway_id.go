@@ -0,0 +1,78 @@
+package network
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// VirtualNetworkGatewayID is a typed representation of the ID of a Virtual Network Gateway
+type VirtualNetworkGatewayID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewVirtualNetworkGatewayID returns a new VirtualNetworkGatewayID from the specified segments
+func NewVirtualNetworkGatewayID(subscriptionId, resourceGroup, name string) VirtualNetworkGatewayID {
+	return VirtualNetworkGatewayID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Virtual Network Gateway
+func (id VirtualNetworkGatewayID) String() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualNetworkGateways/%s", id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseVirtualNetworkGatewayID parses the specified Resource ID into a VirtualNetworkGatewayID, returning an error
+// if the ID isn't a valid Virtual Network Gateway ID
+func ParseVirtualNetworkGatewayID(input string) (*VirtualNetworkGatewayID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Network Gateway ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Virtual Network Gateway ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := VirtualNetworkGatewayID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("Error parsing Virtual Network Gateway ID %q: ID was missing the `resourceGroups` element", input)
+	}
+
+	if resourceId.Name, err = id.PopSegment("virtualNetworkGateways"); err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Network Gateway ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoRemainingSegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Network Gateway ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateVirtualNetworkGatewayID validates that the specified value is a valid Virtual Network Gateway ID
+func ValidateVirtualNetworkGatewayID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseVirtualNetworkGatewayID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Virtual Network Gateway ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
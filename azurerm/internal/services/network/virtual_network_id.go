@@ -0,0 +1,78 @@
+package network
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// VirtualNetworkID is a typed representation of the ID of a Virtual Network
+type VirtualNetworkID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewVirtualNetworkID returns a new VirtualNetworkID from the specified segments
+func NewVirtualNetworkID(subscriptionId, resourceGroup, name string) VirtualNetworkID {
+	return VirtualNetworkID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Virtual Network
+func (id VirtualNetworkID) String() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualNetworks/%s", id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseVirtualNetworkID parses the specified Resource ID into a VirtualNetworkID, returning an error
+// if the ID isn't a valid Virtual Network ID
+func ParseVirtualNetworkID(input string) (*VirtualNetworkID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Network ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Virtual Network ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := VirtualNetworkID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("Error parsing Virtual Network ID %q: ID was missing the `resourceGroups` element", input)
+	}
+
+	if resourceId.Name, err = id.PopSegment("virtualNetworks"); err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Network ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoRemainingSegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Network ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateVirtualNetworkID validates that the specified value is a valid Virtual Network ID
+func ValidateVirtualNetworkID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseVirtualNetworkID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Virtual Network ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,78 @@
+package network
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// VirtualWanID is a typed representation of the ID of a Virtual Wan
+type VirtualWanID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewVirtualWanID returns a new VirtualWanID from the specified segments
+func NewVirtualWanID(subscriptionId, resourceGroup, name string) VirtualWanID {
+	return VirtualWanID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Virtual Wan
+func (id VirtualWanID) String() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualWans/%s", id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseVirtualWanID parses the specified Resource ID into a VirtualWanID, returning an error
+// if the ID isn't a valid Virtual Wan ID
+func ParseVirtualWanID(input string) (*VirtualWanID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Wan ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Virtual Wan ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := VirtualWanID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("Error parsing Virtual Wan ID %q: ID was missing the `resourceGroups` element", input)
+	}
+
+	if resourceId.Name, err = id.PopSegment("virtualWans"); err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Wan ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoRemainingSegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Wan ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateVirtualWanID validates that the specified value is a valid Virtual Wan ID
+func ValidateVirtualWanID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseVirtualWanID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Virtual Wan ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,78 @@
+package network
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// VpnGatewayID is a typed representation of the ID of a Vpn Gateway
+type VpnGatewayID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewVpnGatewayID returns a new VpnGatewayID from the specified segments
+func NewVpnGatewayID(subscriptionId, resourceGroup, name string) VpnGatewayID {
+	return VpnGatewayID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Vpn Gateway
+func (id VpnGatewayID) String() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/vpnGateways/%s", id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseVpnGatewayID parses the specified Resource ID into a VpnGatewayID, returning an error
+// if the ID isn't a valid Vpn Gateway ID
+func ParseVpnGatewayID(input string) (*VpnGatewayID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Vpn Gateway ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Vpn Gateway ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := VpnGatewayID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("Error parsing Vpn Gateway ID %q: ID was missing the `resourceGroups` element", input)
+	}
+
+	if resourceId.Name, err = id.PopSegment("vpnGateways"); err != nil {
+		return nil, fmt.Errorf("Error parsing Vpn Gateway ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoRemainingSegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Vpn Gateway ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateVpnGatewayID validates that the specified value is a valid Vpn Gateway ID
+func ValidateVpnGatewayID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseVpnGatewayID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Vpn Gateway ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
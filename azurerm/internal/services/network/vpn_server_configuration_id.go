@@ -0,0 +1,84 @@
+package network
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// VpnServerConfigurationID is a typed representation of the ID of a Vpn Server Configuration
+type VpnServerConfigurationID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	VirtualWanName string
+	Name           string
+}
+
+// NewVpnServerConfigurationID returns a new VpnServerConfigurationID from the specified segments
+func NewVpnServerConfigurationID(subscriptionId, resourceGroup, virtualWanName, name string) VpnServerConfigurationID {
+	return VpnServerConfigurationID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		VirtualWanName: virtualWanName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Vpn Server Configuration
+func (id VpnServerConfigurationID) String() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualWans/%s/p2sVpnServerConfigurations/%s", id.SubscriptionId, id.ResourceGroup, id.VirtualWanName, id.Name)
+}
+
+// ParseVpnServerConfigurationID parses the specified Resource ID into a VpnServerConfigurationID, returning an error
+// if the ID isn't a valid Vpn Server Configuration ID
+func ParseVpnServerConfigurationID(input string) (*VpnServerConfigurationID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Vpn Server Configuration ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Vpn Server Configuration ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := VpnServerConfigurationID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("Error parsing Vpn Server Configuration ID %q: ID was missing the `resourceGroups` element", input)
+	}
+
+	if resourceId.VirtualWanName, err = id.PopSegment("virtualWans"); err != nil {
+		return nil, fmt.Errorf("Error parsing Vpn Server Configuration ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("p2sVpnServerConfigurations"); err != nil {
+		return nil, fmt.Errorf("Error parsing Vpn Server Configuration ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoRemainingSegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Vpn Server Configuration ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateVpnServerConfigurationID validates that the specified value is a valid Vpn Server Configuration ID
+func ValidateVpnServerConfigurationID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseVpnServerConfigurationID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Vpn Server Configuration ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,78 @@
+package network
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// VpnSiteID is a typed representation of the ID of a Vpn Site
+type VpnSiteID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewVpnSiteID returns a new VpnSiteID from the specified segments
+func NewVpnSiteID(subscriptionId, resourceGroup, name string) VpnSiteID {
+	return VpnSiteID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Vpn Site
+func (id VpnSiteID) String() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/vpnSites/%s", id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseVpnSiteID parses the specified Resource ID into a VpnSiteID, returning an error
+// if the ID isn't a valid Vpn Site ID
+func ParseVpnSiteID(input string) (*VpnSiteID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Vpn Site ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Vpn Site ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := VpnSiteID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("Error parsing Vpn Site ID %q: ID was missing the `resourceGroups` element", input)
+	}
+
+	if resourceId.Name, err = id.PopSegment("vpnSites"); err != nil {
+		return nil, fmt.Errorf("Error parsing Vpn Site ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoRemainingSegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Vpn Site ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateVpnSiteID validates that the specified value is a valid Vpn Site ID
+func ValidateVpnSiteID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseVpnSiteID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Vpn Site ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
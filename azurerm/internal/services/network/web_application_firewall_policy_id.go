@@ -0,0 +1,78 @@
+package network
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// WebApplicationFirewallPolicyID is a typed representation of the ID of a Web Application Firewall Policy
+type WebApplicationFirewallPolicyID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewWebApplicationFirewallPolicyID returns a new WebApplicationFirewallPolicyID from the specified segments
+func NewWebApplicationFirewallPolicyID(subscriptionId, resourceGroup, name string) WebApplicationFirewallPolicyID {
+	return WebApplicationFirewallPolicyID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Web Application Firewall Policy
+func (id WebApplicationFirewallPolicyID) String() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/ApplicationGatewayWebApplicationFirewallPolicies/%s", id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseWebApplicationFirewallPolicyID parses the specified Resource ID into a WebApplicationFirewallPolicyID, returning an error
+// if the ID isn't a valid Web Application Firewall Policy ID
+func ParseWebApplicationFirewallPolicyID(input string) (*WebApplicationFirewallPolicyID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Web Application Firewall Policy ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Web Application Firewall Policy ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := WebApplicationFirewallPolicyID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("Error parsing Web Application Firewall Policy ID %q: ID was missing the `resourceGroups` element", input)
+	}
+
+	if resourceId.Name, err = id.PopSegment("ApplicationGatewayWebApplicationFirewallPolicies"); err != nil {
+		return nil, fmt.Errorf("Error parsing Web Application Firewall Policy ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoRemainingSegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Web Application Firewall Policy ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateWebApplicationFirewallPolicyID validates that the specified value is a valid Web Application Firewall Policy ID
+func ValidateWebApplicationFirewallPolicyID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseWebApplicationFirewallPolicyID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Web Application Firewall Policy ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
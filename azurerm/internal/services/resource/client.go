@@ -12,6 +12,7 @@ type Client struct {
 	DeploymentsClient *resources.DeploymentsClient
 	LocksClient       *locks.ManagementLocksClient
 	ProvidersClient   *providers.ProvidersClient
+	ResourcesClient   *resources.Client
 }
 
 func BuildClient(o *common.ClientOptions) *Client {
@@ -29,10 +30,14 @@ func BuildClient(o *common.ClientOptions) *Client {
 	ProvidersClient := providers.NewProvidersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ProvidersClient.Client, o.ResourceManagerAuthorizer)
 
+	ResourcesClient := resources.NewClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&ResourcesClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		GroupsClient:      &GroupsClient,
 		DeploymentsClient: &DeploymentsClient,
 		LocksClient:       &LocksClient,
 		ProvidersClient:   &ProvidersClient,
+		ResourcesClient:   &ResourcesClient,
 	}
 }
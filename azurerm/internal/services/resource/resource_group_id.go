@@ -0,0 +1,67 @@
+package resource
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ResourceGroupID is a typed representation of the ID of a Resource Group
+type ResourceGroupID struct {
+	SubscriptionId string
+	Name           string
+}
+
+// NewResourceGroupID returns a new ResourceGroupID from the specified segments
+func NewResourceGroupID(subscriptionId, name string) ResourceGroupID {
+	return ResourceGroupID{
+		SubscriptionId: subscriptionId,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Resource Group
+func (id ResourceGroupID) String() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s", id.SubscriptionId, id.Name)
+}
+
+// ParseResourceGroupID parses the specified Resource ID into a ResourceGroupID, returning an error
+// if the ID isn't a valid Resource Group ID
+func ParseResourceGroupID(input string) (*ResourceGroupID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Resource Group ID %q: %+v", input, err)
+	}
+
+	resourceId := ResourceGroupID{
+		SubscriptionId: id.SubscriptionID,
+		Name:           id.ResourceGroup,
+	}
+
+	if resourceId.Name == "" {
+		return nil, fmt.Errorf("Error parsing Resource Group ID %q: ID was missing the `resourceGroups` element", input)
+	}
+
+	if err := id.ValidateNoRemainingSegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Resource Group ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateResourceGroupID validates that the specified value is a valid Resource Group ID
+func ValidateResourceGroupID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseResourceGroupID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Resource Group ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
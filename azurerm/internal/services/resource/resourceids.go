@@ -0,0 +1,3 @@
+package resource
+
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=ResourceGroup -id=/subscriptions/{subscriptionId}/resourceGroups/{name}
@@ -21,6 +21,7 @@ type Client struct {
 	AccountsClient storage.AccountsClient
 
 	environment az.Environment
+	options     *common.ClientOptions
 }
 
 func BuildClient(options *common.ClientOptions) *Client {
@@ -32,6 +33,7 @@ func BuildClient(options *common.ClientOptions) *Client {
 	return &Client{
 		AccountsClient: accountsClient,
 		environment:    options.Environment,
+		options:        options,
 	}
 }
 
@@ -43,7 +45,7 @@ func (client Client) BlobsClient(ctx context.Context, resourceGroup, accountName
 
 	storageAuth := authorizers.NewSharedKeyAuthorizer(accountName, *accountKey)
 	blobsClient := blobs.NewWithEnvironment(client.environment)
-	blobsClient.Client.Authorizer = storageAuth
+	client.options.ConfigureClient(&blobsClient.Client, storageAuth)
 	return &blobsClient, nil
 }
 
@@ -55,7 +57,7 @@ func (client Client) ContainersClient(ctx context.Context, resourceGroup, accoun
 
 	storageAuth := authorizers.NewSharedKeyAuthorizer(accountName, *accountKey)
 	containersClient := containers.NewWithEnvironment(client.environment)
-	containersClient.Client.Authorizer = storageAuth
+	client.options.ConfigureClient(&containersClient.Client, storageAuth)
 	return &containersClient, nil
 }
 
@@ -67,7 +69,7 @@ func (client Client) FileShareDirectoriesClient(ctx context.Context, resourceGro
 
 	storageAuth := authorizers.NewSharedKeyLiteAuthorizer(accountName, *accountKey)
 	directoriesClient := directories.NewWithEnvironment(client.environment)
-	directoriesClient.Client.Authorizer = storageAuth
+	client.options.ConfigureClient(&directoriesClient.Client, storageAuth)
 	return &directoriesClient, nil
 }
 
@@ -79,7 +81,7 @@ func (client Client) FileSharesClient(ctx context.Context, resourceGroup, accoun
 
 	storageAuth := authorizers.NewSharedKeyLiteAuthorizer(accountName, *accountKey)
 	directoriesClient := shares.NewWithEnvironment(client.environment)
-	directoriesClient.Client.Authorizer = storageAuth
+	client.options.ConfigureClient(&directoriesClient.Client, storageAuth)
 	return &directoriesClient, nil
 }
 
@@ -91,7 +93,7 @@ func (client Client) QueuesClient(ctx context.Context, resourceGroup, accountNam
 
 	storageAuth := authorizers.NewSharedKeyLiteAuthorizer(accountName, *accountKey)
 	queuesClient := queues.NewWithEnvironment(client.environment)
-	queuesClient.Client.Authorizer = storageAuth
+	client.options.ConfigureClient(&queuesClient.Client, storageAuth)
 	return &queuesClient, nil
 }
 
@@ -103,7 +105,7 @@ func (client Client) TableEntityClient(ctx context.Context, resourceGroup, accou
 
 	storageAuth := authorizers.NewSharedKeyLiteTableAuthorizer(accountName, *accountKey)
 	entitiesClient := entities.NewWithEnvironment(client.environment)
-	entitiesClient.Client.Authorizer = storageAuth
+	client.options.ConfigureClient(&entitiesClient.Client, storageAuth)
 	return &entitiesClient, nil
 }
 
@@ -115,6 +117,6 @@ func (client Client) TablesClient(ctx context.Context, resourceGroup, accountNam
 
 	storageAuth := authorizers.NewSharedKeyLiteTableAuthorizer(accountName, *accountKey)
 	tablesClient := tables.NewWithEnvironment(client.environment)
-	tablesClient.Client.Authorizer = storageAuth
+	client.options.ConfigureClient(&tablesClient.Client, storageAuth)
 	return &tablesClient, nil
 }
@@ -0,0 +1,55 @@
+package timeouts
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
+
+// resourceData is the subset of the *schema.ResourceData interface required to
+// determine which timeout should be used for a given operation
+type resourceData interface {
+	IsNewResource() bool
+	Timeout(key string) time.Duration
+}
+
+// ForCreate returns the context wrapped with the timeout for a Create operation
+func ForCreate(ctx context.Context, d resourceData) (context.Context, context.CancelFunc) {
+	return buildWithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+}
+
+// ForCreateUpdate returns the context wrapped with the timeout for a combined Create/Update operation
+//
+// This uses the Create timeout when the resource is being created and the Update timeout otherwise
+func ForCreateUpdate(ctx context.Context, d resourceData) (context.Context, context.CancelFunc) {
+	if d.IsNewResource() {
+		return ForCreate(ctx, d)
+	}
+
+	return ForUpdate(ctx, d)
+}
+
+// ForDelete returns the context wrapped with the timeout for a Delete operation
+func ForDelete(ctx context.Context, d resourceData) (context.Context, context.CancelFunc) {
+	return buildWithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+}
+
+// ForRead returns the context wrapped with the timeout for a Read operation
+//
+// GET requests made using this context can be served from the Read Cache, when it's enabled
+func ForRead(ctx context.Context, d resourceData) (context.Context, context.CancelFunc) {
+	return buildWithTimeout(common.WithReadCaching(ctx), d.Timeout(schema.TimeoutRead))
+}
+
+// ForUpdate returns the context wrapped with the timeout for an Update operation
+func ForUpdate(ctx context.Context, d resourceData) (context.Context, context.CancelFunc) {
+	return buildWithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
+}
+
+func buildWithTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	// the StopContext is cancelled when Terraform Core is interrupted (e.g. Ctrl/Cmd+C)
+	// and as such the timeout is layered on top of it, rather than replacing it
+	return context.WithTimeout(ctx, timeout)
+}
@@ -0,0 +1,96 @@
+package timeouts
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type testResourceData struct {
+	isNew    bool
+	timeouts map[string]time.Duration
+}
+
+func (d testResourceData) IsNewResource() bool {
+	return d.isNew
+}
+
+func (d testResourceData) Timeout(key string) time.Duration {
+	return d.timeouts[key]
+}
+
+func TestDetermineTimeouts(t *testing.T) {
+	timeouts := map[string]time.Duration{
+		schema.TimeoutCreate: 10 * time.Minute,
+		schema.TimeoutRead:   20 * time.Minute,
+		schema.TimeoutUpdate: 30 * time.Minute,
+		schema.TimeoutDelete: 40 * time.Minute,
+	}
+	testData := []struct {
+		name     string
+		isNew    bool
+		function func(ctx context.Context, d resourceData) (context.Context, context.CancelFunc)
+		expected time.Duration
+	}{
+		{
+			name:     "create",
+			function: ForCreate,
+			expected: 10 * time.Minute,
+		},
+		{
+			name:     "create/update for a new resource",
+			isNew:    true,
+			function: ForCreateUpdate,
+			expected: 10 * time.Minute,
+		},
+		{
+			name:     "create/update for an existing resource",
+			isNew:    false,
+			function: ForCreateUpdate,
+			expected: 30 * time.Minute,
+		},
+		{
+			name:     "read",
+			function: ForRead,
+			expected: 20 * time.Minute,
+		},
+		{
+			name:     "update",
+			function: ForUpdate,
+			expected: 30 * time.Minute,
+		},
+		{
+			name:     "delete",
+			function: ForDelete,
+			expected: 40 * time.Minute,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test %q..", v.name)
+
+		d := testResourceData{
+			isNew:    v.isNew,
+			timeouts: timeouts,
+		}
+		ctx, cancel := v.function(context.Background(), d)
+
+		deadline, ok := ctx.Deadline()
+		cancel()
+		if !ok {
+			t.Fatalf("Expected a deadline to be set but it wasn't")
+		}
+
+		// allow some leeway for the time taken to run the test
+		actual := time.Until(deadline)
+		if actual > v.expected || actual < v.expected-time.Minute {
+			t.Fatalf("Expected a deadline of %s but got %s", v.expected, actual)
+		}
+
+		if ctx.Err() != context.Canceled {
+			t.Fatalf("Expected the context to be cancelled once the CancelFunc was called")
+		}
+	}
+}
@@ -0,0 +1,489 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+// This tool generates a typed Resource ID (comprising a struct, a Parse function, a String
+// function and a ValidateFunc) alongside Unit Tests for the same, from a declarative list
+// of segments.
+//
+// Usage (typically via `go:generate`):
+//   go run ../../tools/generator-resource-id/main.go -path=./ -name=Subnet \
+//     -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualNetworks/{virtualNetworkName}/subnets/{name}
+//
+// Each `{placeholder}` becomes a field on the struct, with the key preceding it used to locate
+// the value when parsing; the `providers` segment is validated against the literal value.
+
+func main() {
+	path := flag.String("path", "", "The relative path to the directory where the files should be output")
+	name := flag.String("name", "", "The name of this Resource Type, e.g. `Subnet`")
+	id := flag.String("id", "", "The Resource ID Template, e.g. `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualNetworks/{name}`")
+	flag.Parse()
+
+	if *path == "" || *name == "" || *id == "" {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	if err := run(*path, *name, *id); err != nil {
+		log.Fatalf("Error generating Resource ID %q: %+v", *name, err)
+	}
+}
+
+func run(outputPath, name, idTemplate string) error {
+	packageName, err := determinePackageName(outputPath)
+	if err != nil {
+		return err
+	}
+
+	definition, err := parseDefinition(packageName, name, idTemplate)
+	if err != nil {
+		return err
+	}
+
+	fileName := toSnakeCase(name) + "_id"
+	if err := writeTemplate(filepath.Join(outputPath, fileName+".go"), idCodeTemplate, definition); err != nil {
+		return fmt.Errorf("writing Resource ID: %+v", err)
+	}
+
+	if err := writeTemplate(filepath.Join(outputPath, fileName+"_test.go"), idTestTemplate, definition); err != nil {
+		return fmt.Errorf("writing Resource ID Tests: %+v", err)
+	}
+
+	return nil
+}
+
+type segment struct {
+	// Key is the key of this segment within the Resource ID, e.g. `virtualNetworks`
+	Key string
+
+	// FieldName is the name of the field on the struct, e.g. `VirtualNetworkName`
+	FieldName string
+
+	// ArgumentName is the name of the argument used in the constructor, e.g. `virtualNetworkName`
+	ArgumentName string
+
+	// ExampleValue is the value used for this segment in the Unit Tests
+	ExampleValue string
+}
+
+type definition struct {
+	PackageName string
+	Name        string
+
+	// HumanName is the Name with spaces, e.g. `Network Security Group`
+	HumanName string
+
+	// Provider is the Resource Provider namespace, e.g. `Microsoft.Network`; may be empty
+	Provider string
+
+	Segments []segment
+
+	// FormatString is the format string used to build the Resource ID, e.g. `/subscriptions/%s/...`
+	FormatString string
+}
+
+func (d definition) SubscriptionSegment() *segment {
+	return d.segmentForKey("subscriptions")
+}
+
+func (d definition) ResourceGroupSegment() *segment {
+	return d.segmentForKey("resourceGroups")
+}
+
+func (d definition) PathSegments() []segment {
+	output := make([]segment, 0)
+	for _, s := range d.Segments {
+		if s.Key == "subscriptions" || s.Key == "resourceGroups" {
+			continue
+		}
+		output = append(output, s)
+	}
+	return output
+}
+
+// ExampleID returns a valid Resource ID using the example values
+func (d definition) ExampleID() string {
+	return d.idUsing(func(s segment) string { return s.ExampleValue }, d.Provider, "")
+}
+
+// ExampleIDWithoutSegment returns the example Resource ID with the specified key (and value) omitted
+func (d definition) ExampleIDWithoutSegment(key string) string {
+	return d.idUsing(func(s segment) string { return s.ExampleValue }, d.Provider, key)
+}
+
+// ExampleIDWithEmptyValue returns the example Resource ID with the value of the specified key omitted
+func (d definition) ExampleIDWithEmptyValue(key string) string {
+	return d.idUsing(func(s segment) string {
+		if s.Key == key {
+			return ""
+		}
+		return s.ExampleValue
+	}, d.Provider, "")
+}
+
+// ExampleIDWithDifferentCasing returns the example Resource ID with the casing of the keys (but
+// not the values) changed, as can be returned by some Azure API's
+func (d definition) ExampleIDWithDifferentCasing() string {
+	id := d.idUsing(func(s segment) string { return s.ExampleValue }, strings.ToLower(d.Provider), "")
+	for _, s := range d.PathSegments() {
+		id = strings.Replace(id, "/"+s.Key+"/", "/"+strings.ToUpper(s.Key)+"/", 1)
+	}
+	return strings.Replace(id, "/resourceGroups/", "/resourcegroups/", 1)
+}
+
+// ExampleIDWithWrongProvider returns the example Resource ID using a different Resource Provider
+func (d definition) ExampleIDWithWrongProvider() string {
+	return d.idUsing(func(s segment) string { return s.ExampleValue }, "Microsoft.Example", "")
+}
+
+// ExampleIDWithExtraSegment returns the example Resource ID with an additional trailing segment
+func (d definition) ExampleIDWithExtraSegment() string {
+	return d.ExampleID() + "/extra/value1"
+}
+
+func (d definition) idUsing(valueFunc func(s segment) string, provider string, omitKey string) string {
+	output := ""
+	for _, s := range d.Segments {
+		if s.Key == "resourceGroups" || s.Key == "subscriptions" {
+			if s.Key == omitKey {
+				continue
+			}
+			output += fmt.Sprintf("/%s/%s", s.Key, valueFunc(s))
+			continue
+		}
+
+		if provider != "" && !strings.Contains(output, "/providers/") {
+			output += fmt.Sprintf("/providers/%s", provider)
+		}
+
+		if s.Key == omitKey {
+			continue
+		}
+		output += fmt.Sprintf("/%s/%s", s.Key, valueFunc(s))
+	}
+	return output
+}
+
+func (d definition) segmentForKey(key string) *segment {
+	for _, s := range d.Segments {
+		if s.Key == key {
+			v := s
+			return &v
+		}
+	}
+	return nil
+}
+
+var placeholderRegex = regexp.MustCompile(`^\{([a-zA-Z]+)\}$`)
+
+func parseDefinition(packageName, name, idTemplate string) (*definition, error) {
+	if !strings.HasPrefix(idTemplate, "/") {
+		return nil, fmt.Errorf("the ID %q must start with a `/`", idTemplate)
+	}
+
+	components := strings.Split(strings.TrimPrefix(idTemplate, "/"), "/")
+	if len(components)%2 != 0 {
+		return nil, fmt.Errorf("the ID %q must contain an even number of segments", idTemplate)
+	}
+
+	output := definition{
+		PackageName: packageName,
+		Name:        name,
+		HumanName:   toHumanName(name),
+	}
+	formatString := ""
+	for i := 0; i < len(components); i += 2 {
+		key := components[i]
+		value := components[i+1]
+
+		if key == "providers" {
+			if placeholderRegex.MatchString(value) {
+				return nil, fmt.Errorf("the `providers` segment must be a literal value but got %q", value)
+			}
+
+			output.Provider = value
+			formatString += fmt.Sprintf("/providers/%s", value)
+			continue
+		}
+
+		matches := placeholderRegex.FindStringSubmatch(value)
+		if len(matches) != 2 {
+			return nil, fmt.Errorf("the value for the segment %q must be a `{placeholder}` but got %q", key, value)
+		}
+
+		argumentName := matches[1]
+		output.Segments = append(output.Segments, segment{
+			Key:          key,
+			FieldName:    strings.ToUpper(argumentName[0:1]) + argumentName[1:],
+			ArgumentName: argumentName,
+			ExampleValue: exampleValueFor(key, argumentName),
+		})
+		formatString += fmt.Sprintf("/%s/%%s", key)
+	}
+
+	if output.SubscriptionSegment() == nil {
+		return nil, fmt.Errorf("the ID %q must contain a `subscriptions` segment", idTemplate)
+	}
+
+	output.FormatString = formatString
+	return &output, nil
+}
+
+func exampleValueFor(key, argumentName string) string {
+	if key == "subscriptions" {
+		return "12345678-1234-9876-4563-123456789012"
+	}
+
+	return strings.TrimSuffix(argumentName, "Name") + "1"
+}
+
+func determinePackageName(outputPath string) (string, error) {
+	absolutePath, err := filepath.Abs(outputPath)
+	if err != nil {
+		return "", fmt.Errorf("determining absolute path for %q: %+v", outputPath, err)
+	}
+
+	return filepath.Base(absolutePath), nil
+}
+
+func writeTemplate(fileName string, tmpl *template.Template, data *definition) error {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return fmt.Errorf("executing template: %+v", err)
+	}
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("formatting output: %+v\n\n%s", err, buf.String())
+	}
+
+	return ioutil.WriteFile(fileName, formatted, 0644)
+}
+
+func toSnakeCase(input string) string {
+	return strings.ToLower(strings.Join(splitWords(input), "_"))
+}
+
+func toHumanName(input string) string {
+	return strings.Join(splitWords(input), " ")
+}
+
+// splitWords splits a PascalCased string into its constituent words, keeping acronyms
+// together - for example `PublicIPAddress` becomes `Public`, `IP` and `Address`
+func splitWords(input string) []string {
+	runes := []rune(input)
+	words := make([]string, 0)
+	current := ""
+	for i, c := range runes {
+		if i > 0 && unicode.IsUpper(c) {
+			previousIsLower := !unicode.IsUpper(runes[i-1])
+			nextIsLower := i+1 < len(runes) && !unicode.IsUpper(runes[i+1])
+			if previousIsLower || nextIsLower {
+				words = append(words, current)
+				current = ""
+			}
+		}
+
+		current += string(c)
+	}
+
+	return append(words, current)
+}
+
+var idCodeTemplate = template.Must(template.New("id").Parse(`package {{ .PackageName }}
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	{{- if .Provider }}
+	"strings"
+	{{- end }}
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// {{ .Name }}ID is a typed representation of the ID of a {{ .HumanName }}
+type {{ .Name }}ID struct {
+{{- range .Segments }}
+	{{ .FieldName }} string
+{{- end }}
+}
+
+// New{{ .Name }}ID returns a new {{ .Name }}ID from the specified segments
+func New{{ .Name }}ID({{ range $i, $s := .Segments }}{{ if $i }}, {{ end }}{{ $s.ArgumentName }}{{ end }} string) {{ .Name }}ID {
+	return {{ .Name }}ID{
+{{- range .Segments }}
+		{{ .FieldName }}: {{ .ArgumentName }},
+{{- end }}
+	}
+}
+
+// String returns the Resource ID for this {{ .HumanName }}
+func (id {{ .Name }}ID) String() string {
+	return fmt.Sprintf("{{ .FormatString }}"{{ range .Segments }}, id.{{ .FieldName }}{{ end }})
+}
+
+// Parse{{ .Name }}ID parses the specified Resource ID into a {{ .Name }}ID, returning an error
+// if the ID isn't a valid {{ .HumanName }} ID
+func Parse{{ .Name }}ID(input string) (*{{ .Name }}ID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing {{ .HumanName }} ID %q: %+v", input, err)
+	}
+{{ if .Provider }}
+	if !strings.EqualFold(id.Provider, "{{ .Provider }}") {
+		return nil, fmt.Errorf("Error parsing {{ .HumanName }} ID %q: expected the Resource Provider to be %q but got %q", input, "{{ .Provider }}", id.Provider)
+	}
+{{ end }}
+	resourceId := {{ .Name }}ID{
+{{- with .SubscriptionSegment }}
+		{{ .FieldName }}: id.SubscriptionID,
+{{- end }}
+{{- with .ResourceGroupSegment }}
+		{{ .FieldName }}: id.ResourceGroup,
+{{- end }}
+	}
+{{ with .ResourceGroupSegment }}
+	if resourceId.{{ .FieldName }} == "" {
+		return nil, fmt.Errorf("Error parsing {{ $.HumanName }} ID %q: ID was missing the ` + "`resourceGroups`" + ` element", input)
+	}
+{{ end }}
+{{- range .PathSegments }}
+	if resourceId.{{ .FieldName }}, err = id.PopSegment("{{ .Key }}"); err != nil {
+		return nil, fmt.Errorf("Error parsing {{ $.HumanName }} ID %q: %+v", input, err)
+	}
+{{ end }}
+	if err := id.ValidateNoRemainingSegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing {{ .HumanName }} ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// Validate{{ .Name }}ID validates that the specified value is a valid {{ .HumanName }} ID
+func Validate{{ .Name }}ID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := Parse{{ .Name }}ID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a {{ .HumanName }} ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
+`))
+
+var idTestTemplate = template.Must(template.New("idTest").Parse(`package {{ .PackageName }}
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"testing"
+)
+
+func Test{{ .Name }}IDFormatter(t *testing.T) {
+	actual := New{{ .Name }}ID({{ range $i, $s := .Segments }}{{ if $i }}, {{ end }}"{{ $s.ExampleValue }}"{{ end }}).String()
+	expected := "{{ .ExampleID }}"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestParse{{ .Name }}ID(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    string
+		Expected *{{ .Name }}ID
+	}{
+		{
+			Name:     "Empty",
+			Input:    "",
+			Expected: nil,
+		},
+{{- range .Segments }}
+		{
+			Name:     "Missing {{ .Key }} Segment",
+			Input:    "{{ $.ExampleIDWithoutSegment .Key }}",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing {{ .Key }} Value",
+			Input:    "{{ $.ExampleIDWithEmptyValue .Key }}",
+			Expected: nil,
+		},
+{{- end }}
+{{- if .Provider }}
+		{
+			Name:     "Wrong Resource Provider",
+			Input:    "{{ .ExampleIDWithWrongProvider }}",
+			Expected: nil,
+		},
+{{- end }}
+		{
+			Name:     "Unexpected Segment",
+			Input:    "{{ .ExampleIDWithExtraSegment }}",
+			Expected: nil,
+		},
+		{
+			Name:  "Valid",
+			Input: "{{ .ExampleID }}",
+			Expected: &{{ .Name }}ID{
+{{- range .Segments }}
+				{{ .FieldName }}: "{{ .ExampleValue }}",
+{{- end }}
+			},
+		},
+		{
+			Name:  "Different Casing",
+			Input: "{{ .ExampleIDWithDifferentCasing }}",
+			Expected: &{{ .Name }}ID{
+{{- range .Segments }}
+				{{ .FieldName }}: "{{ .ExampleValue }}",
+{{- end }}
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := Parse{{ .Name }}ID(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Expected == nil {
+			t.Fatalf("Expected an error but got a value for %q", v.Input)
+		}
+{{ range .Segments }}
+		if actual.{{ .FieldName }} != v.Expected.{{ .FieldName }} {
+			t.Fatalf("Expected %q but got %q for {{ .FieldName }}", v.Expected.{{ .FieldName }}, actual.{{ .FieldName }})
+		}
+{{ end }}
+		if _, errors := Validate{{ .Name }}ID(v.Input, "id"); len(errors) > 0 {
+			t.Fatalf("Expected %q to be a valid ID but got: %+v", v.Input, errors)
+		}
+	}
+}
+`))
@@ -8,8 +8,8 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -25,7 +25,8 @@ func resourceLogicAppTriggerUpdate(d *schema.ResourceData, meta interface{}, log
 
 func resourceLogicAppComponentUpdate(d *schema.ResourceData, meta interface{}, kind string, propertyName string, logicAppId string, name string, vals map[string]interface{}, resourceName string) error {
 	client := meta.(*ArmClient).logic.WorkflowsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(logicAppId)
 	if err != nil {
@@ -38,7 +39,9 @@ func resourceLogicAppComponentUpdate(d *schema.ResourceData, meta interface{}, k
 	log.Printf("[DEBUG] Preparing arguments for Logic App Workspace %q (Resource Group %q) %s %q", logicAppName, resourceGroup, kind, name)
 
 	// lock to prevent against Actions or Triggers conflicting
-	locks.ByName(logicAppName, logicAppResourceName)
+	if err := locks.ByNameWithContext(ctx, logicAppName, logicAppResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(logicAppName, logicAppResourceName)
 
 	read, err := client.Get(ctx, resourceGroup, logicAppName)
@@ -63,7 +66,7 @@ func resourceLogicAppComponentUpdate(d *schema.ResourceData, meta interface{}, k
 	definition := read.WorkflowProperties.Definition.(map[string]interface{})
 	vs := definition[propertyName].(map[string]interface{})
 
-	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+	if meta.(*ArmClient).Features.RequiresImport && d.IsNewResource() {
 		if _, hasExisting := vs[name]; hasExisting {
 			return tf.ImportAsExistsError(resourceName, resourceId)
 		}
@@ -102,12 +105,15 @@ func resourceLogicAppTriggerRemove(d *schema.ResourceData, meta interface{}, res
 
 func resourceLogicAppComponentRemove(d *schema.ResourceData, meta interface{}, kind, propertyName, resourceGroup, logicAppName, name string) error {
 	client := meta.(*ArmClient).logic.WorkflowsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[DEBUG] Preparing arguments for Logic App Workspace %q (Resource Group %q) %s %q Deletion", logicAppName, resourceGroup, kind, name)
 
 	// lock to prevent against Actions, Parameters or Actions conflicting
-	locks.ByName(logicAppName, logicAppResourceName)
+	if err := locks.ByNameWithContext(ctx, logicAppName, logicAppResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(logicAppName, logicAppResourceName)
 
 	read, err := client.Get(ctx, resourceGroup, logicAppName)
@@ -164,7 +170,9 @@ func retrieveLogicAppComponent(meta interface{}, resourceGroup, kind, propertyNa
 	log.Printf("[DEBUG] Preparing arguments for Logic App Workspace %q (Resource Group %q) %s %q", logicAppName, resourceGroup, kind, name)
 
 	// lock to prevent against Actions, Parameters or Actions conflicting
-	locks.ByName(logicAppName, logicAppResourceName)
+	if err := locks.ByNameWithContext(ctx, logicAppName, logicAppResourceName); err != nil {
+		return nil, nil, err
+	}
 	defer locks.UnlockByName(logicAppName, logicAppResourceName)
 
 	read, err := client.Get(ctx, resourceGroup, logicAppName)
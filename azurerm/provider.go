@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/common"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -108,6 +109,9 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_notification_hub":                        dataSourceNotificationHub(),
 		"azurerm_platform_image":                          dataSourceArmPlatformImage(),
 		"azurerm_policy_definition":                       dataSourceArmPolicyDefinition(),
+		"azurerm_private_dns_zone":                        dataSourceArmPrivateDnsZone(),
+		"azurerm_private_endpoint_connection":             dataSourceArmPrivateEndpointConnection(),
+		"azurerm_private_link_service":                    dataSourceArmPrivateLinkService(),
 		"azurerm_proximity_placement_group":               dataSourceArmProximityPlacementGroup(),
 		"azurerm_public_ip":                               dataSourceArmPublicIP(),
 		"azurerm_public_ips":                              dataSourceArmPublicIPs(),
@@ -192,6 +196,7 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_azuread_application":                                resourceArmActiveDirectoryApplication(),
 		"azurerm_azuread_service_principal_password":                 resourceArmActiveDirectoryServicePrincipalPassword(),
 		"azurerm_azuread_service_principal":                          resourceArmActiveDirectoryServicePrincipal(),
+		"azurerm_bastion_host":                                       resourceArmBastionHost(),
 		"azurerm_batch_account":                                      resourceArmBatchAccount(),
 		"azurerm_batch_application":                                  resourceArmBatchApplication(),
 		"azurerm_batch_certificate":                                  resourceArmBatchCertificate(),
@@ -262,6 +267,8 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_firewall_nat_rule_collection":                       resourceArmFirewallNatRuleCollection(),
 		"azurerm_firewall_network_rule_collection":                   resourceArmFirewallNetworkRuleCollection(),
 		"azurerm_firewall":                                           resourceArmFirewall(),
+		"azurerm_firewall_policy":                                    resourceArmFirewallPolicy(),
+		"azurerm_firewall_policy_rule_collection_group":              resourceArmFirewallPolicyRuleCollectionGroup(),
 		"azurerm_frontdoor":                                          resourceArmFrontDoor(),
 		"azurerm_frontdoor_firewall_policy":                          resourceArmFrontDoorFirewallPolicy(),
 		"azurerm_function_app":                                       resourceArmFunctionApp(),
@@ -330,6 +337,7 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_mysql_firewall_rule":                                resourceArmMySqlFirewallRule(),
 		"azurerm_mysql_server":                                       resourceArmMySqlServer(),
 		"azurerm_mysql_virtual_network_rule":                         resourceArmMySqlVirtualNetworkRule(),
+		"azurerm_nat_gateway":                                        resourceArmNatGateway(),
 		"azurerm_network_connection_monitor":                         resourceArmNetworkConnectionMonitor(),
 		"azurerm_network_ddos_protection_plan":                       resourceArmNetworkDDoSProtectionPlan(),
 		"azurerm_network_interface":                                  resourceArmNetworkInterface(),
@@ -342,6 +350,7 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_network_security_group":                                                 resourceArmNetworkSecurityGroup(),
 		"azurerm_network_security_rule":                                                  resourceArmNetworkSecurityRule(),
 		"azurerm_network_watcher":                                                        resourceArmNetworkWatcher(),
+		"azurerm_network_watcher_flow_log":                                               resourceArmNetworkWatcherFlowLog(),
 		"azurerm_notification_hub_authorization_rule":                                    resourceArmNotificationHubAuthorizationRule(),
 		"azurerm_notification_hub_namespace":                                             resourceArmNotificationHubNamespace(),
 		"azurerm_notification_hub":                                                       resourceArmNotificationHub(),
@@ -349,6 +358,7 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_policy_assignment":                                                      resourceArmPolicyAssignment(),
 		"azurerm_policy_definition":                                                      resourceArmPolicyDefinition(),
 		"azurerm_policy_set_definition":                                                  resourceArmPolicySetDefinition(),
+		"azurerm_point_to_site_vpn_gateway":                                              resourceArmPointToSiteVpnGateway(),
 		"azurerm_postgresql_configuration":                                               resourceArmPostgreSQLConfiguration(),
 		"azurerm_postgresql_database":                                                    resourceArmPostgreSQLDatabase(),
 		"azurerm_postgresql_firewall_rule":                                               resourceArmPostgreSQLFirewallRule(),
@@ -356,8 +366,15 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_postgresql_virtual_network_rule":                                        resourceArmPostgreSQLVirtualNetworkRule(),
 		"azurerm_private_dns_zone":                                                       resourceArmPrivateDnsZone(),
 		"azurerm_private_dns_a_record":                                                   resourceArmPrivateDnsARecord(),
+		"azurerm_private_dns_aaaa_record":                                                resourceArmPrivateDnsAaaaRecord(),
 		"azurerm_private_dns_cname_record":                                               resourceArmPrivateDnsCNameRecord(),
+		"azurerm_private_dns_mx_record":                                                  resourceArmPrivateDnsMxRecord(),
+		"azurerm_private_dns_ptr_record":                                                 resourceArmPrivateDnsPtrRecord(),
+		"azurerm_private_dns_srv_record":                                                 resourceArmPrivateDnsSrvRecord(),
+		"azurerm_private_dns_txt_record":                                                 resourceArmPrivateDnsTxtRecord(),
 		"azurerm_private_dns_zone_virtual_network_link":                                  resourceArmPrivateDnsZoneVirtualNetworkLink(),
+		"azurerm_private_endpoint":                                                       resourceArmPrivateEndpoint(),
+		"azurerm_private_link_service":                                                   resourceArmPrivateLinkService(),
 		"azurerm_proximity_placement_group":                                              resourceArmProximityPlacementGroup(),
 		"azurerm_public_ip":                                                              resourceArmPublicIp(),
 		"azurerm_public_ip_prefix":                                                       resourceArmPublicIpPrefix(),
@@ -423,6 +440,7 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_stream_analytics_stream_input_blob":                                     resourceArmStreamAnalyticsStreamInputBlob(),
 		"azurerm_stream_analytics_stream_input_eventhub":                                 resourceArmStreamAnalyticsStreamInputEventHub(),
 		"azurerm_stream_analytics_stream_input_iothub":                                   resourceArmStreamAnalyticsStreamInputIoTHub(),
+		"azurerm_subnet_nat_gateway_association":                                         resourceArmSubnetNatGatewayAssociation(),
 		"azurerm_subnet_network_security_group_association":                              resourceArmSubnetNetworkSecurityGroupAssociation(),
 		"azurerm_subnet_route_table_association":                                         resourceArmSubnetRouteTableAssociation(),
 		"azurerm_subnet":                                                                 resourceArmSubnet(),
@@ -430,7 +448,10 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_traffic_manager_endpoint":                                               resourceArmTrafficManagerEndpoint(),
 		"azurerm_traffic_manager_profile":                                                resourceArmTrafficManagerProfile(),
 		"azurerm_user_assigned_identity":                                                 resourceArmUserAssignedIdentity(),
+		"azurerm_virtual_hub_connection":                                                 resourceArmVirtualHubConnection(),
+		"azurerm_virtual_hub":                                                            resourceArmVirtualHub(),
 		"azurerm_virtual_machine_data_disk_attachment":                                   resourceArmVirtualMachineDataDiskAttachment(),
+		"azurerm_virtual_machine_disk_encryption":                                        resourceArmVirtualMachineDiskEncryption(),
 		"azurerm_virtual_machine_extension":                                              resourceArmVirtualMachineExtensions(),
 		"azurerm_virtual_machine_scale_set":                                              resourceArmVirtualMachineScaleSet(),
 		"azurerm_virtual_machine":                                                        resourceArmVirtualMachine(),
@@ -439,6 +460,9 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_virtual_network_peering":                                                resourceArmVirtualNetworkPeering(),
 		"azurerm_virtual_network":                                                        resourceArmVirtualNetwork(),
 		"azurerm_virtual_wan":                                                            resourceArmVirtualWan(),
+		"azurerm_vpn_gateway":                                                            resourceArmVpnGateway(),
+		"azurerm_vpn_server_configuration":                                               resourceArmVpnServerConfiguration(),
+		"azurerm_vpn_site":                                                               resourceArmVpnSite(),
 		"azurerm_web_application_firewall_policy":                                        resourceArmWebApplicationFirewallPolicy(),
 	}
 
@@ -562,6 +586,20 @@ func Provider() terraform.ResourceProvider {
 				Description: "This will disable the x-ms-correlation-request-id header.",
 			},
 
+			"http_trace_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_HTTP_TRACE_FILE", ""),
+				Description: "The path to a file which a redacted trace (in JSON) of each HTTP request made to Azure should be appended to.",
+			},
+
+			"enable_read_cache": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_ENABLE_READ_CACHE", false),
+				Description: "Should identical reads of the same resource be deduplicated during a run of Terraform?",
+			},
+
 			// Advanced feature flags
 			"skip_credentials_validation": {
 				Type:        schema.TypeBool,
@@ -576,6 +614,8 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("ARM_SKIP_PROVIDER_REGISTRATION", false),
 				Description: "Should the AzureRM Provider skip registering all of the Resource Providers that it supports, if they're not already registered?",
 			},
+
+			"features": features.Schema(),
 		},
 
 		DataSourcesMap: dataSources,
@@ -633,12 +673,16 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 		partnerId := d.Get("partner_id").(string)
 		skipProviderRegistration := d.Get("skip_provider_registration").(bool)
 		disableCorrelationRequestID := d.Get("disable_correlation_request_id").(bool)
+		httpTraceFile := d.Get("http_trace_file").(string)
+		enableReadCache := d.Get("enable_read_cache").(bool)
 
-		client, err := getArmClient(config, skipProviderRegistration, partnerId, disableCorrelationRequestID)
+		client, err := getArmClient(config, skipProviderRegistration, partnerId, disableCorrelationRequestID, httpTraceFile, enableReadCache)
 		if err != nil {
 			return nil, err
 		}
 
+		client.Features = features.Expand(d.Get("features").([]interface{}))
+
 		// TODO: clean this up when ArmClient is removed
 		client.StopContext = p.StopContext()
 		client.Client.StopContext = p.StopContext()
@@ -648,6 +692,11 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 			// TODO: remove the old reference here
 			client.StopContext = p.StopContext()
 			client.Client.StopContext = p.StopContext()
+
+			// cached responses mustn't be carried over between tests
+			if client.Client.ReadCache != nil {
+				client.Client.ReadCache.Clear()
+			}
 			return nil
 		}
 
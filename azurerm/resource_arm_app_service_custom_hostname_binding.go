@@ -3,13 +3,14 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -24,6 +25,12 @@ func resourceArmAppServiceCustomHostnameBinding() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"hostname": {
 				Type:     schema.TypeString,
@@ -44,7 +51,8 @@ func resourceArmAppServiceCustomHostnameBinding() *schema.Resource {
 
 func resourceArmAppServiceCustomHostnameBindingCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).web.AppServicesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for App Service Hostname Binding creation.")
 
@@ -52,10 +60,12 @@ func resourceArmAppServiceCustomHostnameBindingCreate(d *schema.ResourceData, me
 	appServiceName := d.Get("app_service_name").(string)
 	hostname := d.Get("hostname").(string)
 
-	locks.ByName(appServiceName, appServiceCustomHostnameBindingResourceName)
+	if err := locks.ByNameWithContext(ctx, appServiceName, appServiceCustomHostnameBindingResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(appServiceName, appServiceCustomHostnameBindingResourceName)
 
-	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+	if meta.(*ArmClient).Features.RequiresImport && d.IsNewResource() {
 		existing, err := client.GetHostNameBinding(ctx, resourceGroup, appServiceName, hostname)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -103,7 +113,8 @@ func resourceArmAppServiceCustomHostnameBindingRead(d *schema.ResourceData, meta
 	appServiceName := id.Path["sites"]
 	hostname := id.Path["hostNameBindings"]
 
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	resp, err := client.GetHostNameBinding(ctx, resourceGroup, appServiceName, hostname)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
@@ -123,6 +134,8 @@ func resourceArmAppServiceCustomHostnameBindingRead(d *schema.ResourceData, meta
 
 func resourceArmAppServiceCustomHostnameBindingDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).web.AppServicesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -132,12 +145,13 @@ func resourceArmAppServiceCustomHostnameBindingDelete(d *schema.ResourceData, me
 	appServiceName := id.Path["sites"]
 	hostname := id.Path["hostNameBindings"]
 
-	locks.ByName(appServiceName, appServiceCustomHostnameBindingResourceName)
+	if err := locks.ByNameWithContext(ctx, appServiceName, appServiceCustomHostnameBindingResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(appServiceName, appServiceCustomHostnameBindingResourceName)
 
 	log.Printf("[DEBUG] Deleting App Service Hostname Binding %q (App Service %q / Resource Group %q)", hostname, appServiceName, resGroup)
 
-	ctx := meta.(*ArmClient).StopContext
 	resp, err := client.DeleteHostNameBinding(ctx, resGroup, appServiceName, hostname)
 	if err != nil {
 		if !utils.ResponseWasNotFound(resp) {
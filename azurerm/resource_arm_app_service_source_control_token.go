@@ -3,12 +3,14 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -24,6 +26,13 @@ func resourceArmAppServiceSourceControlToken() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"type": {
 				Type:     schema.TypeString,
@@ -55,7 +64,8 @@ func resourceArmAppServiceSourceControlToken() *schema.Resource {
 
 func resourceArmAppServiceSourceControlTokenCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).web.BaseClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for App Service Source Control Token creation.")
 
@@ -63,7 +73,9 @@ func resourceArmAppServiceSourceControlTokenCreateUpdate(d *schema.ResourceData,
 	token := d.Get("token").(string)
 	tokenSecret := d.Get("token_secret").(string)
 
-	locks.ByName(scmType, appServiceSourceControlTokenResourceName)
+	if err := locks.ByNameWithContext(ctx, scmType, appServiceSourceControlTokenResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(scmType, appServiceSourceControlTokenResourceName)
 
 	properties := web.SourceControl{
@@ -92,7 +104,8 @@ func resourceArmAppServiceSourceControlTokenCreateUpdate(d *schema.ResourceData,
 
 func resourceArmAppServiceSourceControlTokenRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).web.BaseClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	scmType := d.Id()
 
@@ -118,7 +131,8 @@ func resourceArmAppServiceSourceControlTokenRead(d *schema.ResourceData, meta in
 
 func resourceArmAppServiceSourceControlTokenDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).web.BaseClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	scmType := d.Id()
 
@@ -126,7 +140,9 @@ func resourceArmAppServiceSourceControlTokenDelete(d *schema.ResourceData, meta
 	token := ""
 	tokenSecret := ""
 
-	locks.ByName(scmType, appServiceSourceControlTokenResourceName)
+	if err := locks.ByNameWithContext(ctx, scmType, appServiceSourceControlTokenResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(scmType, appServiceSourceControlTokenResourceName)
 
 	log.Printf("[DEBUG] Deleting App Service Source Control Token (Type %q)", scmType)
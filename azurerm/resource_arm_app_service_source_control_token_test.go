@@ -5,16 +5,16 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func TestAccAzureRMAppServiceSourceControlToken(t *testing.T) {
 	resourceName := "azurerm_app_service_source_control_token.test"
-	token := strings.ToLower(acctest.RandString(41))
-	tokenSecret := strings.ToLower(acctest.RandString(41))
+	token := strings.ToLower(tf.AccRandString(41))
+	tokenSecret := strings.ToLower(tf.AccRandString(41))
 
 	config := testAccAzureRMAppServiceSourceControlToken(token, tokenSecret)
 
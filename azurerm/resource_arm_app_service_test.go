@@ -6,7 +6,6 @@ import (
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
-	"github.com/hashicorp/terraform/helper/acctest"
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -217,7 +216,7 @@ func TestAccAzureRMAppService_32Bit(t *testing.T) {
 func TestAccAzureRMAppService_backup(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(5)
+	rs := tf.AccRandString(5)
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1032,7 +1031,7 @@ func TestAccAzureRMAppService_httpFileSystemLogs(t *testing.T) {
 func TestAccAzureRMAppService_httpBlobStorageLogs(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(5)
+	rs := tf.AccRandString(5)
 	config := testAccAzureRMAppService_httpBlobStorageLogs(ri, rs, testLocation())
 	config2 := testAccAzureRMAppService_basic(ri, testLocation())
 
@@ -1070,7 +1069,7 @@ func TestAccAzureRMAppService_httpBlobStorageLogs(t *testing.T) {
 func TestAccAzureRMAppService_httpFileSystemAndStorageBlobLogs(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(5)
+	rs := tf.AccRandString(5)
 	config := testAccAzureRMAppService_httpFileSystemAndStorageBlobLogs(ri, rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -11,8 +12,9 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	networkSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -40,8 +42,14 @@ func resourceArmApplicationGateway() *schema.Resource {
 		Read:   resourceArmApplicationGatewayRead,
 		Update: resourceArmApplicationGatewayCreateUpdate,
 		Delete: resourceArmApplicationGatewayDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+
+		Importer: azure.ValidateResourceIDPriorToImport(networkSvc.ValidateApplicationGatewayID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
+			Delete: schema.DefaultTimeout(90 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -287,9 +295,10 @@ func resourceArmApplicationGateway() *schema.Resource {
 						},
 
 						"subnet_id": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							DiffSuppressFunc: suppress.ResourceIDDifference,
 						},
 
 						"private_ip_address": {
@@ -299,9 +308,10 @@ func resourceArmApplicationGateway() *schema.Resource {
 						},
 
 						"public_ip_address_id": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							DiffSuppressFunc: suppress.ResourceIDDifference,
 						},
 
 						"private_ip_address_allocation": {
@@ -359,9 +369,10 @@ func resourceArmApplicationGateway() *schema.Resource {
 						},
 
 						"subnet_id": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: azure.ValidateResourceID,
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     networkSvc.ValidateSubnetID,
+							DiffSuppressFunc: suppress.ResourceIDDifference,
 						},
 
 						"id": {
@@ -1341,14 +1352,15 @@ func resourceArmApplicationGateway() *schema.Resource {
 func resourceArmApplicationGatewayCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
 	client := armClient.network.ApplicationGatewaysClient
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(armClient.StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Application Gateway creation.")
 
 	name := d.Get("name").(string)
 	resGroup := d.Get("resource_group_name").(string)
 
-	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+	if meta.(*ArmClient).Features.RequiresImport && d.IsNewResource() {
 		existing, err := client.Get(ctx, resGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -1503,7 +1515,8 @@ func resourceArmApplicationGatewayCreateUpdate(d *schema.ResourceData, meta inte
 
 func resourceArmApplicationGatewayRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).network.ApplicationGatewaysClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -1645,7 +1658,8 @@ func resourceArmApplicationGatewayRead(d *schema.ResourceData, meta interface{})
 
 func resourceArmApplicationGatewayDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).network.ApplicationGatewaysClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -2433,7 +2447,7 @@ func flattenApplicationGatewayIPConfigurations(input *[]network.ApplicationGatew
 		if props := v.ApplicationGatewayIPConfigurationPropertiesFormat; props != nil {
 			if subnet := props.Subnet; subnet != nil {
 				if subnet.ID != nil {
-					output["subnet_id"] = *subnet.ID
+					output["subnet_id"] = azure.NormalizeResourceID(*subnet.ID)
 				}
 			}
 		}
@@ -2556,7 +2570,7 @@ func flattenApplicationGatewayFrontendIPConfigurations(input *[]network.Applicat
 			output["private_ip_address_allocation"] = string(props.PrivateIPAllocationMethod)
 
 			if props.Subnet != nil && props.Subnet.ID != nil {
-				output["subnet_id"] = *props.Subnet.ID
+				output["subnet_id"] = azure.NormalizeResourceID(*props.Subnet.ID)
 			}
 
 			if props.PrivateIPAddress != nil {
@@ -2564,7 +2578,7 @@ func flattenApplicationGatewayFrontendIPConfigurations(input *[]network.Applicat
 			}
 
 			if props.PublicIPAddress != nil && props.PublicIPAddress.ID != nil {
-				output["public_ip_address_id"] = *props.PublicIPAddress.ID
+				output["public_ip_address_id"] = azure.NormalizeResourceID(*props.PublicIPAddress.ID)
 			}
 		}
 
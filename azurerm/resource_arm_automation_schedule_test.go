@@ -68,7 +68,7 @@ func TestAccAzureRMAutomationSchedule_oneTime_complete(t *testing.T) {
 	//the API returns the time in the timezone we pass in
 	//it also seems to strip seconds, hijack the RFC3339 format to have 0s there
 	loc, _ := time.LoadLocation("CET")
-	startTime := time.Now().UTC().Add(time.Hour * 7).In(loc).Format("2006-01-02T15:04:00Z07:00")
+	startTime := tf.AccTimeNow().Add(time.Hour * 7).In(loc).Format("2006-01-02T15:04:00Z07:00")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -95,7 +95,7 @@ func TestAccAzureRMAutomationSchedule_oneTime_update(t *testing.T) {
 	//the API returns the time in the timezone we pass in
 	//it also seems to strip seconds, hijack the RFC3339 format to have 0s there
 	loc, _ := time.LoadLocation("CET")
-	startTime := time.Now().UTC().Add(time.Hour * 7).In(loc).Format("2006-01-02T15:04:00Z07:00")
+	startTime := tf.AccTimeNow().Add(time.Hour * 7).In(loc).Format("2006-01-02T15:04:00Z07:00")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
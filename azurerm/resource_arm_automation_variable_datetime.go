@@ -3,6 +3,7 @@ package azurerm
 import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"time"
 )
 
 func resourceArmAutomationVariableDateTime() *schema.Resource {
@@ -16,6 +17,13 @@ func resourceArmAutomationVariableDateTime() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: resourceAutomationVariableCommonSchema(schema.TypeString, validate.RFC3339Time),
 	}
 }
@@ -2,6 +2,7 @@ package azurerm
 
 import (
 	"github.com/hashicorp/terraform/helper/schema"
+	"time"
 )
 
 func resourceArmAutomationVariableInt() *schema.Resource {
@@ -15,6 +16,13 @@ func resourceArmAutomationVariableInt() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: resourceAutomationVariableCommonSchema(schema.TypeInt, nil),
 	}
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -31,6 +32,12 @@ As such the Azure Active Directory resources within the AzureRM Provider are now
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"service_principal_id": {
 				Type:         schema.TypeString,
@@ -74,7 +81,8 @@ As such the Azure Active Directory resources within the AzureRM Provider are now
 
 func resourceArmActiveDirectoryServicePrincipalPasswordCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).graph.ServicePrincipalsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	objectId := d.Get("service_principal_id").(string)
 	value := d.Get("value").(string)
@@ -105,7 +113,9 @@ func resourceArmActiveDirectoryServicePrincipalPasswordCreate(d *schema.Resource
 		credential.StartDate = &date.Time{Time: startDate}
 	}
 
-	locks.ByName(objectId, servicePrincipalResourceName)
+	if err := locks.ByNameWithContext(ctx, objectId, servicePrincipalResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(objectId, servicePrincipalResourceName)
 
 	existingCredentials, err := client.ListPasswordCredentials(ctx, objectId)
@@ -145,7 +155,8 @@ func resourceArmActiveDirectoryServicePrincipalPasswordCreate(d *schema.Resource
 
 func resourceArmActiveDirectoryServicePrincipalPasswordRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).graph.ServicePrincipalsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id := strings.Split(d.Id(), "/")
 	if len(id) != 2 {
@@ -207,7 +218,8 @@ func resourceArmActiveDirectoryServicePrincipalPasswordRead(d *schema.ResourceDa
 
 func resourceArmActiveDirectoryServicePrincipalPasswordDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).graph.ServicePrincipalsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id := strings.Split(d.Id(), "/")
 	if len(id) != 2 {
@@ -217,7 +229,9 @@ func resourceArmActiveDirectoryServicePrincipalPasswordDelete(d *schema.Resource
 	objectId := id[0]
 	keyId := id[1]
 
-	locks.ByName(objectId, servicePrincipalResourceName)
+	if err := locks.ByNameWithContext(ctx, objectId, servicePrincipalResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(objectId, servicePrincipalResourceName)
 
 	// ensure the parent Service Principal exists
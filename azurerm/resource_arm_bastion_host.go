@@ -0,0 +1,365 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	networkSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// the Subnet used by a Bastion Host must be named `AzureBastionSubnet` and be at least a /27
+const bastionHostSubnetName = "AzureBastionSubnet"
+const bastionHostSubnetMaximumPrefixLength = 27
+
+func resourceArmBastionHost() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmBastionHostCreateUpdate,
+		Read:   resourceArmBastionHostRead,
+		Update: resourceArmBastionHostCreateUpdate,
+		Delete: resourceArmBastionHostDelete,
+
+		Importer: azure.ValidateResourceIDPriorToImport(networkSvc.ValidateBastionHostID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.BastionHostName,
+			},
+
+			"location": azure.SchemaLocation(),
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"ip_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validate.BastionHostName,
+						},
+
+						"subnet_id": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateFunc:     validateArmBastionHostSubnetID,
+							DiffSuppressFunc: suppress.ResourceIDDifference,
+						},
+
+						"public_ip_address_id": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateFunc:     networkSvc.ValidatePublicIPAddressID,
+							DiffSuppressFunc: suppress.ResourceIDDifference,
+						},
+					},
+				},
+			},
+
+			"dns_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceArmBastionHostCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network.BastionHostsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Println("[INFO] preparing arguments for Azure Bastion Host creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if meta.(*ArmClient).Features.RequiresImport && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Bastion Host %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_bastion_host", *existing.ID)
+		}
+	}
+
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	t := d.Get("tags").(map[string]interface{})
+
+	ipConfigs := d.Get("ip_configuration").([]interface{})
+	ipConfig := ipConfigs[0].(map[string]interface{})
+	subnetId := ipConfig["subnet_id"].(string)
+	publicIpAddressId := ipConfig["public_ip_address_id"].(string)
+
+	if err := validateArmBastionHostSubnet(ctx, meta, subnetId); err != nil {
+		return err
+	}
+
+	if err := validateArmBastionHostPublicIPAddress(ctx, meta, publicIpAddressId); err != nil {
+		return err
+	}
+
+	parameters := network.BastionHost{
+		Location: &location,
+		BastionHostPropertiesFormat: &network.BastionHostPropertiesFormat{
+			IPConfigurations: expandArmBastionHostIPConfiguration(ipConfigs),
+		},
+		Tags: tags.Expand(t),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Bastion Host %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Bastion Host %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Bastion Host %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Bastion Host %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmBastionHostRead(d, meta)
+}
+
+func resourceArmBastionHostRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network.BastionHostsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := networkSvc.ParseBastionHostID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Bastion Host %q (Resource Group %q) was not found - removing from state", id.Name, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Bastion Host %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	if props := resp.BastionHostPropertiesFormat; props != nil {
+		d.Set("dns_name", props.DNSName)
+
+		if err := d.Set("ip_configuration", flattenArmBastionHostIPConfiguration(props.IPConfigurations)); err != nil {
+			return fmt.Errorf("Error setting `ip_configuration`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceArmBastionHostDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network.BastionHostsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := networkSvc.ParseBastionHostID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Bastion Host %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Bastion Host %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func validateArmBastionHostSubnetID(i interface{}, k string) (warnings []string, errors []error) {
+	warnings, errors = networkSvc.ValidateSubnetID(i, k)
+	if len(errors) > 0 {
+		return warnings, errors
+	}
+
+	id, err := networkSvc.ParseSubnetID(i.(string))
+	if err != nil {
+		errors = append(errors, fmt.Errorf("parsing %q: %+v", k, err))
+		return warnings, errors
+	}
+
+	if id.Name != bastionHostSubnetName {
+		errors = append(errors, fmt.Errorf("%q must reference a Subnet named %q, got %q", k, bastionHostSubnetName, id.Name))
+	}
+
+	return warnings, errors
+}
+
+// validateArmBastionHostSubnet ensures the Subnet is large enough to host a Bastion Host, since the
+// address prefix isn't known until the Subnet has been created
+func validateArmBastionHostSubnet(ctx context.Context, meta interface{}, subnetId string) error {
+	client := meta.(*ArmClient).network.SubnetsClient
+
+	id, err := networkSvc.ParseSubnetID(subnetId)
+	if err != nil {
+		return err
+	}
+
+	if id.Name != bastionHostSubnetName {
+		return fmt.Errorf("The Subnet used for a Bastion Host must be named %q but got %q", bastionHostSubnetName, id.Name)
+	}
+
+	subnet, err := client.Get(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): %+v", id.Name, id.VirtualNetworkName, id.ResourceGroup, err)
+	}
+
+	props := subnet.SubnetPropertiesFormat
+	if props == nil || props.AddressPrefix == nil {
+		return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): `addressPrefix` was nil", id.Name, id.VirtualNetworkName, id.ResourceGroup)
+	}
+
+	_, cidr, err := net.ParseCIDR(*props.AddressPrefix)
+	if err != nil {
+		return fmt.Errorf("Error parsing address prefix %q for Subnet %q (Virtual Network %q / Resource Group %q): %+v", *props.AddressPrefix, id.Name, id.VirtualNetworkName, id.ResourceGroup, err)
+	}
+
+	if prefixLength, _ := cidr.Mask.Size(); prefixLength > bastionHostSubnetMaximumPrefixLength {
+		return fmt.Errorf("The Subnet used for a Bastion Host must have a prefix of /%d or larger but Subnet %q (Virtual Network %q / Resource Group %q) is a /%d", bastionHostSubnetMaximumPrefixLength, id.Name, id.VirtualNetworkName, id.ResourceGroup, prefixLength)
+	}
+
+	return nil
+}
+
+// validateArmBastionHostPublicIPAddress ensures the Public IP Address is a Standard SKU with a Static allocation
+func validateArmBastionHostPublicIPAddress(ctx context.Context, meta interface{}, publicIpAddressId string) error {
+	client := meta.(*ArmClient).network.PublicIPsClient
+
+	id, err := networkSvc.ParsePublicIPAddressID(publicIpAddressId)
+	if err != nil {
+		return err
+	}
+
+	publicIp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Public IP Address %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if publicIp.Sku == nil || publicIp.Sku.Name != network.PublicIPAddressSkuNameStandard {
+		return fmt.Errorf("The Public IP Address used for a Bastion Host must use the %q SKU - Public IP Address %q (Resource Group %q) does not", string(network.PublicIPAddressSkuNameStandard), id.Name, id.ResourceGroup)
+	}
+
+	if props := publicIp.PublicIPAddressPropertiesFormat; props == nil || props.PublicIPAllocationMethod != network.Static {
+		return fmt.Errorf("The Public IP Address used for a Bastion Host must use a %q allocation method - Public IP Address %q (Resource Group %q) does not", string(network.Static), id.Name, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func expandArmBastionHostIPConfiguration(input []interface{}) *[]network.BastionHostIPConfiguration {
+	results := make([]network.BastionHostIPConfiguration, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		results = append(results, network.BastionHostIPConfiguration{
+			Name: utils.String(v["name"].(string)),
+			BastionHostIPConfigurationPropertiesFormat: &network.BastionHostIPConfigurationPropertiesFormat{
+				Subnet: &network.SubResource{
+					ID: utils.String(v["subnet_id"].(string)),
+				},
+				PublicIPAddress: &network.SubResource{
+					ID: utils.String(v["public_ip_address_id"].(string)),
+				},
+			},
+		})
+	}
+
+	return &results
+}
+
+func flattenArmBastionHostIPConfiguration(input *[]network.BastionHostIPConfiguration) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		subnetId := ""
+		publicIpAddressId := ""
+		if props := item.BastionHostIPConfigurationPropertiesFormat; props != nil {
+			if props.Subnet != nil && props.Subnet.ID != nil {
+				subnetId = *props.Subnet.ID
+			}
+
+			if props.PublicIPAddress != nil && props.PublicIPAddress.ID != nil {
+				publicIpAddressId = *props.PublicIPAddress.ID
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                 name,
+			"subnet_id":            subnetId,
+			"public_ip_address_id": publicIpAddressId,
+		})
+	}
+
+	return results
+}
@@ -4,9 +4,9 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"time"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 
 	"github.com/Azure/azure-sdk-for-go/services/batch/mgmt/2018-12-01/batch"
@@ -14,7 +14,9 @@ import (
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -28,6 +30,13 @@ func resourceArmBatchAccount() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -42,10 +51,11 @@ func resourceArmBatchAccount() *schema.Resource {
 
 			"location": azure.SchemaLocation(),
 			"storage_account_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: azure.ValidateResourceIDOrEmpty,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     azure.ValidateResourceIDOrEmpty,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
 			},
 			"pool_allocation_mode": {
 				Type:     schema.TypeString,
@@ -96,7 +106,8 @@ func resourceArmBatchAccount() *schema.Resource {
 
 func resourceArmBatchAccountCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).batch.AccountClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure Batch account creation.")
 
@@ -107,7 +118,7 @@ func resourceArmBatchAccountCreate(d *schema.ResourceData, meta interface{}) err
 	poolAllocationMode := d.Get("pool_allocation_mode").(string)
 	t := d.Get("tags").(map[string]interface{})
 
-	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+	if meta.(*ArmClient).Features.RequiresImport && d.IsNewResource() {
 		existing, err := client.Get(ctx, resourceGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -174,7 +185,8 @@ func resourceArmBatchAccountCreate(d *schema.ResourceData, meta interface{}) err
 
 func resourceArmBatchAccountRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).batch.AccountClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -224,7 +236,8 @@ func resourceArmBatchAccountRead(d *schema.ResourceData, meta interface{}) error
 
 func resourceArmBatchAccountUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).batch.AccountClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure Batch account update.")
 
@@ -267,7 +280,8 @@ func resourceArmBatchAccountUpdate(d *schema.ResourceData, meta interface{}) err
 
 func resourceArmBatchAccountDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).batch.AccountClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -6,7 +6,6 @@ import (
 	"os"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -43,7 +42,7 @@ func TestValidateBatchAccountName(t *testing.T) {
 func TestAccAzureRMBatchAccount_basic(t *testing.T) {
 	resourceName := "azurerm_batch_account.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	location := testLocation()
 
 	config := testAccAzureRMBatchAccount_basic(ri, rs, location)
@@ -73,7 +72,7 @@ func TestAccAzureRMBatchAccount_requiresImport(t *testing.T) {
 	resourceName := "azurerm_batch_account.test"
 	ri := tf.AccRandTimeInt()
 
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -98,7 +97,7 @@ func TestAccAzureRMBatchAccount_requiresImport(t *testing.T) {
 func TestAccAzureRMBatchAccount_complete(t *testing.T) {
 	resourceName := "azurerm_batch_account.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	location := testLocation()
 
 	config := testAccAzureRMBatchAccount_complete(ri, rs, location)
@@ -135,7 +134,7 @@ func TestAccAzureRMBatchAccount_complete(t *testing.T) {
 func TestAccAzureRMBatchAccount_userSubscription(t *testing.T) {
 	resourceName := "azurerm_batch_account.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	location := testLocation()
 
 	tenantID := os.Getenv("ARM_TENANT_ID")
@@ -5,7 +5,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -15,7 +14,7 @@ import (
 func TestAccAzureRMBatchApplication_basic(t *testing.T) {
 	resourceName := "azurerm_batch_application.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -41,7 +40,7 @@ func TestAccAzureRMBatchApplication_basic(t *testing.T) {
 func TestAccAzureRMBatchApplication_update(t *testing.T) {
 	resourceName := "azurerm_batch_application.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	location := testLocation()
 	displayName := fmt.Sprintf("TestAccDisplayName-%d", ri)
 
@@ -6,7 +6,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -16,7 +15,7 @@ import (
 func TestAccAzureRMBatchCertificate_Pfx(t *testing.T) {
 	resourceName := "azurerm_batch_certificate.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	location := testLocation()
 
 	subscriptionID := os.Getenv("ARM_SUBSCRIPTION_ID")
@@ -50,7 +49,7 @@ func TestAccAzureRMBatchCertificate_Pfx(t *testing.T) {
 
 func TestAccAzureRMBatchCertificate_PfxWithoutPassword(t *testing.T) {
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	location := testLocation()
 
 	config := testAccAzureRMBatchCertificatePfxWithoutPassword(ri, rs, location)
@@ -71,7 +70,7 @@ func TestAccAzureRMBatchCertificate_PfxWithoutPassword(t *testing.T) {
 func TestAccAzureRMBatchCertificate_Cer(t *testing.T) {
 	resourceName := "azurerm_batch_certificate.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	location := testLocation()
 
 	subscriptionID := os.Getenv("ARM_SUBSCRIPTION_ID")
@@ -106,7 +105,7 @@ func TestAccAzureRMBatchCertificate_Cer(t *testing.T) {
 
 func TestAccAzureRMBatchCertificate_CerWithPassword(t *testing.T) {
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	location := testLocation()
 
 	config := testAccAzureRMBatchCertificateCerWithPassword(ri, rs, location)
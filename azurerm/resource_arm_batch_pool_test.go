@@ -10,7 +10,6 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -19,7 +18,7 @@ import (
 func TestAccAzureRMBatchPool_basic(t *testing.T) {
 	resourceName := "azurerm_batch_pool.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -54,7 +53,7 @@ func TestAccAzureRMBatchPool_requiresImport(t *testing.T) {
 
 	resourceName := "azurerm_batch_pool.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -88,7 +87,7 @@ func TestAccAzureRMBatchPool_requiresImport(t *testing.T) {
 func TestAccAzureRMBatchPool_fixedScale_complete(t *testing.T) {
 	resourceName := "azurerm_batch_pool.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -122,7 +121,7 @@ func TestAccAzureRMBatchPool_fixedScale_complete(t *testing.T) {
 func TestAccAzureRMBatchPool_autoScale_complete(t *testing.T) {
 	resourceName := "azurerm_batch_pool.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -153,7 +152,7 @@ func TestAccAzureRMBatchPool_autoScale_complete(t *testing.T) {
 func TestAccAzureRMBatchPool_completeUpdated(t *testing.T) {
 	resourceName := "azurerm_batch_pool.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -203,7 +202,7 @@ func TestAccAzureRMBatchPool_completeUpdated(t *testing.T) {
 func TestAccAzureRMBatchPoolStartTask_basic(t *testing.T) {
 	resourceName := "azurerm_batch_pool.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -244,7 +243,7 @@ func TestAccAzureRMBatchPoolStartTask_basic(t *testing.T) {
 func TestAccAzureRMBatchPool_certificates(t *testing.T) {
 	resourceName := "azurerm_batch_pool.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 
 	subscriptionID := os.Getenv("ARM_SUBSCRIPTION_ID")
 	certificate0ID := fmt.Sprintf("/subscriptions/%s/resourceGroups/testaccbatch%d/providers/Microsoft.Batch/batchAccounts/testaccbatch%s/certificates/sha1-312d31a79fa0cef49c00f769afc2b73e9f4edf34", subscriptionID, ri, rs)
@@ -282,7 +281,7 @@ func TestAccAzureRMBatchPool_certificates(t *testing.T) {
 
 func TestAccAzureRMBatchPool_validateResourceFileWithoutSource(t *testing.T) {
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -300,7 +299,7 @@ func TestAccAzureRMBatchPool_validateResourceFileWithoutSource(t *testing.T) {
 func TestAccAzureRMBatchPool_container(t *testing.T) {
 	resourceName := "azurerm_batch_pool.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -324,7 +323,7 @@ func TestAccAzureRMBatchPool_container(t *testing.T) {
 
 func TestAccAzureRMBatchPool_validateResourceFileWithMultipleSources(t *testing.T) {
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -341,7 +340,7 @@ func TestAccAzureRMBatchPool_validateResourceFileWithMultipleSources(t *testing.
 
 func TestAccAzureRMBatchPool_validateResourceFileBlobPrefixWithoutAutoStorageContainerUrl(t *testing.T) {
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -358,7 +357,7 @@ func TestAccAzureRMBatchPool_validateResourceFileBlobPrefixWithoutAutoStorageCon
 
 func TestAccAzureRMBatchPool_validateResourceFileHttpURLWithoutFilePath(t *testing.T) {
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -376,7 +375,7 @@ func TestAccAzureRMBatchPool_validateResourceFileHttpURLWithoutFilePath(t *testi
 func TestAccAzureRMBatchPool_customImage(t *testing.T) {
 	resourceName := "azurerm_batch_pool.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/botservice/mgmt/2018-07-12/botservice"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -10,7 +11,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -25,6 +26,13 @@ func resourceArmBotChannelSlack() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"resource_group_name": azure.SchemaResourceGroupName(),
 
@@ -67,12 +75,13 @@ func resourceArmBotChannelSlack() *schema.Resource {
 
 func resourceArmBotChannelSlackCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).bot.ChannelClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resourceGroup := d.Get("resource_group_name").(string)
 	botName := d.Get("bot_name").(string)
 
-	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+	if meta.(*ArmClient).Features.RequiresImport && d.IsNewResource() {
 		existing, err := client.Get(ctx, resourceGroup, string(botservice.ChannelNameSlackChannel), botName)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -120,7 +129,8 @@ func resourceArmBotChannelSlackCreate(d *schema.ResourceData, meta interface{})
 
 func resourceArmBotChannelSlackRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).bot.ChannelClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -156,7 +166,8 @@ func resourceArmBotChannelSlackRead(d *schema.ResourceData, meta interface{}) er
 
 func resourceArmBotChannelSlackUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).bot.ChannelClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	botName := d.Get("bot_name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -197,7 +208,8 @@ func resourceArmBotChannelSlackUpdate(d *schema.ResourceData, meta interface{})
 
 func resourceArmBotChannelSlackDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).bot.ChannelClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -2,15 +2,18 @@ package azurerm
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	computeSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 
 	"github.com/hashicorp/terraform/helper/schema"
@@ -35,6 +38,13 @@ Information on migrating to the renamed resource can be found here: https://terr
 As such the existing 'azurerm_connection_monitor' resource is deprecated and will be removed in the next major version of the AzureRM Provider (2.0).
 `,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -75,9 +85,10 @@ As such the existing 'azurerm_connection_monitor' resource is deprecated and wil
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"virtual_machine_id": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: azure.ValidateResourceID,
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     computeSvc.ValidateVirtualMachineID,
+							DiffSuppressFunc: suppress.ResourceIDDifference,
 						},
 						"port": {
 							Type:         schema.TypeInt,
@@ -96,10 +107,11 @@ As such the existing 'azurerm_connection_monitor' resource is deprecated and wil
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"virtual_machine_id": {
-							Type:          schema.TypeString,
-							Optional:      true,
-							ValidateFunc:  azure.ValidateResourceID,
-							ConflictsWith: []string{"destination.0.address"},
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     computeSvc.ValidateVirtualMachineID,
+							DiffSuppressFunc: suppress.ResourceIDDifference,
+							ConflictsWith:    []string{"destination.0.address"},
 						},
 						"address": {
 							Type:          schema.TypeString,
@@ -122,7 +134,8 @@ As such the existing 'azurerm_connection_monitor' resource is deprecated and wil
 
 func resourceArmConnectionMonitorCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).network.ConnectionMonitorsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	watcherName := d.Get("network_watcher_name").(string)
@@ -141,7 +154,7 @@ func resourceArmConnectionMonitorCreateUpdate(d *schema.ResourceData, meta inter
 		return err
 	}
 
-	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+	if meta.(*ArmClient).Features.RequiresImport && d.IsNewResource() {
 		existing, err := client.Get(ctx, resourceGroup, watcherName, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -191,7 +204,8 @@ func resourceArmConnectionMonitorCreateUpdate(d *schema.ResourceData, meta inter
 
 func resourceArmConnectionMonitorRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).network.ConnectionMonitorsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -237,7 +251,8 @@ func resourceArmConnectionMonitorRead(d *schema.ResourceData, meta interface{})
 
 func resourceArmConnectionMonitorDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).network.ConnectionMonitorsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
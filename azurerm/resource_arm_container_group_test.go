@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 
@@ -47,7 +46,7 @@ func TestAccAzureRMContainerGroup_SystemAssignedIdentity(t *testing.T) {
 func TestAccAzureRMContainerGroup_UserAssignedIdentity(t *testing.T) {
 	resourceName := "azurerm_container_group.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(14)
+	rs := tf.AccRandString(14)
 	config := testAccAzureRMContainerGroup_UserAssignedIdentity(ri, testLocation(), rs)
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -75,7 +74,7 @@ func TestAccAzureRMContainerGroup_UserAssignedIdentity(t *testing.T) {
 func TestAccAzureRMContainerGroup_multipleAssignedIdentities(t *testing.T) {
 	resourceName := "azurerm_container_group.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(14)
+	rs := tf.AccRandString(14)
 	config := testAccAzureRMContainerGroup_MultipleAssignedIdentities(ri, testLocation(), rs)
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
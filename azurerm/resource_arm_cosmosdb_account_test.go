@@ -16,7 +16,7 @@ import (
 
 // TODO: refactor the test configs
 
-//consistency
+// consistency
 func TestAccAzureRMCosmosDBAccount_eventualConsistency(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 	resourceName := "azurerm_cosmosdb_account.test"
@@ -217,7 +217,7 @@ func TestAccAzureRMCosmosDBAccount_consistency_change(t *testing.T) {
 	})
 }
 
-//DB kinds
+// DB kinds
 func TestAccAzureRMCosmosDBAccount_mongoDB(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 	resourceName := "azurerm_cosmosdb_account.test"
@@ -452,7 +452,7 @@ func TestAbcAzureRMCosmosDBAccount_updatePropertiesAndLocation(t *testing.T) {
 	})
 }
 
-//replication
+// replication
 func TestAccAzureRMCosmosDBAccount_geoReplicated_customId(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 	resourceName := "azurerm_cosmosdb_account.test"
@@ -585,7 +585,7 @@ func TestAccAzureRMCosmosDBAccount_virtualNetworkFilter(t *testing.T) {
 	})
 }
 
-//basic --> complete (
+// basic --> complete (
 func TestAccAzureRMCosmosDBAccount_complete(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 	resourceName := "azurerm_cosmosdb_account.test"
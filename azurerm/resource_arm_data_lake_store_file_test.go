@@ -8,7 +8,6 @@ import (
 	"os"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -44,7 +43,7 @@ func TestAccAzureRMDataLakeStoreFile_basic(t *testing.T) {
 	resourceName := "azurerm_data_lake_store_file.test"
 
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -70,7 +69,7 @@ func TestAccAzureRMDataLakeStoreFile_basic(t *testing.T) {
 func TestAccAzureRMDataLakeStoreFile_largefiles(t *testing.T) {
 	resourceName := "azurerm_data_lake_store_file.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 
 	//"large" in this context is anything greater than 4 megabytes
 	largeSize := 12 * 1024 * 1024 //12 mb
@@ -120,7 +119,7 @@ func TestAccAzureRMDataLakeStoreFile_requiresimport(t *testing.T) {
 	resourceName := "azurerm_data_lake_store_file.test"
 
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
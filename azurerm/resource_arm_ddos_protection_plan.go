@@ -3,14 +3,16 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	networkSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -23,9 +25,7 @@ func resourceArmDDoSProtectionPlan() *schema.Resource {
 		Update: resourceArmDDoSProtectionPlanCreateUpdate,
 		Delete: resourceArmDDoSProtectionPlanDelete,
 
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: azure.ValidateResourceIDPriorToImport(networkSvc.ValidateDdosProtectionPlanID),
 
 		DeprecationMessage: `The 'azurerm_ddos_protection_plan' resource is deprecated in favour of the renamed version 'azurerm_network_ddos_protection_plan'.
 
@@ -33,6 +33,13 @@ Information on migrating to the renamed resource can be found here: https://terr
 
 As such the existing 'azurerm_ddos_protection_plan' resource is deprecated and will be removed in the next major version of the AzureRM Provider (2.0).
 `,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -59,14 +66,15 @@ As such the existing 'azurerm_ddos_protection_plan' resource is deprecated and w
 
 func resourceArmDDoSProtectionPlanCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).network.DDOSProtectionPlansClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for DDoS protection plan creation")
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+	if meta.(*ArmClient).Features.RequiresImport && d.IsNewResource() {
 		existing, err := client.Get(ctx, resourceGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -87,11 +95,14 @@ func resourceArmDDoSProtectionPlanCreateUpdate(d *schema.ResourceData, meta inte
 		return fmt.Errorf("Error extracting names of Virtual Network: %+v", err)
 	}
 
-	locks.ByName(name, azureDDoSProtectionPlanResourceName)
-	defer locks.UnlockByName(name, azureDDoSProtectionPlanResourceName)
-
-	locks.MultipleByName(vnetsToLock, virtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(vnetsToLock, virtualNetworkResourceName)
+	namesToLock := []locks.NamesOfType{
+		{ResourceType: azureDDoSProtectionPlanResourceName, Names: &[]string{name}},
+		{ResourceType: virtualNetworkResourceName, Names: vnetsToLock},
+	}
+	if err := locks.MultipleByTypeWithContext(ctx, namesToLock...); err != nil {
+		return err
+	}
+	defer locks.UnlockMultipleByType(namesToLock...)
 
 	parameters := network.DdosProtectionPlan{
 		Location: &location,
@@ -123,7 +134,8 @@ func resourceArmDDoSProtectionPlanCreateUpdate(d *schema.ResourceData, meta inte
 
 func resourceArmDDoSProtectionPlanRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).network.DDOSProtectionPlansClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -161,7 +173,8 @@ func resourceArmDDoSProtectionPlanRead(d *schema.ResourceData, meta interface{})
 
 func resourceArmDDoSProtectionPlanDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).network.DDOSProtectionPlansClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -186,11 +199,14 @@ func resourceArmDDoSProtectionPlanDelete(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("Error extracting names of Virtual Network: %+v", err)
 	}
 
-	locks.ByName(name, azureDDoSProtectionPlanResourceName)
-	defer locks.UnlockByName(name, azureDDoSProtectionPlanResourceName)
-
-	locks.MultipleByName(vnetsToLock, virtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(vnetsToLock, virtualNetworkResourceName)
+	namesToLock := []locks.NamesOfType{
+		{ResourceType: azureDDoSProtectionPlanResourceName, Names: &[]string{name}},
+		{ResourceType: virtualNetworkResourceName, Names: vnetsToLock},
+	}
+	if err := locks.MultipleByTypeWithContext(ctx, namesToLock...); err != nil {
+		return err
+	}
+	defer locks.UnlockMultipleByType(namesToLock...)
 
 	future, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
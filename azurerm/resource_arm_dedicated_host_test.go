@@ -0,0 +1,260 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	computeSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMDedicatedHost_basic(t *testing.T) {
+	resourceName := "azurerm_dedicated_host.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDedicatedHostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDedicatedHost_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDedicatedHostExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_replace_on_failure", "true"),
+					resource.TestCheckResourceAttr(resourceName, "license_type", "None"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDedicatedHost_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_dedicated_host.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDedicatedHostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDedicatedHost_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDedicatedHostExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMDedicatedHost_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_dedicated_host"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDedicatedHost_complete(t *testing.T) {
+	resourceName := "azurerm_dedicated_host.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDedicatedHostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDedicatedHost_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDedicatedHostExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_replace_on_failure", "false"),
+					resource.TestCheckResourceAttr(resourceName, "license_type", "Windows_Server_Hybrid"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.ENV", "prod"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDedicatedHost_update(t *testing.T) {
+	resourceName := "azurerm_dedicated_host.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDedicatedHostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDedicatedHost_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDedicatedHostExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_replace_on_failure", "true"),
+					resource.TestCheckResourceAttr(resourceName, "license_type", "None"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMDedicatedHost_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDedicatedHostExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_replace_on_failure", "false"),
+					resource.TestCheckResourceAttr(resourceName, "license_type", "Windows_Server_Hybrid"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMDedicatedHost_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDedicatedHostExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_replace_on_failure", "true"),
+					resource.TestCheckResourceAttr(resourceName, "license_type", "None"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMDedicatedHostExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Dedicated Host not found: %s", resourceName)
+		}
+
+		id, err := computeSvc.ParseDedicatedHostID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).Compute.DedicatedHostsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.HostGroupName, id.Name, ""); err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Dedicated Host %q (Host Group %q / Resource Group %q) does not exist", id.Name, id.HostGroupName, id.ResourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on Compute.DedicatedHostsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMDedicatedHostDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).Compute.DedicatedHostsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_dedicated_host" {
+			continue
+		}
+
+		id, err := computeSvc.ParseDedicatedHostID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.HostGroupName, id.Name, ""); err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Get on Compute.DedicatedHostsClient: %+v", err)
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("Dedicated Host %q (Host Group %q / Resource Group %q) still exists", id.Name, id.HostGroupName, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMDedicatedHost_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dedicated_host_group" "test" {
+  name                        = "acctestDHG-%d"
+  resource_group_name         = "${azurerm_resource_group.test.name}"
+  location                    = "${azurerm_resource_group.test.location}"
+  platform_fault_domain_count = 2
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMDedicatedHost_basic(rInt int, location string) string {
+	template := testAccAzureRMDedicatedHost_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dedicated_host" "test" {
+  name                    = "acctestDH-%d"
+  location                = "${azurerm_resource_group.test.location}"
+  dedicated_host_group_id = "${azurerm_dedicated_host_group.test.id}"
+  sku_name                = "DSv3-Type1"
+  platform_fault_domain   = 1
+}
+`, template, rInt)
+}
+
+func testAccAzureRMDedicatedHost_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMDedicatedHost_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dedicated_host" "import" {
+  name                    = "${azurerm_dedicated_host.test.name}"
+  location                = "${azurerm_dedicated_host.test.location}"
+  dedicated_host_group_id = "${azurerm_dedicated_host.test.dedicated_host_group_id}"
+  sku_name                = "${azurerm_dedicated_host.test.sku_name}"
+  platform_fault_domain   = "${azurerm_dedicated_host.test.platform_fault_domain}"
+}
+`, template)
+}
+
+func testAccAzureRMDedicatedHost_complete(rInt int, location string) string {
+	template := testAccAzureRMDedicatedHost_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dedicated_host" "test" {
+  name                    = "acctestDH-%d"
+  location                = "${azurerm_resource_group.test.location}"
+  dedicated_host_group_id = "${azurerm_dedicated_host_group.test.id}"
+  sku_name                = "DSv3-Type1"
+  platform_fault_domain   = 1
+  auto_replace_on_failure = false
+  license_type            = "Windows_Server_Hybrid"
+
+  tags = {
+    ENV = "prod"
+  }
+}
+`, template, rInt)
+}
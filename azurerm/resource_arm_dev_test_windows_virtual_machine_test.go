@@ -5,15 +5,15 @@ import (
 	"net/http"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 )
 
 func TestAccAzureRMDevTestVirtualMachine_basic(t *testing.T) {
 	resourceName := "azurerm_dev_test_windows_virtual_machine.test"
-	rInt := acctest.RandIntRange(11111, 99999)
+	rInt := tf.AccRandIntRange(11111, 99999)
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -51,7 +51,7 @@ func TestAccAzureRMDevTestVirtualMachine_requiresImport(t *testing.T) {
 	}
 
 	resourceName := "azurerm_dev_test_windows_virtual_machine.test"
-	rInt := acctest.RandIntRange(11111, 99999)
+	rInt := tf.AccRandIntRange(11111, 99999)
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -75,7 +75,7 @@ func TestAccAzureRMDevTestVirtualMachine_requiresImport(t *testing.T) {
 
 func TestAccAzureRMDevTestWindowsVirtualMachine_inboundNatRules(t *testing.T) {
 	resourceName := "azurerm_dev_test_windows_virtual_machine.test"
-	rInt := acctest.RandIntRange(11111, 99999)
+	rInt := tf.AccRandIntRange(11111, 99999)
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -111,7 +111,7 @@ func TestAccAzureRMDevTestWindowsVirtualMachine_inboundNatRules(t *testing.T) {
 
 func TestAccAzureRMDevTestWindowsVirtualMachine_updateStorage(t *testing.T) {
 	resourceName := "azurerm_dev_test_windows_virtual_machine.test"
-	rInt := acctest.RandIntRange(11111, 99999)
+	rInt := tf.AccRandIntRange(11111, 99999)
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
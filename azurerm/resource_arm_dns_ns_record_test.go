@@ -12,7 +12,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 )
 
-//TODO: remove this once we remove the `record` attribute
+// TODO: remove this once we remove the `record` attribute
 func TestAccAzureRMDnsNsRecord_deprecatedBasic(t *testing.T) {
 	resourceName := "azurerm_dns_ns_record.test"
 	ri := tf.AccRandTimeInt()
@@ -87,7 +87,7 @@ func TestAccAzureRMDnsNsRecord_requiresImport(t *testing.T) {
 	})
 }
 
-//TODO: remove this once we remove the `record` attribute
+// TODO: remove this once we remove the `record` attribute
 func TestAccAzureRMDnsNsRecord_deprecatedUpdateRecords(t *testing.T) {
 	resourceName := "azurerm_dns_ns_record.test"
 	ri := tf.AccRandTimeInt()
@@ -148,7 +148,7 @@ func TestAccAzureRMDnsNsRecord_updateRecords(t *testing.T) {
 	})
 }
 
-//TODO: remove this once we remove the `record` attribute
+// TODO: remove this once we remove the `record` attribute
 func TestAccAzureRMDnsNsRecord_deprecatedChangeRecordToRecords(t *testing.T) {
 	resourceName := "azurerm_dns_ns_record.test"
 	ri := tf.AccRandTimeInt()
@@ -179,7 +179,7 @@ func TestAccAzureRMDnsNsRecord_deprecatedChangeRecordToRecords(t *testing.T) {
 	})
 }
 
-//TODO: remove this once we remove the `record` attribute
+// TODO: remove this once we remove the `record` attribute
 func TestAccAzureRMDnsNsRecord_deprecatedWithTags(t *testing.T) {
 	resourceName := "azurerm_dns_ns_record.test"
 	ri := tf.AccRandTimeInt()
@@ -342,7 +342,7 @@ resource "azurerm_dns_ns_record" "import" {
 `, template)
 }
 
-//TODO: remove this once we remove the `record` attribute
+// TODO: remove this once we remove the `record` attribute
 func testAccAzureRMDnsNsRecord_deprecatedBasic(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -372,7 +372,7 @@ resource "azurerm_dns_ns_record" "test" {
 `, rInt, location, rInt, rInt)
 }
 
-//TODO: remove this once we remove the `record` attribute
+// TODO: remove this once we remove the `record` attribute
 func testAccAzureRMDnsNsRecord_deprecatedBasicNewRecords(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -419,7 +419,7 @@ resource "azurerm_dns_ns_record" "test" {
 `, rInt, location, rInt, rInt)
 }
 
-//TODO: remove this once we remove the `record` attribute
+// TODO: remove this once we remove the `record` attribute
 func testAccAzureRMDnsNsRecord_deprecatedUpdateRecords(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -481,7 +481,7 @@ resource "azurerm_dns_ns_record" "test" {
 `, rInt, location, rInt, rInt)
 }
 
-//TODO: remove this once we remove the `record` attribute
+// TODO: remove this once we remove the `record` attribute
 func testAccAzureRMDnsNsRecord_deprecatedWithTags(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -543,7 +543,7 @@ resource "azurerm_dns_ns_record" "test" {
 `, rInt, location, rInt, rInt)
 }
 
-//TODO: remove this once we remove the `record` attribute
+// TODO: remove this once we remove the `record` attribute
 func testAccAzureRMDnsNsRecord_deprecatedWithTagsUpdate(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -6,7 +6,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -16,7 +15,7 @@ import (
 func TestAccAzureRMEventGridEventSubscription_basic(t *testing.T) {
 	resourceName := "azurerm_eventgrid_event_subscription.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 
 	location := testLocation()
 
@@ -79,7 +78,7 @@ func TestAccAzureRMEventGridEventSubscription_eventhub(t *testing.T) {
 func TestAccAzureRMEventGridEventSubscription_update(t *testing.T) {
 	resourceName := "azurerm_eventgrid_event_subscription.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -122,7 +121,7 @@ func TestAccAzureRMEventGridEventSubscription_update(t *testing.T) {
 func TestAccAzureRMEventGridEventSubscription_filter(t *testing.T) {
 	resourceName := "azurerm_eventgrid_event_subscription.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 
 	location := testLocation()
 
@@ -7,7 +7,6 @@ import (
 
 	"strconv"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -305,7 +304,7 @@ func TestAccAzureRMEventHub_standard(t *testing.T) {
 func TestAccAzureRMEventHub_captureDescription(t *testing.T) {
 	resourceName := "azurerm_eventhub.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(5)
+	rs := tf.AccRandString(5)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -332,7 +331,7 @@ func TestAccAzureRMEventHub_captureDescription(t *testing.T) {
 func TestAccAzureRMEventHub_captureDescriptionDisabled(t *testing.T) {
 	resourceName := "azurerm_eventhub.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(5)
+	rs := tf.AccRandString(5)
 	location := testLocation()
 
 	config := testAccAzureRMEventHub_captureDescription(ri, rs, location, true)
@@ -2,14 +2,16 @@ package azurerm
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	networkSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -18,8 +20,13 @@ func resourceArmExpressRouteCircuitAuthorization() *schema.Resource {
 		Create: resourceArmExpressRouteCircuitAuthorizationCreate,
 		Read:   resourceArmExpressRouteCircuitAuthorizationRead,
 		Delete: resourceArmExpressRouteCircuitAuthorizationDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+
+		Importer: azure.ValidateResourceIDPriorToImport(networkSvc.ValidateExpressRouteCircuitAuthorizationID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -53,16 +60,19 @@ func resourceArmExpressRouteCircuitAuthorization() *schema.Resource {
 
 func resourceArmExpressRouteCircuitAuthorizationCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).network.ExpressRouteAuthsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 	circuitName := d.Get("express_route_circuit_name").(string)
 
-	locks.ByName(circuitName, expressRouteCircuitResourceName)
+	if err := locks.ByNameWithContext(ctx, circuitName, expressRouteCircuitResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(circuitName, expressRouteCircuitResourceName)
 
-	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+	if meta.(*ArmClient).Features.RequiresImport && d.IsNewResource() {
 		existing, err := client.Get(ctx, resourceGroup, circuitName, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -100,7 +110,8 @@ func resourceArmExpressRouteCircuitAuthorizationCreate(d *schema.ResourceData, m
 
 func resourceArmExpressRouteCircuitAuthorizationRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).network.ExpressRouteAuthsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -134,7 +145,8 @@ func resourceArmExpressRouteCircuitAuthorizationRead(d *schema.ResourceData, met
 
 func resourceArmExpressRouteCircuitAuthorizationDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).network.ExpressRouteAuthsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -145,7 +157,9 @@ func resourceArmExpressRouteCircuitAuthorizationDelete(d *schema.ResourceData, m
 	circuitName := id.Path["expressRouteCircuits"]
 	name := id.Path["authorizations"]
 
-	locks.ByName(circuitName, expressRouteCircuitResourceName)
+	if err := locks.ByNameWithContext(ctx, circuitName, expressRouteCircuitResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(circuitName, expressRouteCircuitResourceName)
 
 	future, err := client.Delete(ctx, resourceGroup, circuitName, name)
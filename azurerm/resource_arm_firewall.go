@@ -4,15 +4,19 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	networkSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -24,8 +28,14 @@ func resourceArmFirewall() *schema.Resource {
 		Read:   resourceArmFirewallRead,
 		Update: resourceArmFirewallCreateUpdate,
 		Delete: resourceArmFirewallDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+
+		Importer: azure.ValidateResourceIDPriorToImport(networkSvc.ValidateFirewallID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
+			Delete: schema.DefaultTimeout(90 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -43,7 +53,7 @@ func resourceArmFirewall() *schema.Resource {
 			"ip_configuration": {
 				Type:     schema.TypeList,
 				Required: true,
-				MaxItems: 1,
+				MinItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
@@ -52,25 +62,28 @@ func resourceArmFirewall() *schema.Resource {
 							ValidateFunc: validate.NoEmptyStrings,
 						},
 						"subnet_id": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ForceNew:     true,
-							ValidateFunc: validateAzureFirewallSubnetName,
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateFunc:     validateAzureFirewallSubnetName,
+							DiffSuppressFunc: suppress.ResourceIDDifference,
 						},
 						"internal_public_ip_address_id": {
-							Type:          schema.TypeString,
-							Optional:      true,
-							Computed:      true,
-							ValidateFunc:  azure.ValidateResourceID,
-							Deprecated:    "This field has been deprecated. Use `public_ip_address_id` instead.",
-							ConflictsWith: []string{"ip_configuration.0.public_ip_address_id"},
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateFunc:     networkSvc.ValidatePublicIPAddressID,
+							DiffSuppressFunc: suppress.ResourceIDDifference,
+							Deprecated:       "This field has been deprecated. Use `public_ip_address_id` instead.",
+							ConflictsWith:    []string{"ip_configuration.0.public_ip_address_id"},
 						},
 						"public_ip_address_id": {
-							Type:          schema.TypeString,
-							Optional:      true,
-							Computed:      true,
-							ValidateFunc:  azure.ValidateResourceID,
-							ConflictsWith: []string{"ip_configuration.0.internal_public_ip_address_id"},
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateFunc:     networkSvc.ValidatePublicIPAddressID,
+							DiffSuppressFunc: suppress.ResourceIDDifference,
+							ConflictsWith:    []string{"ip_configuration.0.internal_public_ip_address_id"},
 						},
 						"private_ip_address": {
 							Type:     schema.TypeString,
@@ -80,6 +93,26 @@ func resourceArmFirewall() *schema.Resource {
 				},
 			},
 
+			"threat_intel_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(network.AzureFirewallThreatIntelModeAlert),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.AzureFirewallThreatIntelModeAlert),
+					string(network.AzureFirewallThreatIntelModeDeny),
+					string(network.AzureFirewallThreatIntelModeOff),
+				}, false),
+			},
+
+			"zones": azure.SchemaZones(),
+
+			"firewall_policy_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     networkSvc.ValidateFirewallPolicyID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
+			},
+
 			"tags": tags.Schema(),
 		},
 	}
@@ -87,14 +120,15 @@ func resourceArmFirewall() *schema.Resource {
 
 func resourceArmFirewallCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).network.AzureFirewallsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Azure Firewall creation")
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+	if meta.(*ArmClient).Features.RequiresImport && d.IsNewResource() {
 		existing, err := client.Get(ctx, resourceGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -114,21 +148,30 @@ func resourceArmFirewallCreateUpdate(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Error Building list of Azure Firewall IP Configurations: %+v", err)
 	}
 
-	locks.ByName(name, azureFirewallResourceName)
-	defer locks.UnlockByName(name, azureFirewallResourceName)
-
-	locks.MultipleByName(subnetToLock, subnetResourceName)
-	defer locks.UnlockMultipleByName(subnetToLock, subnetResourceName)
-
-	locks.MultipleByName(vnetToLock, virtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(vnetToLock, virtualNetworkResourceName)
+	namesToLock := []locks.NamesOfType{
+		{ResourceType: azureFirewallResourceName, Names: &[]string{name}},
+		{ResourceType: subnetResourceName, Names: subnetToLock},
+		{ResourceType: virtualNetworkResourceName, Names: vnetToLock},
+	}
+	if err := locks.MultipleByTypeWithContext(ctx, namesToLock...); err != nil {
+		return err
+	}
+	defer locks.UnlockMultipleByType(namesToLock...)
 
 	parameters := network.AzureFirewall{
 		Location: &location,
 		Tags:     tags.Expand(t),
 		AzureFirewallPropertiesFormat: &network.AzureFirewallPropertiesFormat{
 			IPConfigurations: ipConfigs,
+			ThreatIntelMode:  network.AzureFirewallThreatIntelMode(d.Get("threat_intel_mode").(string)),
 		},
+		Zones: azure.ExpandZones(d.Get("zones").([]interface{})),
+	}
+
+	if policyId := d.Get("firewall_policy_id").(string); policyId != "" {
+		parameters.AzureFirewallPropertiesFormat.FirewallPolicy = &network.SubResource{
+			ID: utils.String(policyId),
+		}
 	}
 
 	if !d.IsNewResource() {
@@ -173,7 +216,8 @@ func resourceArmFirewallCreateUpdate(d *schema.ResourceData, meta interface{}) e
 
 func resourceArmFirewallRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).network.AzureFirewallsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -204,14 +248,25 @@ func resourceArmFirewallRead(d *schema.ResourceData, meta interface{}) error {
 		if err := d.Set("ip_configuration", ipConfigs); err != nil {
 			return fmt.Errorf("Error setting `ip_configuration`: %+v", err)
 		}
+
+		d.Set("threat_intel_mode", string(props.ThreatIntelMode))
+
+		policyId := ""
+		if policy := props.FirewallPolicy; policy != nil && policy.ID != nil {
+			policyId = azure.NormalizeResourceID(*policy.ID)
+		}
+		d.Set("firewall_policy_id", policyId)
 	}
 
+	d.Set("zones", utils.FlattenStringSlice(read.Zones))
+
 	return tags.FlattenAndSet(d, read.Tags)
 }
 
 func resourceArmFirewallDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).network.AzureFirewallsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -258,14 +313,15 @@ func resourceArmFirewallDelete(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	locks.ByName(name, azureFirewallResourceName)
-	defer locks.UnlockByName(name, azureFirewallResourceName)
-
-	locks.MultipleByName(&subnetNamesToLock, subnetResourceName)
-	defer locks.UnlockMultipleByName(&subnetNamesToLock, subnetResourceName)
-
-	locks.MultipleByName(&virtualNetworkNamesToLock, virtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(&virtualNetworkNamesToLock, virtualNetworkResourceName)
+	namesToLock := []locks.NamesOfType{
+		{ResourceType: azureFirewallResourceName, Names: &[]string{name}},
+		{ResourceType: subnetResourceName, Names: &subnetNamesToLock},
+		{ResourceType: virtualNetworkResourceName, Names: &virtualNetworkNamesToLock},
+	}
+	if err := locks.MultipleByTypeWithContext(ctx, namesToLock...); err != nil {
+		return err
+	}
+	defer locks.UnlockMultipleByType(namesToLock...)
 
 	future, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
@@ -285,7 +341,7 @@ func expandArmFirewallIPConfigurations(d *schema.ResourceData) (*[]network.Azure
 	subnetNamesToLock := make([]string, 0)
 	virtualNetworkNamesToLock := make([]string, 0)
 
-	for _, configRaw := range configs {
+	for i, configRaw := range configs {
 		data := configRaw.(map[string]interface{})
 		name := data["name"].(string)
 		subnetId := data["subnet_id"].(string)
@@ -296,36 +352,48 @@ func expandArmFirewallIPConfigurations(d *schema.ResourceData) (*[]network.Azure
 		}
 
 		if !exist || pubID == "" {
-			return nil, nil, nil, fmt.Errorf("one of `ip_configuration.0.internal_public_ip_address_id` or `ip_configuration.0.public_ip_address_id` must be set")
-		}
-
-		subnetID, err := azure.ParseAzureResourceID(subnetId)
-		if err != nil {
-			return nil, nil, nil, err
-		}
-
-		subnetName := subnetID.Path["subnets"]
-		virtualNetworkName := subnetID.Path["virtualNetworks"]
-
-		if !sliceContainsValue(subnetNamesToLock, subnetName) {
-			subnetNamesToLock = append(subnetNamesToLock, subnetName)
-		}
-
-		if !sliceContainsValue(virtualNetworkNamesToLock, virtualNetworkName) {
-			virtualNetworkNamesToLock = append(virtualNetworkNamesToLock, virtualNetworkName)
+			return nil, nil, nil, fmt.Errorf("one of `ip_configuration.%d.internal_public_ip_address_id` or `ip_configuration.%d.public_ip_address_id` must be set", i, i)
 		}
 
 		ipConfig := network.AzureFirewallIPConfiguration{
 			Name: utils.String(name),
 			AzureFirewallIPConfigurationPropertiesFormat: &network.AzureFirewallIPConfigurationPropertiesFormat{
-				Subnet: &network.SubResource{
-					ID: utils.String(subnetId),
-				},
 				PublicIPAddress: &network.SubResource{
 					ID: utils.String(pubID),
 				},
 			},
 		}
+
+		// only the first IP Configuration is attached to the `AzureFirewallSubnet`,
+		// any additional IP Configurations only associate a Public IP Address
+		if i == 0 {
+			if subnetId == "" {
+				return nil, nil, nil, fmt.Errorf("`ip_configuration.0.subnet_id` must be set")
+			}
+
+			subnetID, err := azure.ParseAzureResourceID(subnetId)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+
+			subnetName := subnetID.Path["subnets"]
+			virtualNetworkName := subnetID.Path["virtualNetworks"]
+
+			if !sliceContainsValue(subnetNamesToLock, subnetName) {
+				subnetNamesToLock = append(subnetNamesToLock, subnetName)
+			}
+
+			if !sliceContainsValue(virtualNetworkNamesToLock, virtualNetworkName) {
+				virtualNetworkNamesToLock = append(virtualNetworkNamesToLock, virtualNetworkName)
+			}
+
+			ipConfig.AzureFirewallIPConfigurationPropertiesFormat.Subnet = &network.SubResource{
+				ID: utils.String(subnetId),
+			}
+		} else if subnetId != "" {
+			return nil, nil, nil, fmt.Errorf("`ip_configuration.%d.subnet_id` cannot be set - only the first `ip_configuration` block can specify a Subnet", i)
+		}
+
 		ipConfigs = append(ipConfigs, ipConfig)
 	}
 	return &ipConfigs, &subnetNamesToLock, &virtualNetworkNamesToLock, nil
@@ -350,7 +418,7 @@ func flattenArmFirewallIPConfigurations(input *[]network.AzureFirewallIPConfigur
 
 		if subnet := props.Subnet; subnet != nil {
 			if id := subnet.ID; id != nil {
-				afIPConfig["subnet_id"] = *id
+				afIPConfig["subnet_id"] = azure.NormalizeResourceID(*id)
 			}
 		}
 
@@ -360,8 +428,9 @@ func flattenArmFirewallIPConfigurations(input *[]network.AzureFirewallIPConfigur
 
 		if pip := props.PublicIPAddress; pip != nil {
 			if id := pip.ID; id != nil {
-				afIPConfig["internal_public_ip_address_id"] = *id
-				afIPConfig["public_ip_address_id"] = *id
+				publicIPAddressID := azure.NormalizeResourceID(*id)
+				afIPConfig["internal_public_ip_address_id"] = publicIPAddressID
+				afIPConfig["public_ip_address_id"] = publicIPAddressID
 			}
 		}
 		result = append(result, afIPConfig)
@@ -306,6 +306,34 @@ func TestAccAzureRMFirewallNetworkRuleCollection_updateFirewallTags(t *testing.T
 	})
 }
 
+func TestAccAzureRMFirewallNetworkRuleCollection_preservesFirewallSettings(t *testing.T) {
+	resourceName := "azurerm_firewall_network_rule_collection.test"
+	firewallResourceName := "azurerm_firewall.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFirewallDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFirewallNetworkRuleCollection_preservesFirewallSettings(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFirewallNetworkRuleCollectionExists(resourceName),
+				),
+			},
+			{
+				// refresh the Firewall to confirm the Rule Collection didn't reset its settings
+				Config: testAccAzureRMFirewallNetworkRuleCollection_preservesFirewallSettings(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(firewallResourceName, "threat_intel_mode", "Deny"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMFirewallNetworkRuleCollectionExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -731,3 +759,38 @@ resource "azurerm_firewall_network_rule_collection" "test" {
 }
 `, template)
 }
+
+func testAccAzureRMFirewallNetworkRuleCollection_preservesFirewallSettings(rInt int, location string) string {
+	template := testAccAzureRMFirewall_withThreatIntelMode(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_network_rule_collection" "test" {
+  name                = "acctestnrc"
+  azure_firewall_name = "${azurerm_firewall.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  priority            = 100
+  action              = "Allow"
+
+  rule {
+    name = "rule1"
+
+    source_addresses = [
+      "10.0.0.0/16",
+    ]
+
+    destination_ports = [
+      "53",
+    ]
+
+    destination_addresses = [
+      "8.8.8.8",
+    ]
+
+    protocols = [
+      "Any",
+    ]
+  }
+}
+`, template)
+}
@@ -0,0 +1,253 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	networkSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+var firewallPolicyResourceName = "azurerm_firewall_policy"
+
+func resourceArmFirewallPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmFirewallPolicyCreateUpdate,
+		Read:   resourceArmFirewallPolicyRead,
+		Update: resourceArmFirewallPolicyCreateUpdate,
+		Delete: resourceArmFirewallPolicyDelete,
+
+		Importer: azure.ValidateResourceIDPriorToImport(networkSvc.ValidateFirewallPolicyID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureFirewallName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"base_policy_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     networkSvc.ValidateFirewallPolicyID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
+			},
+
+			"threat_intelligence_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(network.AzureFirewallThreatIntelModeAlert),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.AzureFirewallThreatIntelModeAlert),
+					string(network.AzureFirewallThreatIntelModeDeny),
+					string(network.AzureFirewallThreatIntelModeOff),
+				}, false),
+			},
+
+			"child_policy_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"firewall_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"rule_collection_group_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceArmFirewallPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network.FirewallPoliciesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if meta.(*ArmClient).Features.RequiresImport && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Firewall Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_firewall_policy", *existing.ID)
+		}
+	}
+
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	t := d.Get("tags").(map[string]interface{})
+
+	parameters := network.FirewallPolicy{
+		Location: utils.String(location),
+		FirewallPolicyPropertiesFormat: &network.FirewallPolicyPropertiesFormat{
+			ThreatIntelMode: network.AzureFirewallThreatIntelMode(d.Get("threat_intelligence_mode").(string)),
+		},
+		Tags: tags.Expand(t),
+	}
+
+	if v, ok := d.GetOk("base_policy_id"); ok {
+		parameters.FirewallPolicyPropertiesFormat.BasePolicy = &network.SubResource{
+			ID: utils.String(v.(string)),
+		}
+	}
+
+	if err := locks.ByNameWithContext(ctx, name, firewallPolicyResourceName); err != nil {
+		return err
+	}
+	defer locks.UnlockByName(name, firewallPolicyResourceName)
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Firewall Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Firewall Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Firewall Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Firewall Policy %q (Resource Group %q) ID", name, resourceGroup)
+	}
+	d.SetId(*resp.ID)
+
+	return resourceArmFirewallPolicyRead(d, meta)
+}
+
+func resourceArmFirewallPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network.FirewallPoliciesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := networkSvc.ParseFirewallPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Firewall Policy %q (Resource Group %q) was not found - removing from state", id.Name, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Firewall Policy %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	if props := resp.FirewallPolicyPropertiesFormat; props != nil {
+		basePolicyId := ""
+		if props.BasePolicy != nil && props.BasePolicy.ID != nil {
+			basePolicyId = azure.NormalizeResourceID(*props.BasePolicy.ID)
+		}
+		d.Set("base_policy_id", basePolicyId)
+		d.Set("threat_intelligence_mode", string(props.ThreatIntelMode))
+
+		if err := d.Set("child_policy_ids", flattenArmFirewallPolicySubResourceIDs(props.ChildPolicies)); err != nil {
+			return fmt.Errorf("Error setting `child_policy_ids`: %+v", err)
+		}
+
+		if err := d.Set("firewall_ids", flattenArmFirewallPolicySubResourceIDs(props.Firewalls)); err != nil {
+			return fmt.Errorf("Error setting `firewall_ids`: %+v", err)
+		}
+
+		if err := d.Set("rule_collection_group_ids", flattenArmFirewallPolicySubResourceIDs(props.RuleGroups)); err != nil {
+			return fmt.Errorf("Error setting `rule_collection_group_ids`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceArmFirewallPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network.FirewallPoliciesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := networkSvc.ParseFirewallPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := locks.ByNameWithContext(ctx, id.Name, firewallPolicyResourceName); err != nil {
+		return err
+	}
+	defer locks.UnlockByName(id.Name, firewallPolicyResourceName)
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Firewall Policy %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Firewall Policy %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func flattenArmFirewallPolicySubResourceIDs(input *[]network.SubResource) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		if item.ID == nil {
+			continue
+		}
+
+		results = append(results, azure.NormalizeResourceID(*item.ID))
+	}
+	return results
+}
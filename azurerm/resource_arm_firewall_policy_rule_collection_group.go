@@ -0,0 +1,707 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	networkSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmFirewallPolicyRuleCollectionGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmFirewallPolicyRuleCollectionGroupCreateUpdate,
+		Read:   resourceArmFirewallPolicyRuleCollectionGroupRead,
+		Update: resourceArmFirewallPolicyRuleCollectionGroupCreateUpdate,
+		Delete: resourceArmFirewallPolicyRuleCollectionGroupDelete,
+
+		Importer: azure.ValidateResourceIDPriorToImport(networkSvc.ValidateFirewallPolicyRuleCollectionGroupID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureFirewallName,
+			},
+
+			"firewall_policy_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     networkSvc.ValidateFirewallPolicyID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
+			},
+
+			"priority": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(100, 65000),
+			},
+
+			"application_rule_collection": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAzureFirewallName,
+						},
+
+						"priority": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(100, 65000),
+						},
+
+						"action": firewallPolicyRuleCollectionActionSchema(),
+
+						"rule": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+
+									"description": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									"protocols": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"type": {
+													Type:     schema.TypeString,
+													Required: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														string(network.FirewallPolicyRuleConditionApplicationProtocolTypeHTTP),
+														string(network.FirewallPolicyRuleConditionApplicationProtocolTypeHTTPS),
+													}, false),
+												},
+
+												"port": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntBetween(0, 64000),
+												},
+											},
+										},
+									},
+
+									"source_addresses": firewallPolicyRuleStringSetSchema(true),
+
+									"destination_addresses": firewallPolicyRuleStringSetSchema(false),
+
+									"destination_fqdns": firewallPolicyRuleStringSetSchema(false),
+
+									"destination_fqdn_tags": firewallPolicyRuleStringSetSchema(false),
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"network_rule_collection": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAzureFirewallName,
+						},
+
+						"priority": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(100, 65000),
+						},
+
+						"action": firewallPolicyRuleCollectionActionSchema(),
+
+						"rule": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+
+									"description": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									"protocols": firewallPolicyNetworkProtocolsSchema(),
+
+									"source_addresses": firewallPolicyRuleStringSetSchema(true),
+
+									"destination_addresses": firewallPolicyRuleStringSetSchema(true),
+
+									"destination_ports": firewallPolicyRuleStringSetSchema(true),
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"nat_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAzureFirewallName,
+						},
+
+						"priority": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(100, 65000),
+						},
+
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"protocols": firewallPolicyNetworkProtocolsSchema(),
+
+						"source_addresses": firewallPolicyRuleStringSetSchema(true),
+
+						"destination_address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"destination_ports": firewallPolicyRuleStringSetSchema(true),
+
+						"translated_address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"translated_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 65535),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func firewallPolicyRuleCollectionActionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			string(network.FirewallPolicyFilterRuleActionTypeAllow),
+			string(network.FirewallPolicyFilterRuleActionTypeDeny),
+		}, false),
+	}
+}
+
+func firewallPolicyNetworkProtocolsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Required: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(network.FirewallPolicyRuleConditionNetworkProtocolAny),
+				string(network.FirewallPolicyRuleConditionNetworkProtocolICMP),
+				string(network.FirewallPolicyRuleConditionNetworkProtocolTCP),
+				string(network.FirewallPolicyRuleConditionNetworkProtocolUDP),
+			}, false),
+		},
+		Set: schema.HashString,
+	}
+}
+
+func firewallPolicyRuleStringSetSchema(required bool) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Required: required,
+		Optional: !required,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validate.NoEmptyStrings,
+		},
+		Set: schema.HashString,
+	}
+}
+
+func resourceArmFirewallPolicyRuleCollectionGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network.FirewallPolicyRuleGroupsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	policyId, err := networkSvc.ParseFirewallPolicyID(d.Get("firewall_policy_id").(string))
+	if err != nil {
+		return err
+	}
+
+	if meta.(*ArmClient).Features.RequiresImport && d.IsNewResource() {
+		existing, err := client.Get(ctx, policyId.ResourceGroup, policyId.Name, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Rule Collection Group %q (Firewall Policy %q / Resource Group %q): %+v", name, policyId.Name, policyId.ResourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_firewall_policy_rule_collection_group", *existing.ID)
+		}
+	}
+
+	rules := make([]network.BasicFirewallPolicyRule, 0)
+	rules = append(rules, expandArmFirewallPolicyApplicationRuleCollections(d.Get("application_rule_collection").([]interface{}))...)
+	rules = append(rules, expandArmFirewallPolicyNetworkRuleCollections(d.Get("network_rule_collection").([]interface{}))...)
+	rules = append(rules, expandArmFirewallPolicyNatRules(d.Get("nat_rule").([]interface{}))...)
+
+	parameters := network.FirewallPolicyRuleGroup{
+		FirewallPolicyRuleGroupProperties: &network.FirewallPolicyRuleGroupProperties{
+			Priority: utils.Int32(int32(d.Get("priority").(int))),
+			Rules:    &rules,
+		},
+	}
+
+	// the Firewall Policy can only process a single change at a time
+	if err := locks.ByNameWithContext(ctx, policyId.Name, firewallPolicyResourceName); err != nil {
+		return err
+	}
+	defer locks.UnlockByName(policyId.Name, firewallPolicyResourceName)
+
+	future, err := client.CreateOrUpdate(ctx, policyId.ResourceGroup, policyId.Name, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Rule Collection Group %q (Firewall Policy %q / Resource Group %q): %+v", name, policyId.Name, policyId.ResourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Rule Collection Group %q (Firewall Policy %q / Resource Group %q): %+v", name, policyId.Name, policyId.ResourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, policyId.ResourceGroup, policyId.Name, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Rule Collection Group %q (Firewall Policy %q / Resource Group %q): %+v", name, policyId.Name, policyId.ResourceGroup, err)
+	}
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Rule Collection Group %q (Firewall Policy %q / Resource Group %q) ID", name, policyId.Name, policyId.ResourceGroup)
+	}
+	d.SetId(*resp.ID)
+
+	return resourceArmFirewallPolicyRuleCollectionGroupRead(d, meta)
+}
+
+func resourceArmFirewallPolicyRuleCollectionGroupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network.FirewallPolicyRuleGroupsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := networkSvc.ParseFirewallPolicyRuleCollectionGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.FirewallPolicyName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Rule Collection Group %q (Firewall Policy %q / Resource Group %q) was not found - removing from state", id.Name, id.FirewallPolicyName, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Rule Collection Group %q (Firewall Policy %q / Resource Group %q): %+v", id.Name, id.FirewallPolicyName, id.ResourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("firewall_policy_id", networkSvc.NewFirewallPolicyID(id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName).String())
+
+	if props := resp.FirewallPolicyRuleGroupProperties; props != nil {
+		d.Set("priority", props.Priority)
+
+		applicationRuleCollections, networkRuleCollections, natRules := flattenArmFirewallPolicyRules(props.Rules)
+		if err := d.Set("application_rule_collection", applicationRuleCollections); err != nil {
+			return fmt.Errorf("Error setting `application_rule_collection`: %+v", err)
+		}
+		if err := d.Set("network_rule_collection", networkRuleCollections); err != nil {
+			return fmt.Errorf("Error setting `network_rule_collection`: %+v", err)
+		}
+		if err := d.Set("nat_rule", natRules); err != nil {
+			return fmt.Errorf("Error setting `nat_rule`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmFirewallPolicyRuleCollectionGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network.FirewallPolicyRuleGroupsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := networkSvc.ParseFirewallPolicyRuleCollectionGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := locks.ByNameWithContext(ctx, id.FirewallPolicyName, firewallPolicyResourceName); err != nil {
+		return err
+	}
+	defer locks.UnlockByName(id.FirewallPolicyName, firewallPolicyResourceName)
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.FirewallPolicyName, id.Name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Rule Collection Group %q (Firewall Policy %q / Resource Group %q): %+v", id.Name, id.FirewallPolicyName, id.ResourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Rule Collection Group %q (Firewall Policy %q / Resource Group %q): %+v", id.Name, id.FirewallPolicyName, id.ResourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandArmFirewallPolicyApplicationRuleCollections(input []interface{}) []network.BasicFirewallPolicyRule {
+	results := make([]network.BasicFirewallPolicyRule, 0)
+	for _, item := range input {
+		collection := item.(map[string]interface{})
+
+		conditions := make([]network.BasicFirewallPolicyRuleCondition, 0)
+		for _, ruleRaw := range collection["rule"].([]interface{}) {
+			rule := ruleRaw.(map[string]interface{})
+
+			protocols := make([]network.FirewallPolicyRuleConditionApplicationProtocol, 0)
+			for _, protocolRaw := range rule["protocols"].([]interface{}) {
+				protocol := protocolRaw.(map[string]interface{})
+				protocols = append(protocols, network.FirewallPolicyRuleConditionApplicationProtocol{
+					ProtocolType: network.FirewallPolicyRuleConditionApplicationProtocolType(protocol["type"].(string)),
+					Port:         utils.Int32(int32(protocol["port"].(int))),
+				})
+			}
+
+			conditions = append(conditions, network.ApplicationRuleCondition{
+				Name:                 utils.String(rule["name"].(string)),
+				Description:          utils.String(rule["description"].(string)),
+				Protocols:            &protocols,
+				SourceAddresses:      utils.ExpandStringSlice(rule["source_addresses"].(*schema.Set).List()),
+				DestinationAddresses: utils.ExpandStringSlice(rule["destination_addresses"].(*schema.Set).List()),
+				TargetFqdns:          utils.ExpandStringSlice(rule["destination_fqdns"].(*schema.Set).List()),
+				FqdnTags:             utils.ExpandStringSlice(rule["destination_fqdn_tags"].(*schema.Set).List()),
+			})
+		}
+
+		results = append(results, network.FirewallPolicyFilterRule{
+			Name:     utils.String(collection["name"].(string)),
+			Priority: utils.Int32(int32(collection["priority"].(int))),
+			Action: &network.FirewallPolicyFilterRuleAction{
+				Type: network.FirewallPolicyFilterRuleActionType(collection["action"].(string)),
+			},
+			RuleConditions: &conditions,
+		})
+	}
+	return results
+}
+
+func expandArmFirewallPolicyNetworkRuleCollections(input []interface{}) []network.BasicFirewallPolicyRule {
+	results := make([]network.BasicFirewallPolicyRule, 0)
+	for _, item := range input {
+		collection := item.(map[string]interface{})
+
+		conditions := make([]network.BasicFirewallPolicyRuleCondition, 0)
+		for _, ruleRaw := range collection["rule"].([]interface{}) {
+			rule := ruleRaw.(map[string]interface{})
+
+			conditions = append(conditions, network.RuleCondition{
+				Name:                 utils.String(rule["name"].(string)),
+				Description:          utils.String(rule["description"].(string)),
+				IPProtocols:          expandArmFirewallPolicyNetworkProtocols(rule["protocols"].(*schema.Set).List()),
+				SourceAddresses:      utils.ExpandStringSlice(rule["source_addresses"].(*schema.Set).List()),
+				DestinationAddresses: utils.ExpandStringSlice(rule["destination_addresses"].(*schema.Set).List()),
+				DestinationPorts:     utils.ExpandStringSlice(rule["destination_ports"].(*schema.Set).List()),
+			})
+		}
+
+		results = append(results, network.FirewallPolicyFilterRule{
+			Name:     utils.String(collection["name"].(string)),
+			Priority: utils.Int32(int32(collection["priority"].(int))),
+			Action: &network.FirewallPolicyFilterRuleAction{
+				Type: network.FirewallPolicyFilterRuleActionType(collection["action"].(string)),
+			},
+			RuleConditions: &conditions,
+		})
+	}
+	return results
+}
+
+func expandArmFirewallPolicyNatRules(input []interface{}) []network.BasicFirewallPolicyRule {
+	results := make([]network.BasicFirewallPolicyRule, 0)
+	for _, item := range input {
+		rule := item.(map[string]interface{})
+		name := rule["name"].(string)
+
+		results = append(results, network.FirewallPolicyNatRule{
+			Name:     utils.String(name),
+			Priority: utils.Int32(int32(rule["priority"].(int))),
+			Action: &network.FirewallPolicyNatRuleAction{
+				Type: network.DNAT,
+			},
+			TranslatedAddress: utils.String(rule["translated_address"].(string)),
+			TranslatedPort:    utils.String(strconv.Itoa(rule["translated_port"].(int))),
+			RuleCondition: network.RuleCondition{
+				Name:                 utils.String(name),
+				Description:          utils.String(rule["description"].(string)),
+				IPProtocols:          expandArmFirewallPolicyNetworkProtocols(rule["protocols"].(*schema.Set).List()),
+				SourceAddresses:      utils.ExpandStringSlice(rule["source_addresses"].(*schema.Set).List()),
+				DestinationAddresses: &[]string{rule["destination_address"].(string)},
+				DestinationPorts:     utils.ExpandStringSlice(rule["destination_ports"].(*schema.Set).List()),
+			},
+		})
+	}
+	return results
+}
+
+func expandArmFirewallPolicyNetworkProtocols(input []interface{}) *[]network.FirewallPolicyRuleConditionNetworkProtocol {
+	results := make([]network.FirewallPolicyRuleConditionNetworkProtocol, 0)
+	for _, item := range input {
+		results = append(results, network.FirewallPolicyRuleConditionNetworkProtocol(item.(string)))
+	}
+	return &results
+}
+
+func flattenArmFirewallPolicyRules(input *[]network.BasicFirewallPolicyRule) ([]interface{}, []interface{}, []interface{}) {
+	applicationRuleCollections := make([]interface{}, 0)
+	networkRuleCollections := make([]interface{}, 0)
+	natRules := make([]interface{}, 0)
+	if input == nil {
+		return applicationRuleCollections, networkRuleCollections, natRules
+	}
+
+	for _, item := range *input {
+		if rule, ok := item.AsFirewallPolicyNatRule(); ok && rule != nil {
+			natRules = append(natRules, flattenArmFirewallPolicyNatRule(rule))
+			continue
+		}
+
+		rule, ok := item.AsFirewallPolicyFilterRule()
+		if !ok || rule == nil {
+			continue
+		}
+
+		name := ""
+		if rule.Name != nil {
+			name = *rule.Name
+		}
+		priority := 0
+		if rule.Priority != nil {
+			priority = int(*rule.Priority)
+		}
+		action := ""
+		if rule.Action != nil {
+			action = string(rule.Action.Type)
+		}
+
+		applicationRules := make([]interface{}, 0)
+		networkRules := make([]interface{}, 0)
+		if rule.RuleConditions != nil {
+			for _, condition := range *rule.RuleConditions {
+				if appCondition, ok := condition.AsApplicationRuleCondition(); ok && appCondition != nil {
+					applicationRules = append(applicationRules, flattenArmFirewallPolicyApplicationRuleCondition(appCondition))
+					continue
+				}
+
+				if networkCondition, ok := condition.AsRuleCondition(); ok && networkCondition != nil {
+					networkRules = append(networkRules, flattenArmFirewallPolicyNetworkRuleCondition(networkCondition))
+				}
+			}
+		}
+
+		if len(applicationRules) > 0 {
+			applicationRuleCollections = append(applicationRuleCollections, map[string]interface{}{
+				"name":     name,
+				"priority": priority,
+				"action":   action,
+				"rule":     applicationRules,
+			})
+		}
+
+		if len(networkRules) > 0 {
+			networkRuleCollections = append(networkRuleCollections, map[string]interface{}{
+				"name":     name,
+				"priority": priority,
+				"action":   action,
+				"rule":     networkRules,
+			})
+		}
+	}
+
+	return applicationRuleCollections, networkRuleCollections, natRules
+}
+
+func flattenArmFirewallPolicyApplicationRuleCondition(input *network.ApplicationRuleCondition) map[string]interface{} {
+	name := ""
+	if input.Name != nil {
+		name = *input.Name
+	}
+	description := ""
+	if input.Description != nil {
+		description = *input.Description
+	}
+
+	protocols := make([]interface{}, 0)
+	if input.Protocols != nil {
+		for _, protocol := range *input.Protocols {
+			port := 0
+			if protocol.Port != nil {
+				port = int(*protocol.Port)
+			}
+			protocols = append(protocols, map[string]interface{}{
+				"type": string(protocol.ProtocolType),
+				"port": port,
+			})
+		}
+	}
+
+	return map[string]interface{}{
+		"name":                  name,
+		"description":           description,
+		"protocols":             protocols,
+		"source_addresses":      schema.NewSet(schema.HashString, utils.FlattenStringSlice(input.SourceAddresses)),
+		"destination_addresses": schema.NewSet(schema.HashString, utils.FlattenStringSlice(input.DestinationAddresses)),
+		"destination_fqdns":     schema.NewSet(schema.HashString, utils.FlattenStringSlice(input.TargetFqdns)),
+		"destination_fqdn_tags": schema.NewSet(schema.HashString, utils.FlattenStringSlice(input.FqdnTags)),
+	}
+}
+
+func flattenArmFirewallPolicyNetworkRuleCondition(input *network.RuleCondition) map[string]interface{} {
+	name := ""
+	if input.Name != nil {
+		name = *input.Name
+	}
+	description := ""
+	if input.Description != nil {
+		description = *input.Description
+	}
+
+	return map[string]interface{}{
+		"name":                  name,
+		"description":           description,
+		"protocols":             schema.NewSet(schema.HashString, flattenArmFirewallPolicyNetworkProtocols(input.IPProtocols)),
+		"source_addresses":      schema.NewSet(schema.HashString, utils.FlattenStringSlice(input.SourceAddresses)),
+		"destination_addresses": schema.NewSet(schema.HashString, utils.FlattenStringSlice(input.DestinationAddresses)),
+		"destination_ports":     schema.NewSet(schema.HashString, utils.FlattenStringSlice(input.DestinationPorts)),
+	}
+}
+
+func flattenArmFirewallPolicyNatRule(input *network.FirewallPolicyNatRule) map[string]interface{} {
+	name := ""
+	if input.Name != nil {
+		name = *input.Name
+	}
+	priority := 0
+	if input.Priority != nil {
+		priority = int(*input.Priority)
+	}
+	translatedAddress := ""
+	if input.TranslatedAddress != nil {
+		translatedAddress = *input.TranslatedAddress
+	}
+	translatedPort := 0
+	if input.TranslatedPort != nil {
+		port, err := strconv.Atoi(*input.TranslatedPort)
+		if err != nil {
+			log.Printf("[DEBUG] Unable to parse Translated Port %q for NAT Rule %q: %+v", *input.TranslatedPort, name, err)
+		}
+		translatedPort = port
+	}
+
+	output := map[string]interface{}{
+		"name":                name,
+		"priority":            priority,
+		"description":         "",
+		"protocols":           schema.NewSet(schema.HashString, []interface{}{}),
+		"source_addresses":    schema.NewSet(schema.HashString, []interface{}{}),
+		"destination_address": "",
+		"destination_ports":   schema.NewSet(schema.HashString, []interface{}{}),
+		"translated_address":  translatedAddress,
+		"translated_port":     translatedPort,
+	}
+
+	if input.RuleCondition == nil {
+		return output
+	}
+
+	if condition, ok := input.RuleCondition.AsRuleCondition(); ok && condition != nil {
+		if condition.Description != nil {
+			output["description"] = *condition.Description
+		}
+		output["protocols"] = schema.NewSet(schema.HashString, flattenArmFirewallPolicyNetworkProtocols(condition.IPProtocols))
+		output["source_addresses"] = schema.NewSet(schema.HashString, utils.FlattenStringSlice(condition.SourceAddresses))
+		output["destination_ports"] = schema.NewSet(schema.HashString, utils.FlattenStringSlice(condition.DestinationPorts))
+		if addresses := condition.DestinationAddresses; addresses != nil && len(*addresses) > 0 {
+			output["destination_address"] = (*addresses)[0]
+		}
+	}
+
+	return output
+}
+
+func flattenArmFirewallPolicyNetworkProtocols(input *[]network.FirewallPolicyRuleConditionNetworkProtocol) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		results = append(results, string(item))
+	}
+	return results
+}
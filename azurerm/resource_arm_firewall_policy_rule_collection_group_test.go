@@ -0,0 +1,327 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	networkSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMFirewallPolicyRuleCollectionGroup_basic(t *testing.T) {
+	resourceName := "azurerm_firewall_policy_rule_collection_group.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFirewallPolicyRuleCollectionGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFirewallPolicyRuleCollectionGroup_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFirewallPolicyRuleCollectionGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "priority", "500"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMFirewallPolicyRuleCollectionGroup_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_firewall_policy_rule_collection_group.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFirewallPolicyRuleCollectionGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFirewallPolicyRuleCollectionGroup_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFirewallPolicyRuleCollectionGroupExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMFirewallPolicyRuleCollectionGroup_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_firewall_policy_rule_collection_group"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMFirewallPolicyRuleCollectionGroup_complete(t *testing.T) {
+	resourceName := "azurerm_firewall_policy_rule_collection_group.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFirewallPolicyRuleCollectionGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFirewallPolicyRuleCollectionGroup_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFirewallPolicyRuleCollectionGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "application_rule_collection.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "application_rule_collection.0.rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "network_rule_collection.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "network_rule_collection.0.rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "nat_rule.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMFirewallPolicyRuleCollectionGroup_update(t *testing.T) {
+	resourceName := "azurerm_firewall_policy_rule_collection_group.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFirewallPolicyRuleCollectionGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFirewallPolicyRuleCollectionGroup_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFirewallPolicyRuleCollectionGroupExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMFirewallPolicyRuleCollectionGroup_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFirewallPolicyRuleCollectionGroupExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMFirewallPolicyRuleCollectionGroup_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFirewallPolicyRuleCollectionGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "nat_rule.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMFirewallPolicyRuleCollectionGroupExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Rule Collection Group not found: %s", resourceName)
+		}
+
+		id, err := networkSvc.ParseFirewallPolicyRuleCollectionGroupID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).network.FirewallPolicyRuleGroupsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.FirewallPolicyName, id.Name); err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Rule Collection Group %q (Firewall Policy %q / Resource Group %q) does not exist", id.Name, id.FirewallPolicyName, id.ResourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on network.FirewallPolicyRuleGroupsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMFirewallPolicyRuleCollectionGroupDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).network.FirewallPolicyRuleGroupsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_firewall_policy_rule_collection_group" {
+			continue
+		}
+
+		id, err := networkSvc.ParseFirewallPolicyRuleCollectionGroupID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.FirewallPolicyName, id.Name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Get on network.FirewallPolicyRuleGroupsClient: %+v", err)
+			}
+			return nil
+		}
+
+		return fmt.Errorf("Rule Collection Group %q (Firewall Policy %q / Resource Group %q) still exists", id.Name, id.FirewallPolicyName, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMFirewallPolicyRuleCollectionGroup_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_firewall_policy" "test" {
+  name                = "acctestfwpolicy-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMFirewallPolicyRuleCollectionGroup_basic(rInt int, location string) string {
+	template := testAccAzureRMFirewallPolicyRuleCollectionGroup_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_rule_collection_group" "test" {
+  name               = "acctestfwpolicyrcg-%d"
+  firewall_policy_id = "${azurerm_firewall_policy.test.id}"
+  priority           = 500
+
+  network_rule_collection {
+    name     = "network_rule_collection1"
+    priority = 400
+    action   = "Deny"
+
+    rule {
+      name                  = "network_rule_collection1_rule1"
+      protocols             = ["TCP", "UDP"]
+      source_addresses      = ["10.0.0.1"]
+      destination_addresses = ["192.168.1.1", "192.168.1.2"]
+      destination_ports     = ["80", "1000-2000"]
+    }
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMFirewallPolicyRuleCollectionGroup_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMFirewallPolicyRuleCollectionGroup_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_rule_collection_group" "import" {
+  name               = "${azurerm_firewall_policy_rule_collection_group.test.name}"
+  firewall_policy_id = "${azurerm_firewall_policy_rule_collection_group.test.firewall_policy_id}"
+  priority           = "${azurerm_firewall_policy_rule_collection_group.test.priority}"
+
+  network_rule_collection {
+    name     = "network_rule_collection1"
+    priority = 400
+    action   = "Deny"
+
+    rule {
+      name                  = "network_rule_collection1_rule1"
+      protocols             = ["TCP", "UDP"]
+      source_addresses      = ["10.0.0.1"]
+      destination_addresses = ["192.168.1.1", "192.168.1.2"]
+      destination_ports     = ["80", "1000-2000"]
+    }
+  }
+}
+`, template)
+}
+
+func testAccAzureRMFirewallPolicyRuleCollectionGroup_complete(rInt int, location string) string {
+	template := testAccAzureRMFirewallPolicyRuleCollectionGroup_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_rule_collection_group" "test" {
+  name               = "acctestfwpolicyrcg-%d"
+  firewall_policy_id = "${azurerm_firewall_policy.test.id}"
+  priority           = 500
+
+  application_rule_collection {
+    name     = "app_rule_collection1"
+    priority = 500
+    action   = "Deny"
+
+    rule {
+      name        = "app_rule_collection1_rule1"
+      description = "deny outbound http to example.com"
+
+      protocols {
+        type = "Http"
+        port = 80
+      }
+
+      protocols {
+        type = "Https"
+        port = 443
+      }
+
+      source_addresses  = ["10.0.0.1"]
+      destination_fqdns = ["*.example.com"]
+    }
+
+    rule {
+      name                  = "app_rule_collection1_rule2"
+      source_addresses      = ["10.0.0.1"]
+      destination_fqdn_tags = ["WindowsDiagnostics"]
+
+      protocols {
+        type = "Https"
+        port = 443
+      }
+    }
+  }
+
+  network_rule_collection {
+    name     = "network_rule_collection1"
+    priority = 400
+    action   = "Deny"
+
+    rule {
+      name                  = "network_rule_collection1_rule1"
+      protocols             = ["TCP", "UDP"]
+      source_addresses      = ["10.0.0.1"]
+      destination_addresses = ["192.168.1.1", "192.168.1.2"]
+      destination_ports     = ["80", "1000-2000"]
+    }
+  }
+
+  nat_rule {
+    name                = "nat_rule1"
+    priority            = 300
+    protocols           = ["TCP"]
+    source_addresses    = ["10.0.0.1", "10.0.0.2"]
+    destination_address = "192.168.1.1"
+    destination_ports   = ["80"]
+    translated_address  = "192.168.0.1"
+    translated_port     = 8080
+  }
+}
+`, template, rInt)
+}
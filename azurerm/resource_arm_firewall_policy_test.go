@@ -0,0 +1,233 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMFirewallPolicy_basic(t *testing.T) {
+	resourceName := "azurerm_firewall_policy.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFirewallPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFirewallPolicy_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFirewallPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "threat_intelligence_mode", "Alert"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMFirewallPolicy_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_firewall_policy.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFirewallPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFirewallPolicy_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFirewallPolicyExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMFirewallPolicy_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_firewall_policy"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMFirewallPolicy_complete(t *testing.T) {
+	resourceName := "azurerm_firewall_policy.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFirewallPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFirewallPolicy_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFirewallPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "threat_intelligence_mode", "Deny"),
+					resource.TestCheckResourceAttrSet(resourceName, "base_policy_id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMFirewallPolicy_update(t *testing.T) {
+	resourceName := "azurerm_firewall_policy.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFirewallPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFirewallPolicy_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFirewallPolicyExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMFirewallPolicy_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFirewallPolicyExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMFirewallPolicy_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFirewallPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "base_policy_id", ""),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMFirewallPolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Firewall Policy not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).network.FirewallPoliciesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		if resp, err := client.Get(ctx, resourceGroup, name, ""); err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Firewall Policy %q (Resource Group %q) does not exist", name, resourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on network.FirewallPoliciesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMFirewallPolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).network.FirewallPoliciesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_firewall_policy" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Get on network.FirewallPoliciesClient: %+v", err)
+			}
+			return nil
+		}
+
+		return fmt.Errorf("Firewall Policy %q (Resource Group %q) still exists", name, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMFirewallPolicy_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_firewall_policy" "test" {
+  name                = "acctestfwpolicy-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMFirewallPolicy_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMFirewallPolicy_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy" "import" {
+  name                = "${azurerm_firewall_policy.test.name}"
+  location            = "${azurerm_firewall_policy.test.location}"
+  resource_group_name = "${azurerm_firewall_policy.test.resource_group_name}"
+}
+`, template)
+}
+
+func testAccAzureRMFirewallPolicy_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_firewall_policy" "parent" {
+  name                = "acctestfwpolicy-parent-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_firewall_policy" "test" {
+  name                     = "acctestfwpolicy-%d"
+  location                 = "${azurerm_resource_group.test.location}"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  base_policy_id           = "${azurerm_firewall_policy.parent.id}"
+  threat_intelligence_mode = "Deny"
+
+  tags = {
+    environment = "Production"
+  }
+}
+`, rInt, location, rInt, rInt)
+}
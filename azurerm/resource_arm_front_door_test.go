@@ -5,7 +5,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -15,7 +14,7 @@ import (
 func TestAccAzureRMFrontDoor_basic(t *testing.T) {
 	resourceName := "azurerm_frontdoor.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(5))
+	rs := strings.ToLower(tf.AccRandString(5))
 	config := testAccAzureRMFrontDoor_basic(ri, rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -70,7 +69,7 @@ func TestAccAzureRMFrontDoor_basic(t *testing.T) {
 func TestAccAzureRMFrontDoor_update(t *testing.T) {
 	resourceName := "azurerm_frontdoor.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(5))
+	rs := strings.ToLower(tf.AccRandString(5))
 	config := testAccAzureRMFrontDoor_basic(ri, rs, testLocation())
 	update := testAccAzureRMFrontDoor_complete(ri, rs, testLocation())
 
@@ -148,7 +147,7 @@ func TestAccAzureRMFrontDoor_update(t *testing.T) {
 func TestAccAzureRMFrontDoor_complete(t *testing.T) {
 	resourceName := "azurerm_frontdoor.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(5))
+	rs := strings.ToLower(tf.AccRandString(5))
 	config := testAccAzureRMFrontDoor_complete(ri, rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -211,7 +210,7 @@ func TestAccAzureRMFrontDoor_complete(t *testing.T) {
 func TestAccAzureRMFrontDoor_waf(t *testing.T) {
 	resourceName := "azurerm_frontdoor.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(5))
+	rs := strings.ToLower(tf.AccRandString(5))
 	config := testAccAzureRMFrontDoor_waf(ri, rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
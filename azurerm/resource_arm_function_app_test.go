@@ -9,7 +9,6 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -19,7 +18,7 @@ import (
 func TestAccAzureRMFunctionApp_basic(t *testing.T) {
 	resourceName := "azurerm_function_app.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	config := testAccAzureRMFunctionApp_basic(ri, rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -56,7 +55,7 @@ func TestAccAzureRMFunctionApp_requiresImport(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 
 	location := testLocation()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -81,7 +80,7 @@ func TestAccAzureRMFunctionApp_requiresImport(t *testing.T) {
 func TestAccAzureRMFunctionApp_tags(t *testing.T) {
 	resourceName := "azurerm_function_app.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	config := testAccAzureRMFunctionApp_tags(ri, rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -109,7 +108,7 @@ func TestAccAzureRMFunctionApp_tags(t *testing.T) {
 func TestAccAzureRMFunctionApp_tagsUpdate(t *testing.T) {
 	resourceName := "azurerm_function_app.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	config := testAccAzureRMFunctionApp_tags(ri, rs, testLocation())
 	updatedConfig := testAccAzureRMFunctionApp_tagsUpdated(ri, rs, testLocation())
 
@@ -142,7 +141,7 @@ func TestAccAzureRMFunctionApp_tagsUpdate(t *testing.T) {
 func TestAccAzureRMFunctionApp_appSettings(t *testing.T) {
 	resourceName := "azurerm_function_app.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	config := testAccAzureRMFunctionApp_basic(ri, rs, testLocation())
 	updatedConfig := testAccAzureRMFunctionApp_appSettings(ri, rs, testLocation())
 
@@ -186,7 +185,7 @@ func TestAccAzureRMFunctionApp_appSettings(t *testing.T) {
 func TestAccAzureRMFunctionApp_siteConfig(t *testing.T) {
 	resourceName := "azurerm_function_app.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	config := testAccAzureRMFunctionApp_alwaysOn(ri, rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -213,7 +212,7 @@ func TestAccAzureRMFunctionApp_siteConfig(t *testing.T) {
 func TestAccAzureRMFunctionApp_linuxFxVersion(t *testing.T) {
 	resourceName := "azurerm_function_app.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	config := testAccAzureRMFunctionApp_linuxFxVersion(ri, rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -241,7 +240,7 @@ func TestAccAzureRMFunctionApp_linuxFxVersion(t *testing.T) {
 func TestAccAzureRMFunctionApp_connectionStrings(t *testing.T) {
 	resourceName := "azurerm_function_app.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	config := testAccAzureRMFunctionApp_connectionStrings(ri, rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -270,7 +269,7 @@ func TestAccAzureRMFunctionApp_connectionStrings(t *testing.T) {
 func TestAccAzureRMFunctionApp_siteConfigMulti(t *testing.T) {
 	resourceName := "azurerm_function_app.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	configBase := testAccAzureRMFunctionApp_basic(ri, rs, testLocation())
 	configUpdate1 := testAccAzureRMFunctionApp_appSettings(ri, rs, testLocation())
 	configUpdate2 := testAccAzureRMFunctionApp_appSettingsAlwaysOn(ri, rs, testLocation())
@@ -338,7 +337,7 @@ func TestAccAzureRMFunctionApp_siteConfigMulti(t *testing.T) {
 func TestAccAzureRMFunctionApp_updateVersion(t *testing.T) {
 	resourceName := "azurerm_function_app.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	preConfig := testAccAzureRMFunctionApp_version(ri, rs, testLocation(), "~1")
 	postConfig := testAccAzureRMFunctionApp_version(ri, rs, testLocation(), "~2")
 
@@ -368,7 +367,7 @@ func TestAccAzureRMFunctionApp_updateVersion(t *testing.T) {
 func TestAccAzureRMFunctionApp_3264bit(t *testing.T) {
 	resourceName := "azurerm_function_app.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	location := testLocation()
 	config := testAccAzureRMFunctionApp_basic(ri, rs, location)
 	updatedConfig := testAccAzureRMFunctionApp_64bit(ri, rs, location)
@@ -399,7 +398,7 @@ func TestAccAzureRMFunctionApp_3264bit(t *testing.T) {
 func TestAccAzureRMFunctionApp_httpsOnly(t *testing.T) {
 	resourceName := "azurerm_function_app.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	location := testLocation()
 	config := testAccAzureRMFunctionApp_httpsOnly(ri, rs, location)
 
@@ -422,7 +421,7 @@ func TestAccAzureRMFunctionApp_httpsOnly(t *testing.T) {
 func TestAccAzureRMFunctionApp_consumptionPlan(t *testing.T) {
 	resourceName := "azurerm_function_app.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	location := testLocation()
 	config := testAccAzureRMFunctionApp_consumptionPlan(ri, rs, location)
 
@@ -446,7 +445,7 @@ func TestAccAzureRMFunctionApp_consumptionPlan(t *testing.T) {
 func TestAccAzureRMFunctionApp_consumptionPlanUppercaseName(t *testing.T) {
 	resourceName := "azurerm_function_app.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	location := testLocation()
 	config := testAccAzureRMFunctionApp_consumptionPlanUppercaseName(ri, rs, location)
 
@@ -475,7 +474,7 @@ func TestAccAzureRMFunctionApp_consumptionPlanUppercaseName(t *testing.T) {
 func TestAccAzureRMFunctionApp_createIdentity(t *testing.T) {
 	resourceName := "azurerm_function_app.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	config := testAccAzureRMFunctionApp_basicIdentity(ri, rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -500,7 +499,7 @@ func TestAccAzureRMFunctionApp_createIdentity(t *testing.T) {
 func TestAccAzureRMFunctionApp_updateIdentity(t *testing.T) {
 	resourceName := "azurerm_function_app.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 
 	preConfig := testAccAzureRMFunctionApp_basic(ri, rs, testLocation())
 	postConfig := testAccAzureRMFunctionApp_basicIdentity(ri, rs, testLocation())
@@ -534,7 +533,7 @@ func TestAccAzureRMFunctionApp_updateIdentity(t *testing.T) {
 func TestAccAzureRMFunctionApp_loggingDisabled(t *testing.T) {
 	resourceName := "azurerm_function_app.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	config := testAccAzureRMFunctionApp_loggingDisabled(ri, rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -562,7 +561,7 @@ func TestAccAzureRMFunctionApp_loggingDisabled(t *testing.T) {
 func TestAccAzureRMFunctionApp_updateLogging(t *testing.T) {
 	resourceName := "azurerm_function_app.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	location := testLocation()
 
 	enabledConfig := testAccAzureRMFunctionApp_basic(ri, rs, location)
@@ -601,7 +600,7 @@ func TestAccAzureRMFunctionApp_updateLogging(t *testing.T) {
 func TestAccAzureRMFunctionApp_authSettings(t *testing.T) {
 	resourceName := "azurerm_function_app.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	tenantID := os.Getenv("ARM_TENANT_ID")
 	config := testAccAzureRMFunctionApp_authSettings(ri, rs, testLocation(), tenantID)
 
@@ -640,7 +639,7 @@ func TestAccAzureRMFunctionApp_authSettings(t *testing.T) {
 func TestAccAzureRMFunctionApp_corsSettings(t *testing.T) {
 	resourceName := "azurerm_function_app.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	config := testAccAzureRMFunctionApp_corsSettings(ri, rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -669,8 +668,8 @@ func TestAccAzureRMFunctionApp_corsSettings(t *testing.T) {
 func TestAccAzureRMFunctionApp_vnetName(t *testing.T) {
 	resourceName := "azurerm_function_app.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
-	vnetName := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
+	vnetName := strings.ToLower(tf.AccRandString(11))
 	config := testAccAzureRMFunctionApp_vnetName(ri, rs, testLocation(), vnetName)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -5,7 +5,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
@@ -14,7 +13,7 @@ import (
 func TestAccAzureRMHDInsightHBaseCluster_basic(t *testing.T) {
 	resourceName := "azurerm_hdinsight_hbase_cluster.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -56,7 +55,7 @@ func TestAccAzureRMHDInsightHBaseCluster_requiresImport(t *testing.T) {
 
 	resourceName := "azurerm_hdinsight_hbase_cluster.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -83,7 +82,7 @@ func TestAccAzureRMHDInsightHBaseCluster_requiresImport(t *testing.T) {
 func TestAccAzureRMHDInsightHBaseCluster_update(t *testing.T) {
 	resourceName := "azurerm_hdinsight_hbase_cluster.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -142,7 +141,7 @@ func TestAccAzureRMHDInsightHBaseCluster_update(t *testing.T) {
 func TestAccAzureRMHDInsightHBaseCluster_sshKeys(t *testing.T) {
 	resourceName := "azurerm_hdinsight_hbase_cluster.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -179,7 +178,7 @@ func TestAccAzureRMHDInsightHBaseCluster_sshKeys(t *testing.T) {
 func TestAccAzureRMHDInsightHBaseCluster_virtualNetwork(t *testing.T) {
 	resourceName := "azurerm_hdinsight_hbase_cluster.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -216,7 +215,7 @@ func TestAccAzureRMHDInsightHBaseCluster_virtualNetwork(t *testing.T) {
 func TestAccAzureRMHDInsightHBaseCluster_complete(t *testing.T) {
 	resourceName := "azurerm_hdinsight_hbase_cluster.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
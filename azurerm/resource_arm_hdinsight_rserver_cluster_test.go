@@ -5,7 +5,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
@@ -14,7 +13,7 @@ import (
 func TestAccAzureRMHDInsightRServerCluster_basic(t *testing.T) {
 	resourceName := "azurerm_hdinsight_rserver_cluster.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -59,7 +58,7 @@ func TestAccAzureRMHDInsightRServerCluster_requiresImport(t *testing.T) {
 
 	resourceName := "azurerm_hdinsight_rserver_cluster.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -87,7 +86,7 @@ func TestAccAzureRMHDInsightRServerCluster_requiresImport(t *testing.T) {
 func TestAccAzureRMHDInsightRServerCluster_update(t *testing.T) {
 	resourceName := "azurerm_hdinsight_rserver_cluster.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -152,7 +151,7 @@ func TestAccAzureRMHDInsightRServerCluster_update(t *testing.T) {
 func TestAccAzureRMHDInsightRServerCluster_sshKeys(t *testing.T) {
 	resourceName := "azurerm_hdinsight_rserver_cluster.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -192,7 +191,7 @@ func TestAccAzureRMHDInsightRServerCluster_sshKeys(t *testing.T) {
 func TestAccAzureRMHDInsightRServerCluster_virtualNetwork(t *testing.T) {
 	resourceName := "azurerm_hdinsight_rserver_cluster.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -232,7 +231,7 @@ func TestAccAzureRMHDInsightRServerCluster_virtualNetwork(t *testing.T) {
 func TestAccAzureRMHDInsightRServerCluster_complete(t *testing.T) {
 	resourceName := "azurerm_hdinsight_rserver_cluster.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
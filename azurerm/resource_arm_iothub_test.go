@@ -5,7 +5,6 @@ import (
 	"net/http"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -116,7 +115,7 @@ func TestAccAzureRMIotHub_standard(t *testing.T) {
 func TestAccAzureRMIotHub_customRoutes(t *testing.T) {
 	resourceName := "azurerm_iothub.test"
 	rInt := tf.AccRandTimeInt()
-	rStr := acctest.RandString(5)
+	rStr := tf.AccRandString(5)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -145,7 +144,7 @@ func TestAccAzureRMIotHub_customRoutes(t *testing.T) {
 func TestAccAzureRMIotHub_fileUpload(t *testing.T) {
 	resourceName := "azurerm_iothub.test"
 	rInt := tf.AccRandTimeInt()
-	rStr := acctest.RandString(5)
+	rStr := tf.AccRandString(5)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -17,9 +17,9 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/set"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -44,6 +44,15 @@ func resourceArmKeyVault() *schema.Resource {
 		MigrateState:  resourceAzureRMKeyVaultMigrateState,
 		SchemaVersion: 1,
 
+		CustomizeDiff: resourceArmKeyVaultCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -145,6 +154,16 @@ func resourceArmKeyVault() *schema.Resource {
 				Optional: true,
 			},
 
+			"soft_delete_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"purge_protection_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
 			"network_acls": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -188,9 +207,31 @@ func resourceArmKeyVault() *schema.Resource {
 	}
 }
 
+func resourceArmKeyVaultCustomizeDiff(diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Get("purge_protection_enabled").(bool) && !diff.Get("soft_delete_enabled").(bool) {
+		return fmt.Errorf("`soft_delete_enabled` must be set to `true` when `purge_protection_enabled` is enabled")
+	}
+
+	// once enabled, neither Soft Delete or Purge Protection can be disabled
+	if diff.HasChange("soft_delete_enabled") {
+		if old, new := diff.GetChange("soft_delete_enabled"); old.(bool) && !new.(bool) {
+			return fmt.Errorf("once Soft Delete has been enabled on a Key Vault it cannot be disabled")
+		}
+	}
+
+	if diff.HasChange("purge_protection_enabled") {
+		if old, new := diff.GetChange("purge_protection_enabled"); old.(bool) && !new.(bool) {
+			return fmt.Errorf("once Purge Protection has been enabled on a Key Vault it cannot be disabled")
+		}
+	}
+
+	return nil
+}
+
 func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).keyvault.VaultsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	// Remove in 2.0
 	var sku keyvault.Sku
@@ -220,7 +261,7 @@ func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) e
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+	if meta.(*ArmClient).Features.RequiresImport && d.IsNewResource() {
 		existing, err := client.Get(ctx, resourceGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -238,8 +279,34 @@ func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) e
 	enabledForDeployment := d.Get("enabled_for_deployment").(bool)
 	enabledForDiskEncryption := d.Get("enabled_for_disk_encryption").(bool)
 	enabledForTemplateDeployment := d.Get("enabled_for_template_deployment").(bool)
+	softDeleteEnabled := d.Get("soft_delete_enabled").(bool)
+	purgeProtectionEnabled := d.Get("purge_protection_enabled").(bool)
 	t := d.Get("tags").(map[string]interface{})
 
+	// a soft-deleted Key Vault with the same name (in this location) prevents a new Key Vault from being created
+	recoverSoftDeletedKeyVault := false
+	if d.IsNewResource() {
+		deleted, err := client.GetDeleted(ctx, name, location)
+		if err != nil {
+			if !utils.ResponseWasNotFound(deleted.Response) {
+				return fmt.Errorf("Error checking for the presence of an existing soft-deleted Key Vault %q (Location %q): %+v", name, location, err)
+			}
+		}
+
+		if deleted.ID != nil && *deleted.ID != "" {
+			if !meta.(*ArmClient).Features.KeyVault.RecoverSoftDeletedKeyVaults {
+				return fmt.Errorf(`A soft-deleted Key Vault with the Name %q exists in the Location %q.
+
+This Key Vault either needs to be recovered or purged before a new Key Vault with this name can be created - alternatively
+the Key Vault can be recovered automatically by setting "recover_soft_deleted_key_vaults" to "true" in the "key_vault"
+block within the "features" block in the Provider configuration.`, name, location)
+			}
+
+			log.Printf("[DEBUG] Recovering the soft-deleted Key Vault %q (Location %q)", name, location)
+			recoverSoftDeletedKeyVault = true
+		}
+	}
+
 	networkAclsRaw := d.Get("network_acls").([]interface{})
 	networkAcls, subnetIds := expandKeyVaultNetworkAcls(networkAclsRaw)
 
@@ -263,12 +330,21 @@ func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) e
 		Tags: tags.Expand(t),
 	}
 
-	// Locking this resource so we don't make modifications to it at the same time if there is a
-	// key vault access policy trying to update it as well
-	locks.ByName(name, keyVaultResourceName)
-	defer locks.UnlockByName(name, keyVaultResourceName)
+	// these can only be set to `true`
+	if softDeleteEnabled {
+		parameters.Properties.EnableSoftDelete = utils.Bool(softDeleteEnabled)
+	}
+	if purgeProtectionEnabled {
+		parameters.Properties.EnablePurgeProtection = utils.Bool(purgeProtectionEnabled)
+	}
+
+	if recoverSoftDeletedKeyVault {
+		parameters.Properties.CreateMode = keyvault.CreateModeRecover
+	}
 
-	// also lock on the Virtual Network ID's since modifications in the networking stack are exclusive
+	// Locking this resource so we don't make modifications to it at the same time if there is a
+	// key vault access policy trying to update it as well - and also lock on the Virtual Network ID's
+	// since modifications in the networking stack are exclusive
 	virtualNetworkNames := make([]string, 0)
 	for _, v := range subnetIds {
 		id, err2 := azure.ParseAzureResourceID(v)
@@ -282,13 +358,24 @@ func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	locks.MultipleByName(&virtualNetworkNames, virtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(&virtualNetworkNames, virtualNetworkResourceName)
+	namesToLock := []locks.NamesOfType{
+		{ResourceType: keyVaultResourceName, Names: &[]string{name}},
+		{ResourceType: virtualNetworkResourceName, Names: &virtualNetworkNames},
+	}
+	if err := locks.MultipleByTypeWithContext(ctx, namesToLock...); err != nil {
+		return err
+	}
+	defer locks.UnlockMultipleByType(namesToLock...)
 
-	if _, err = client.CreateOrUpdate(ctx, resourceGroup, name, parameters); err != nil {
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
+	if err != nil {
 		return fmt.Errorf("Error updating Key Vault %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Key Vault %q (Resource Group %q) to finish updating: %+v", name, resourceGroup, err)
+	}
+
 	read, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
 		return fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %+v", name, resourceGroup, err)
@@ -325,7 +412,8 @@ func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) e
 
 func resourceArmKeyVaultRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).keyvault.VaultsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -356,6 +444,8 @@ func resourceArmKeyVaultRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("enabled_for_disk_encryption", props.EnabledForDiskEncryption)
 		d.Set("enabled_for_template_deployment", props.EnabledForTemplateDeployment)
 		d.Set("vault_uri", props.VaultURI)
+		d.Set("soft_delete_enabled", props.EnableSoftDelete)
+		d.Set("purge_protection_enabled", props.EnablePurgeProtection)
 
 		if sku := props.Sku; sku != nil {
 			// Remove in 2.0
@@ -385,7 +475,8 @@ func resourceArmKeyVaultRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceArmKeyVaultDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).keyvault.VaultsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -394,9 +485,6 @@ func resourceArmKeyVaultDelete(d *schema.ResourceData, meta interface{}) error {
 	resourceGroup := id.ResourceGroup
 	name := id.Path["vaults"]
 
-	locks.ByName(name, keyVaultResourceName)
-	defer locks.UnlockByName(name, keyVaultResourceName)
-
 	read, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
 		if utils.ResponseWasNotFound(read.Response) {
@@ -430,8 +518,14 @@ func resourceArmKeyVaultDelete(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	locks.MultipleByName(&virtualNetworkNames, virtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(&virtualNetworkNames, virtualNetworkResourceName)
+	namesToLock := []locks.NamesOfType{
+		{ResourceType: keyVaultResourceName, Names: &[]string{name}},
+		{ResourceType: virtualNetworkResourceName, Names: &virtualNetworkNames},
+	}
+	if err := locks.MultipleByTypeWithContext(ctx, namesToLock...); err != nil {
+		return err
+	}
+	defer locks.UnlockMultipleByType(namesToLock...)
 
 	resp, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
@@ -440,6 +534,36 @@ func resourceArmKeyVaultDelete(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	// when Soft Delete is enabled the Key Vault is retained (in a deleted state) until it's purged
+	if !meta.(*ArmClient).Features.KeyVault.PurgeSoftDeleteOnDestroy {
+		return nil
+	}
+
+	props := read.Properties
+	if props == nil || props.EnableSoftDelete == nil || !*props.EnableSoftDelete {
+		return nil
+	}
+
+	if props.EnablePurgeProtection != nil && *props.EnablePurgeProtection {
+		log.Printf("[DEBUG] Purge Protection is enabled for Key Vault %q (Resource Group %q) - it'll be purged by Azure once the retention period has elapsed", name, resourceGroup)
+		return nil
+	}
+
+	if read.Location == nil {
+		return fmt.Errorf("Error purging Key Vault %q (Resource Group %q): `location` was nil", name, resourceGroup)
+	}
+	location := azure.NormalizeLocation(*read.Location)
+
+	log.Printf("[DEBUG] Purging the soft-deleted Key Vault %q (Location %q)", name, location)
+	future, err := client.PurgeDeleted(ctx, name, location)
+	if err != nil {
+		return fmt.Errorf("Error purging the soft-deleted Key Vault %q (Location %q): %+v", name, location, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for the soft-deleted Key Vault %q (Location %q) to be purged: %+v", name, location, err)
+	}
+
 	return nil
 }
 
@@ -5,17 +5,17 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func TestAccAzureRMKeyVaultAccessPolicy_basic(t *testing.T) {
 	resourceName := "azurerm_key_vault_access_policy.test"
-	rs := acctest.RandString(6)
+	rs := tf.AccRandString(6)
 	config := testAccAzureRMKeyVaultAccessPolicy_basic(rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -43,7 +43,7 @@ func TestAccAzureRMKeyVaultAccessPolicy_basic(t *testing.T) {
 
 func TestAccAzureRMKeyVaultAccessPolicy_basicClassic(t *testing.T) {
 	resourceName := "azurerm_key_vault_access_policy.test"
-	rs := acctest.RandString(6)
+	rs := tf.AccRandString(6)
 	config := testAccAzureRMKeyVaultAccessPolicy_basicClassic(rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -76,7 +76,7 @@ func TestAccAzureRMKeyVaultAccessPolicy_requiresImport(t *testing.T) {
 	}
 
 	resourceName := "azurerm_key_vault_access_policy.test"
-	rs := acctest.RandString(6)
+	rs := tf.AccRandString(6)
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -104,7 +104,7 @@ func TestAccAzureRMKeyVaultAccessPolicy_requiresImport(t *testing.T) {
 func TestAccAzureRMKeyVaultAccessPolicy_multiple(t *testing.T) {
 	resourceName1 := "azurerm_key_vault_access_policy.test_with_application_id"
 	resourceName2 := "azurerm_key_vault_access_policy.test_no_application_id"
-	rs := acctest.RandString(6)
+	rs := tf.AccRandString(6)
 	config := testAccAzureRMKeyVaultAccessPolicy_multiple(rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -147,7 +147,7 @@ func TestAccAzureRMKeyVaultAccessPolicy_multiple(t *testing.T) {
 }
 
 func TestAccAzureRMKeyVaultAccessPolicy_update(t *testing.T) {
-	rs := acctest.RandString(6)
+	rs := tf.AccRandString(6)
 	resourceName := "azurerm_key_vault_access_policy.test"
 	preConfig := testAccAzureRMKeyVaultAccessPolicy_basic(rs, testLocation())
 	postConfig := testAccAzureRMKeyVaultAccessPolicy_update(rs, testLocation())
@@ -179,7 +179,7 @@ func TestAccAzureRMKeyVaultAccessPolicy_update(t *testing.T) {
 }
 
 func TestAccAzureRMKeyVaultAccessPolicy_nonExistentVault(t *testing.T) {
-	rs := acctest.RandString(6)
+	rs := tf.AccRandString(6)
 	config := testAccAzureRMKeyVaultAccessPolicy_nonExistentVault(rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
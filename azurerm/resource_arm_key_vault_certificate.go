@@ -10,18 +10,20 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
-//todo refactor and find a home for this wayward func
+// todo refactor and find a home for this wayward func
 func resourceArmKeyVaultChildResourceImporter(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	client := meta.(*ArmClient).keyvault.VaultsClient
 	ctx := meta.(*ArmClient).StopContext
@@ -52,6 +54,12 @@ func resourceArmKeyVaultCertificate() *schema.Resource {
 			State: resourceArmKeyVaultChildResourceImporter,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -61,12 +69,13 @@ func resourceArmKeyVaultCertificate() *schema.Resource {
 			},
 
 			"key_vault_id": {
-				Type:          schema.TypeString,
-				Optional:      true, //todo required in 2.0
-				Computed:      true, //todo removed in 2.0
-				ForceNew:      true,
-				ValidateFunc:  azure.ValidateResourceID,
-				ConflictsWith: []string{"vault_uri"},
+				Type:             schema.TypeString,
+				Optional:         true, //todo required in 2.0
+				Computed:         true, //todo removed in 2.0
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
+				ConflictsWith:    []string{"vault_uri"},
 			},
 
 			//todo remove in 2.0
@@ -339,7 +348,8 @@ func resourceArmKeyVaultCertificate() *schema.Resource {
 func resourceArmKeyVaultCertificateCreate(d *schema.ResourceData, meta interface{}) error {
 	vaultClient := meta.(*ArmClient).keyvault.VaultsClient
 	client := meta.(*ArmClient).keyvault.ManagementClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	keyVaultId := d.Get("key_vault_id").(string)
@@ -364,7 +374,7 @@ func resourceArmKeyVaultCertificateCreate(d *schema.ResourceData, meta interface
 		d.Set("key_vault_id", id)
 	}
 
-	if features.ShouldResourcesBeImported() {
+	if meta.(*ArmClient).Features.RequiresImport {
 		existing, err := client.GetCertificate(ctx, keyVaultBaseUrl, name, "")
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -380,7 +390,9 @@ func resourceArmKeyVaultCertificateCreate(d *schema.ResourceData, meta interface
 	t := d.Get("tags").(map[string]interface{})
 	policy := expandKeyVaultCertificatePolicy(d)
 
-	if v, ok := d.GetOk("certificate"); ok {
+	var create func() (autorest.Response, error)
+	v, importing := d.GetOk("certificate")
+	if importing {
 		// Import
 		certificate := expandKeyVaultCertificate(v)
 		importParameters := keyvault.CertificateImportParameters{
@@ -389,8 +401,9 @@ func resourceArmKeyVaultCertificateCreate(d *schema.ResourceData, meta interface
 			CertificatePolicy:        &policy,
 			Tags:                     tags.Expand(t),
 		}
-		if _, err := client.ImportCertificate(ctx, keyVaultBaseUrl, name, importParameters); err != nil {
-			return err
+		create = func() (autorest.Response, error) {
+			resp, err := client.ImportCertificate(ctx, keyVaultBaseUrl, name, importParameters)
+			return resp.Response, err
 		}
 	} else {
 		// Generate new
@@ -398,10 +411,40 @@ func resourceArmKeyVaultCertificateCreate(d *schema.ResourceData, meta interface
 			CertificatePolicy: &policy,
 			Tags:              tags.Expand(t),
 		}
-		if _, err := client.CreateCertificate(ctx, keyVaultBaseUrl, name, parameters); err != nil {
+		create = func() (autorest.Response, error) {
+			resp, err := client.CreateCertificate(ctx, keyVaultBaseUrl, name, parameters)
+			return resp.Response, err
+		}
+	}
+
+	if resp, err := create(); err != nil {
+		if !azure.KeyVaultChildIsDeletedButRecoverable(resp, err) {
 			return err
 		}
 
+		if !meta.(*ArmClient).Features.KeyVault.RecoverSoftDeletedKeyVaultObjects {
+			return azure.KeyVaultChildSoftDeletedError("Certificate", name, keyVaultBaseUrl)
+		}
+
+		recover := func() error {
+			_, err := client.RecoverDeletedCertificate(ctx, keyVaultBaseUrl, name)
+			return err
+		}
+		get := func() (autorest.Response, error) {
+			resp, err := client.GetCertificate(ctx, keyVaultBaseUrl, name, "")
+			return resp.Response, err
+		}
+		if err := azure.RecoverKeyVaultChild(ctx, "Certificate", name, keyVaultBaseUrl, recover, get); err != nil {
+			return err
+		}
+
+		// create a new version of the recovered Certificate so that it matches the configuration
+		if _, err := create(); err != nil {
+			return fmt.Errorf("Error updating the recovered Certificate %q (Key Vault %q): %+v", name, keyVaultBaseUrl, err)
+		}
+	}
+
+	if !importing {
 		log.Printf("[DEBUG] Waiting for Key Vault Certificate %q in Vault %q to be provisioned", name, keyVaultBaseUrl)
 		stateConf := &resource.StateChangeConf{
 			Pending:    []string{"Provisioning"},
@@ -443,7 +486,8 @@ func keyVaultCertificateCreationRefreshFunc(ctx context.Context, client *keyvaul
 func resourceArmKeyVaultCertificateRead(d *schema.ResourceData, meta interface{}) error {
 	keyVaultClient := meta.(*ArmClient).keyvault.VaultsClient
 	client := meta.(*ArmClient).keyvault.ManagementClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseKeyVaultChildID(d.Id())
 	if err != nil {
@@ -511,7 +555,8 @@ func resourceArmKeyVaultCertificateRead(d *schema.ResourceData, meta interface{}
 func resourceArmKeyVaultCertificateDelete(d *schema.ResourceData, meta interface{}) error {
 	keyVaultClient := meta.(*ArmClient).keyvault.VaultsClient
 	client := meta.(*ArmClient).keyvault.ManagementClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseKeyVaultChildID(d.Id())
 	if err != nil {
@@ -545,6 +590,24 @@ func resourceArmKeyVaultCertificateDelete(d *schema.ResourceData, meta interface
 		return fmt.Errorf("Error deleting Certificate %q from Key Vault: %+v", id.Name, err)
 	}
 
+	if meta.(*ArmClient).Features.KeyVault.PurgeSoftDeleteOnDestroy && resp.RecoveryID != nil {
+		if resp.Attributes == nil || resp.Attributes.RecoveryLevel != keyvault.RecoverablePurgeable {
+			log.Printf("[DEBUG] Certificate %q (Key Vault %q) can't be purged - skipping", id.Name, id.KeyVaultBaseUrl)
+			return nil
+		}
+
+		getDeleted := func() (autorest.Response, error) {
+			resp, err := client.GetDeletedCertificate(ctx, id.KeyVaultBaseUrl, id.Name)
+			return resp.Response, err
+		}
+		purge := func() (autorest.Response, error) {
+			return client.PurgeDeletedCertificate(ctx, id.KeyVaultBaseUrl, id.Name)
+		}
+		if err := azure.PurgeKeyVaultChild(ctx, "Certificate", id.Name, id.KeyVaultBaseUrl, getDeleted, purge); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -8,15 +8,15 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func TestAccAzureRMKeyVaultCertificate_basicImportPFX(t *testing.T) {
 	resourceName := "azurerm_key_vault_certificate.test"
-	rs := acctest.RandString(6)
+	rs := tf.AccRandString(6)
 	config := testAccAzureRMKeyVaultCertificate_basicImportPFX(rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -43,7 +43,7 @@ func TestAccAzureRMKeyVaultCertificate_basicImportPFX(t *testing.T) {
 
 func TestAccAzureRMKeyVaultCertificate_basicImportPFXClassic(t *testing.T) {
 	resourceName := "azurerm_key_vault_certificate.test"
-	rs := acctest.RandString(6)
+	rs := tf.AccRandString(6)
 	config := testAccAzureRMKeyVaultCertificate_basicImportPFXClassic(rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -75,7 +75,7 @@ func TestAccAzureRMKeyVaultCertificate_requiresImport(t *testing.T) {
 	}
 
 	resourceName := "azurerm_key_vault_certificate.test"
-	rs := acctest.RandString(6)
+	rs := tf.AccRandString(6)
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -100,7 +100,7 @@ func TestAccAzureRMKeyVaultCertificate_requiresImport(t *testing.T) {
 
 func TestAccAzureRMKeyVaultCertificate_disappears(t *testing.T) {
 	resourceName := "azurerm_key_vault_certificate.test"
-	rs := acctest.RandString(6)
+	rs := tf.AccRandString(6)
 	config := testAccAzureRMKeyVaultCertificate_basicGenerate(rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -121,7 +121,7 @@ func TestAccAzureRMKeyVaultCertificate_disappears(t *testing.T) {
 }
 
 func TestAccAzureRMKeyVaultCertificate_disappearsWhenParentKeyVaultDeleted(t *testing.T) {
-	rs := acctest.RandString(6)
+	rs := tf.AccRandString(6)
 	config := testAccAzureRMKeyVaultCertificate_basicGenerate(rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -143,7 +143,7 @@ func TestAccAzureRMKeyVaultCertificate_disappearsWhenParentKeyVaultDeleted(t *te
 
 func TestAccAzureRMKeyVaultCertificate_basicGenerate(t *testing.T) {
 	resourceName := "azurerm_key_vault_certificate.test"
-	rs := acctest.RandString(6)
+	rs := tf.AccRandString(6)
 	config := testAccAzureRMKeyVaultCertificate_basicGenerate(rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -170,7 +170,7 @@ func TestAccAzureRMKeyVaultCertificate_basicGenerate(t *testing.T) {
 
 func TestAccAzureRMKeyVaultCertificate_basicGenerateSans(t *testing.T) {
 	resourceName := "azurerm_key_vault_certificate.test"
-	rs := acctest.RandString(6)
+	rs := tf.AccRandString(6)
 	config := testAccAzureRMKeyVaultCertificate_basicGenerateSans(rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -194,7 +194,7 @@ func TestAccAzureRMKeyVaultCertificate_basicGenerateSans(t *testing.T) {
 
 func TestAccAzureRMKeyVaultCertificate_basicGenerateTags(t *testing.T) {
 	resourceName := "azurerm_key_vault_certificate.test"
-	rs := acctest.RandString(6)
+	rs := tf.AccRandString(6)
 	config := testAccAzureRMKeyVaultCertificate_basicGenerateTags(rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -222,7 +222,7 @@ func TestAccAzureRMKeyVaultCertificate_basicGenerateTags(t *testing.T) {
 
 func TestAccAzureRMKeyVaultCertificate_basicExtendedKeyUsage(t *testing.T) {
 	resourceName := "azurerm_key_vault_certificate.test"
-	rs := acctest.RandString(6)
+	rs := tf.AccRandString(6)
 	config := testAccAzureRMKeyVaultCertificate_basicExtendedKeyUsage(rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -247,7 +247,7 @@ func TestAccAzureRMKeyVaultCertificate_basicExtendedKeyUsage(t *testing.T) {
 
 func TestAccAzureRMKeyVaultCertificate_emptyExtendedKeyUsage(t *testing.T) {
 	resourceName := "azurerm_key_vault_certificate.test"
-	rs := acctest.RandString(6)
+	rs := tf.AccRandString(6)
 	config := testAccAzureRMKeyVaultCertificate_emptyExtendedKeyUsage(rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -267,6 +267,38 @@ func TestAccAzureRMKeyVaultCertificate_emptyExtendedKeyUsage(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMKeyVaultCertificate_softDeleteRecovery(t *testing.T) {
+	resourceName := "azurerm_key_vault_certificate.test"
+	rs := tf.AccRandString(6)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKeyVaultCertificate_softDelete(rs, location, false, "CN=hello-world"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultCertificateExists(resourceName),
+				),
+			},
+			{
+				// removing the Certificate (without purging it) leaves it soft-deleted
+				Config: testAccAzureRMKeyVault_softDeleteObjectsTemplate(rs, location, false),
+			},
+			{
+				// which is then recovered and updated when the Certificate is re-created
+				Config: testAccAzureRMKeyVaultCertificate_softDelete(rs, location, true, "CN=recovered"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultCertificateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "certificate_policy.0.x509_certificate_properties.0.subject", "CN=recovered"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMKeyVaultCertificateDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*ArmClient).keyvault.ManagementClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
@@ -1010,3 +1042,38 @@ resource "azurerm_key_vault_certificate" "test" {
 }
 `, rString, location, rString, rString)
 }
+
+func testAccAzureRMKeyVaultCertificate_softDelete(rString string, location string, recover bool, subject string) string {
+	template := testAccAzureRMKeyVault_softDeleteObjectsTemplate(rString, location, recover)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_certificate" "test" {
+  name         = "acctestcert%s"
+  key_vault_id = "${azurerm_key_vault.test.id}"
+
+  certificate_policy {
+    issuer_parameters {
+      name = "Self"
+    }
+
+    key_properties {
+      exportable = true
+      key_size   = 2048
+      key_type   = "RSA"
+      reuse_key  = true
+    }
+
+    secret_properties {
+      content_type = "application/x-pkcs12"
+    }
+
+    x509_certificate_properties {
+      key_usage          = ["digitalSignature", "keyEncipherment"]
+      subject            = "%s"
+      validity_in_months = 12
+    }
+  }
+}
+`, template, rString, subject)
+}
@@ -4,15 +4,18 @@ import (
 	"encoding/base64"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -26,6 +29,13 @@ func resourceArmKeyVaultKey() *schema.Resource {
 			State: resourceArmKeyVaultChildResourceImporter,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -35,12 +45,13 @@ func resourceArmKeyVaultKey() *schema.Resource {
 			},
 
 			"key_vault_id": {
-				Type:          schema.TypeString,
-				Optional:      true, //todo required in 2.0
-				Computed:      true, //todo removed in 2.0
-				ForceNew:      true,
-				ValidateFunc:  azure.ValidateResourceID,
-				ConflictsWith: []string{"vault_uri"},
+				Type:             schema.TypeString,
+				Optional:         true, //todo required in 2.0
+				Computed:         true, //todo removed in 2.0
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
+				ConflictsWith:    []string{"vault_uri"},
 			},
 
 			//todo remove in 2.0
@@ -146,7 +157,8 @@ func resourceArmKeyVaultKey() *schema.Resource {
 func resourceArmKeyVaultKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	vaultClient := meta.(*ArmClient).keyvault.VaultsClient
 	client := meta.(*ArmClient).keyvault.ManagementClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Print("[INFO] preparing arguments for AzureRM KeyVault Key creation.")
 	name := d.Get("name").(string)
@@ -172,7 +184,7 @@ func resourceArmKeyVaultKeyCreate(d *schema.ResourceData, meta interface{}) erro
 		d.Set("key_vault_id", id)
 	}
 
-	if features.ShouldResourcesBeImported() {
+	if meta.(*ArmClient).Features.RequiresImport {
 		existing, err := client.GetKey(ctx, keyVaultBaseUri, name, "")
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -214,8 +226,31 @@ func resourceArmKeyVaultKeyCreate(d *schema.ResourceData, meta interface{}) erro
 	// TODO: support `oct` once this is fixed
 	// https://github.com/Azure/azure-rest-api-specs/issues/1739#issuecomment-332236257
 
-	if _, err := client.CreateKey(ctx, keyVaultBaseUri, name, parameters); err != nil {
-		return fmt.Errorf("Error Creating Key: %+v", err)
+	if resp, err := client.CreateKey(ctx, keyVaultBaseUri, name, parameters); err != nil {
+		if !azure.KeyVaultChildIsDeletedButRecoverable(resp.Response, err) {
+			return fmt.Errorf("Error Creating Key: %+v", err)
+		}
+
+		if !meta.(*ArmClient).Features.KeyVault.RecoverSoftDeletedKeyVaultObjects {
+			return azure.KeyVaultChildSoftDeletedError("Key", name, keyVaultBaseUri)
+		}
+
+		recover := func() error {
+			_, err := client.RecoverDeletedKey(ctx, keyVaultBaseUri, name)
+			return err
+		}
+		get := func() (autorest.Response, error) {
+			resp, err := client.GetKey(ctx, keyVaultBaseUri, name, "")
+			return resp.Response, err
+		}
+		if err := azure.RecoverKeyVaultChild(ctx, "Key", name, keyVaultBaseUri, recover, get); err != nil {
+			return err
+		}
+
+		// create a new version of the recovered Key so that it matches the configuration
+		if _, err := client.CreateKey(ctx, keyVaultBaseUri, name, parameters); err != nil {
+			return fmt.Errorf("Error updating the recovered Key %q (Key Vault %q): %+v", name, keyVaultBaseUri, err)
+		}
 	}
 
 	// "" indicates the latest version
@@ -232,7 +267,8 @@ func resourceArmKeyVaultKeyCreate(d *schema.ResourceData, meta interface{}) erro
 func resourceArmKeyVaultKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 	vaultClient := meta.(*ArmClient).keyvault.VaultsClient
 	client := meta.(*ArmClient).keyvault.ManagementClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseKeyVaultChildID(d.Id())
 	if err != nil {
@@ -278,7 +314,8 @@ func resourceArmKeyVaultKeyUpdate(d *schema.ResourceData, meta interface{}) erro
 func resourceArmKeyVaultKeyRead(d *schema.ResourceData, meta interface{}) error {
 	keyVaultClient := meta.(*ArmClient).keyvault.VaultsClient
 	client := meta.(*ArmClient).keyvault.ManagementClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseKeyVaultChildID(d.Id())
 	if err != nil {
@@ -350,7 +387,8 @@ func resourceArmKeyVaultKeyRead(d *schema.ResourceData, meta interface{}) error
 func resourceArmKeyVaultKeyDelete(d *schema.ResourceData, meta interface{}) error {
 	keyVaultClient := meta.(*ArmClient).keyvault.VaultsClient
 	client := meta.(*ArmClient).keyvault.ManagementClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseKeyVaultChildID(d.Id())
 	if err != nil {
@@ -375,8 +413,30 @@ func resourceArmKeyVaultKeyDelete(d *schema.ResourceData, meta interface{}) erro
 		return nil
 	}
 
-	_, err = client.DeleteKey(ctx, id.KeyVaultBaseUrl, id.Name)
-	return err
+	deleted, err := client.DeleteKey(ctx, id.KeyVaultBaseUrl, id.Name)
+	if err != nil {
+		return err
+	}
+
+	if meta.(*ArmClient).Features.KeyVault.PurgeSoftDeleteOnDestroy && deleted.RecoveryID != nil {
+		if deleted.Attributes == nil || deleted.Attributes.RecoveryLevel != keyvault.RecoverablePurgeable {
+			log.Printf("[DEBUG] Key %q (Key Vault %q) can't be purged - skipping", id.Name, id.KeyVaultBaseUrl)
+			return nil
+		}
+
+		getDeleted := func() (autorest.Response, error) {
+			resp, err := client.GetDeletedKey(ctx, id.KeyVaultBaseUrl, id.Name)
+			return resp.Response, err
+		}
+		purge := func() (autorest.Response, error) {
+			return client.PurgeDeletedKey(ctx, id.KeyVaultBaseUrl, id.Name)
+		}
+		if err := azure.PurgeKeyVaultChild(ctx, "Key", id.Name, id.KeyVaultBaseUrl, getDeleted, purge); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func expandKeyVaultKeyOptions(d *schema.ResourceData) *[]keyvault.JSONWebKeyOperation {
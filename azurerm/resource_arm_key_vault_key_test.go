@@ -8,15 +8,15 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func TestAccAzureRMKeyVaultKey_basicEC(t *testing.T) {
 	resourceName := "azurerm_key_vault_key.test"
-	rs := acctest.RandString(6)
+	rs := tf.AccRandString(6)
 	config := testAccAzureRMKeyVaultKey_basicEC(rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -42,7 +42,7 @@ func TestAccAzureRMKeyVaultKey_basicEC(t *testing.T) {
 
 func TestAccAzureRMKeyVaultKey_basicECClassic(t *testing.T) {
 	resourceName := "azurerm_key_vault_key.test"
-	rs := acctest.RandString(6)
+	rs := tf.AccRandString(6)
 	config := testAccAzureRMKeyVaultKey_basicECClassic(rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -73,7 +73,7 @@ func TestAccAzureRMKeyVaultKey_requiresImport(t *testing.T) {
 	}
 
 	resourceName := "azurerm_key_vault_key.test"
-	rs := acctest.RandString(6)
+	rs := tf.AccRandString(6)
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -97,7 +97,7 @@ func TestAccAzureRMKeyVaultKey_requiresImport(t *testing.T) {
 
 func TestAccAzureRMKeyVaultKey_basicECHSM(t *testing.T) {
 	resourceName := "azurerm_key_vault_key.test"
-	rs := acctest.RandString(6)
+	rs := tf.AccRandString(6)
 	config := testAccAzureRMKeyVaultKey_basicECHSM(rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -117,7 +117,7 @@ func TestAccAzureRMKeyVaultKey_basicECHSM(t *testing.T) {
 
 func TestAccAzureRMKeyVaultKey_curveEC(t *testing.T) {
 	resourceName := "azurerm_key_vault_key.test"
-	rs := acctest.RandString(6)
+	rs := tf.AccRandString(6)
 	config := testAccAzureRMKeyVaultKey_curveEC(rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -142,7 +142,7 @@ func TestAccAzureRMKeyVaultKey_curveEC(t *testing.T) {
 
 func TestAccAzureRMKeyVaultKey_basicRSA(t *testing.T) {
 	resourceName := "azurerm_key_vault_key.test"
-	rs := acctest.RandString(6)
+	rs := tf.AccRandString(6)
 	config := testAccAzureRMKeyVaultKey_basicRSA(rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -168,7 +168,7 @@ func TestAccAzureRMKeyVaultKey_basicRSA(t *testing.T) {
 
 func TestAccAzureRMKeyVaultKey_basicRSAHSM(t *testing.T) {
 	resourceName := "azurerm_key_vault_key.test"
-	rs := acctest.RandString(6)
+	rs := tf.AccRandString(6)
 	config := testAccAzureRMKeyVaultKey_basicRSAHSM(rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -194,7 +194,7 @@ func TestAccAzureRMKeyVaultKey_basicRSAHSM(t *testing.T) {
 
 func TestAccAzureRMKeyVaultKey_complete(t *testing.T) {
 	resourceName := "azurerm_key_vault_key.test"
-	rs := acctest.RandString(6)
+	rs := tf.AccRandString(6)
 	config := testAccAzureRMKeyVaultKey_complete(rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -222,7 +222,7 @@ func TestAccAzureRMKeyVaultKey_complete(t *testing.T) {
 
 func TestAccAzureRMKeyVaultKey_update(t *testing.T) {
 	resourceName := "azurerm_key_vault_key.test"
-	rs := acctest.RandString(6)
+	rs := tf.AccRandString(6)
 	config := testAccAzureRMKeyVaultKey_basicRSA(rs, testLocation())
 	updatedConfig := testAccAzureRMKeyVaultKey_basicUpdated(rs, testLocation())
 
@@ -253,7 +253,7 @@ func TestAccAzureRMKeyVaultKey_update(t *testing.T) {
 
 func TestAccAzureRMKeyVaultKey_disappears(t *testing.T) {
 	resourceName := "azurerm_key_vault_key.test"
-	rs := acctest.RandString(6)
+	rs := tf.AccRandString(6)
 	config := testAccAzureRMKeyVaultKey_basicEC(rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -274,7 +274,7 @@ func TestAccAzureRMKeyVaultKey_disappears(t *testing.T) {
 }
 
 func TestAccAzureRMKeyVaultKey_disappearsWhenParentKeyVaultDeleted(t *testing.T) {
-	rs := acctest.RandString(6)
+	rs := tf.AccRandString(6)
 	config := testAccAzureRMKeyVaultKey_basicEC(rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -294,6 +294,38 @@ func TestAccAzureRMKeyVaultKey_disappearsWhenParentKeyVaultDeleted(t *testing.T)
 	})
 }
 
+func TestAccAzureRMKeyVaultKey_softDeleteRecovery(t *testing.T) {
+	resourceName := "azurerm_key_vault_key.test"
+	rs := tf.AccRandString(6)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKeyVaultKey_softDelete(rs, location, false, 2048),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultKeyExists(resourceName),
+				),
+			},
+			{
+				// removing the Key (without purging it) leaves it soft-deleted
+				Config: testAccAzureRMKeyVault_softDeleteObjectsTemplate(rs, location, false),
+			},
+			{
+				// which is then recovered and updated when the Key is re-created
+				Config: testAccAzureRMKeyVaultKey_softDelete(rs, location, true, 4096),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "key_size", "4096"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMKeyVaultKeyDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*ArmClient).keyvault.ManagementClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
@@ -871,3 +903,18 @@ resource "azurerm_key_vault_key" "test" {
 }
 `, rString, location, rString, rString)
 }
+
+func testAccAzureRMKeyVaultKey_softDelete(rString string, location string, recover bool, keySize int) string {
+	template := testAccAzureRMKeyVault_softDeleteObjectsTemplate(rString, location, recover)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_key" "test" {
+  name         = "key-%s"
+  key_vault_id = "${azurerm_key_vault.test.id}"
+  key_type     = "RSA"
+  key_size     = %d
+  key_opts     = ["decrypt", "encrypt"]
+}
+`, template, rString, keySize)
+}
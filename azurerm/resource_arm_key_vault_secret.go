@@ -3,14 +3,17 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -24,6 +27,13 @@ func resourceArmKeyVaultSecret() *schema.Resource {
 			State: resourceArmKeyVaultChildResourceImporter,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -33,12 +43,13 @@ func resourceArmKeyVaultSecret() *schema.Resource {
 			},
 
 			"key_vault_id": {
-				Type:          schema.TypeString,
-				Optional:      true, //todo required in 2.0
-				Computed:      true, //todo removed in 2.0
-				ForceNew:      true,
-				ValidateFunc:  azure.ValidateResourceID,
-				ConflictsWith: []string{"vault_uri"},
+				Type:             schema.TypeString,
+				Optional:         true, //todo required in 2.0
+				Computed:         true, //todo removed in 2.0
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
+				ConflictsWith:    []string{"vault_uri"},
 			},
 
 			//todo remove in 2.0
@@ -76,7 +87,8 @@ func resourceArmKeyVaultSecret() *schema.Resource {
 func resourceArmKeyVaultSecretCreate(d *schema.ResourceData, meta interface{}) error {
 	vaultClient := meta.(*ArmClient).keyvault.VaultsClient
 	client := meta.(*ArmClient).keyvault.ManagementClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Print("[INFO] preparing arguments for AzureRM KeyVault Secret creation.")
 
@@ -103,7 +115,7 @@ func resourceArmKeyVaultSecretCreate(d *schema.ResourceData, meta interface{}) e
 		d.Set("key_vault_id", id)
 	}
 
-	if features.ShouldResourcesBeImported() {
+	if meta.(*ArmClient).Features.RequiresImport {
 		existing, err := client.GetSecret(ctx, keyVaultBaseUrl, name, "")
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -126,8 +138,31 @@ func resourceArmKeyVaultSecretCreate(d *schema.ResourceData, meta interface{}) e
 		Tags:        tags.Expand(t),
 	}
 
-	if _, err := client.SetSecret(ctx, keyVaultBaseUrl, name, parameters); err != nil {
-		return err
+	if resp, err := client.SetSecret(ctx, keyVaultBaseUrl, name, parameters); err != nil {
+		if !azure.KeyVaultChildIsDeletedButRecoverable(resp.Response, err) {
+			return err
+		}
+
+		if !meta.(*ArmClient).Features.KeyVault.RecoverSoftDeletedKeyVaultObjects {
+			return azure.KeyVaultChildSoftDeletedError("Secret", name, keyVaultBaseUrl)
+		}
+
+		recover := func() error {
+			_, err := client.RecoverDeletedSecret(ctx, keyVaultBaseUrl, name)
+			return err
+		}
+		get := func() (autorest.Response, error) {
+			resp, err := client.GetSecret(ctx, keyVaultBaseUrl, name, "")
+			return resp.Response, err
+		}
+		if err := azure.RecoverKeyVaultChild(ctx, "Secret", name, keyVaultBaseUrl, recover, get); err != nil {
+			return err
+		}
+
+		// the recovered Secret retains its previous value, so we set it again to match the configuration
+		if _, err := client.SetSecret(ctx, keyVaultBaseUrl, name, parameters); err != nil {
+			return fmt.Errorf("Error updating the recovered Secret %q (Key Vault %q): %+v", name, keyVaultBaseUrl, err)
+		}
 	}
 
 	// "" indicates the latest version
@@ -147,7 +182,8 @@ func resourceArmKeyVaultSecretCreate(d *schema.ResourceData, meta interface{}) e
 func resourceArmKeyVaultSecretUpdate(d *schema.ResourceData, meta interface{}) error {
 	keyVaultClient := meta.(*ArmClient).keyvault.VaultsClient
 	client := meta.(*ArmClient).keyvault.ManagementClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Print("[INFO] preparing arguments for AzureRM KeyVault Secret update.")
 
 	id, err := azure.ParseKeyVaultChildID(d.Id())
@@ -218,7 +254,8 @@ func resourceArmKeyVaultSecretUpdate(d *schema.ResourceData, meta interface{}) e
 func resourceArmKeyVaultSecretRead(d *schema.ResourceData, meta interface{}) error {
 	keyVaultClient := meta.(*ArmClient).keyvault.VaultsClient
 	client := meta.(*ArmClient).keyvault.ManagementClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseKeyVaultChildID(d.Id())
 	if err != nil {
@@ -274,7 +311,8 @@ func resourceArmKeyVaultSecretRead(d *schema.ResourceData, meta interface{}) err
 func resourceArmKeyVaultSecretDelete(d *schema.ResourceData, meta interface{}) error {
 	keyVaultClient := meta.(*ArmClient).keyvault.VaultsClient
 	client := meta.(*ArmClient).keyvault.ManagementClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseKeyVaultChildID(d.Id())
 	if err != nil {
@@ -299,6 +337,28 @@ func resourceArmKeyVaultSecretDelete(d *schema.ResourceData, meta interface{}) e
 		return nil
 	}
 
-	_, err = client.DeleteSecret(ctx, id.KeyVaultBaseUrl, id.Name)
-	return err
+	deleted, err := client.DeleteSecret(ctx, id.KeyVaultBaseUrl, id.Name)
+	if err != nil {
+		return err
+	}
+
+	if meta.(*ArmClient).Features.KeyVault.PurgeSoftDeleteOnDestroy && deleted.RecoveryID != nil {
+		if deleted.Attributes == nil || deleted.Attributes.RecoveryLevel != keyvault.RecoverablePurgeable {
+			log.Printf("[DEBUG] Secret %q (Key Vault %q) can't be purged - skipping", id.Name, id.KeyVaultBaseUrl)
+			return nil
+		}
+
+		getDeleted := func() (autorest.Response, error) {
+			resp, err := client.GetDeletedSecret(ctx, id.KeyVaultBaseUrl, id.Name)
+			return resp.Response, err
+		}
+		purge := func() (autorest.Response, error) {
+			return client.PurgeDeletedSecret(ctx, id.KeyVaultBaseUrl, id.Name)
+		}
+		if err := azure.PurgeKeyVaultChild(ctx, "Secret", id.Name, id.KeyVaultBaseUrl, getDeleted, purge); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
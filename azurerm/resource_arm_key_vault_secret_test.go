@@ -8,15 +8,15 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func TestAccAzureRMKeyVaultSecret_basic(t *testing.T) {
 	resourceName := "azurerm_key_vault_secret.test"
-	rs := acctest.RandString(6)
+	rs := tf.AccRandString(6)
 	config := testAccAzureRMKeyVaultSecret_basic(rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -42,7 +42,7 @@ func TestAccAzureRMKeyVaultSecret_basic(t *testing.T) {
 
 func TestAccAzureRMKeyVaultSecret_basicClassic(t *testing.T) {
 	resourceName := "azurerm_key_vault_secret.test"
-	rs := acctest.RandString(6)
+	rs := tf.AccRandString(6)
 	config := testAccAzureRMKeyVaultSecret_basicClasic(rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -73,7 +73,7 @@ func TestAccAzureRMKeyVaultSecret_requiresImport(t *testing.T) {
 	}
 
 	resourceName := "azurerm_key_vault_secret.test"
-	rs := acctest.RandString(6)
+	rs := tf.AccRandString(6)
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -98,7 +98,7 @@ func TestAccAzureRMKeyVaultSecret_requiresImport(t *testing.T) {
 
 func TestAccAzureRMKeyVaultSecret_disappears(t *testing.T) {
 	resourceName := "azurerm_key_vault_secret.test"
-	rs := acctest.RandString(6)
+	rs := tf.AccRandString(6)
 	config := testAccAzureRMKeyVaultSecret_basic(rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -119,7 +119,7 @@ func TestAccAzureRMKeyVaultSecret_disappears(t *testing.T) {
 }
 
 func TestAccAzureRMKeyVaultSecret_disappearsWhenParentKeyVaultDeleted(t *testing.T) {
-	rs := acctest.RandString(6)
+	rs := tf.AccRandString(6)
 	config := testAccAzureRMKeyVaultSecret_basic(rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -141,7 +141,7 @@ func TestAccAzureRMKeyVaultSecret_disappearsWhenParentKeyVaultDeleted(t *testing
 
 func TestAccAzureRMKeyVaultSecret_complete(t *testing.T) {
 	resourceName := "azurerm_key_vault_secret.test"
-	rs := acctest.RandString(6)
+	rs := tf.AccRandString(6)
 	config := testAccAzureRMKeyVaultSecret_complete(rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -168,7 +168,7 @@ func TestAccAzureRMKeyVaultSecret_complete(t *testing.T) {
 
 func TestAccAzureRMKeyVaultSecret_update(t *testing.T) {
 	resourceName := "azurerm_key_vault_secret.test"
-	rs := acctest.RandString(6)
+	rs := tf.AccRandString(6)
 	config := testAccAzureRMKeyVaultSecret_basic(rs, testLocation())
 	updatedConfig := testAccAzureRMKeyVaultSecret_basicUpdated(rs, testLocation())
 
@@ -195,6 +195,38 @@ func TestAccAzureRMKeyVaultSecret_update(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMKeyVaultSecret_softDeleteRecovery(t *testing.T) {
+	resourceName := "azurerm_key_vault_secret.test"
+	rs := tf.AccRandString(6)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultSecretDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKeyVaultSecret_softDelete(rs, location, false, "rick-and-morty"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultSecretExists(resourceName),
+				),
+			},
+			{
+				// removing the Secret (without purging it) leaves it soft-deleted
+				Config: testAccAzureRMKeyVault_softDeleteObjectsTemplate(rs, location, false),
+			},
+			{
+				// which is then recovered and updated when the Secret is re-created
+				Config: testAccAzureRMKeyVaultSecret_softDelete(rs, location, true, "szechuan"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultSecretExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "value", "szechuan"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMKeyVaultSecretDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*ArmClient).keyvault.ManagementClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
@@ -501,3 +533,16 @@ resource "azurerm_key_vault_secret" "test" {
 }
 `, rString, location, rString, rString)
 }
+
+func testAccAzureRMKeyVaultSecret_softDelete(rString string, location string, recover bool, value string) string {
+	template := testAccAzureRMKeyVault_softDeleteObjectsTemplate(rString, location, recover)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_secret" "test" {
+  name         = "secret-%s"
+  value        = "%s"
+  key_vault_id = "${azurerm_key_vault.test.id}"
+}
+`, template, rString, value)
+}
@@ -3,9 +3,9 @@ package azurerm
 import (
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
@@ -72,6 +72,59 @@ func TestAccAzureRMKeyVault_name(t *testing.T) {
 	}
 }
 
+func TestResourceArmKeyVaultCustomizeDiff(t *testing.T) {
+	testData := []struct {
+		Name        string
+		State       map[string]string
+		Config      map[string]interface{}
+		ExpectError string
+	}{
+		{
+			Name:   "Enabling Soft Delete",
+			State:  map[string]string{"soft_delete_enabled": "false"},
+			Config: map[string]interface{}{"soft_delete_enabled": true},
+		},
+		{
+			Name:        "Disabling Soft Delete",
+			State:       map[string]string{"soft_delete_enabled": "true"},
+			Config:      map[string]interface{}{"soft_delete_enabled": false},
+			ExpectError: "once Soft Delete has been enabled on a Key Vault it cannot be disabled",
+		},
+		{
+			Name:        "Disabling Purge Protection",
+			State:       map[string]string{"soft_delete_enabled": "true", "purge_protection_enabled": "true"},
+			Config:      map[string]interface{}{"soft_delete_enabled": true, "purge_protection_enabled": false},
+			ExpectError: "once Purge Protection has been enabled on a Key Vault it cannot be disabled",
+		},
+		{
+			Name:        "Purge Protection without Soft Delete",
+			State:       map[string]string{},
+			Config:      map[string]interface{}{"purge_protection_enabled": true},
+			ExpectError: "`soft_delete_enabled` must be set to `true`",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		state := &terraform.InstanceState{
+			ID:         "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1",
+			Attributes: v.State,
+		}
+		_, err := resourceArmKeyVault().Diff(state, terraform.NewResourceConfigRaw(v.Config), nil)
+		if v.ExpectError == "" {
+			if err != nil {
+				t.Fatalf("Expected no error for %q but got: %+v", v.Name, err)
+			}
+			continue
+		}
+
+		if err == nil || !strings.Contains(err.Error(), v.ExpectError) {
+			t.Fatalf("Expected an error containing %q for %q but got: %+v", v.ExpectError, v.Name, err)
+		}
+	}
+}
+
 func TestAccAzureRMKeyVault_basic(t *testing.T) {
 	resourceName := "azurerm_key_vault.test"
 	ri := tf.AccRandTimeInt()
@@ -214,7 +267,7 @@ func TestAccAzureRMKeyVault_networkAcls(t *testing.T) {
 func TestAccAzureRMKeyVault_accessPolicyUpperLimit(t *testing.T) {
 	resourceName := "azurerm_key_vault.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(10)
+	rs := tf.AccRandString(10)
 	config := testAccAzureRMKeyVault_accessPolicyUpperLimit(ri, testLocation(), rs)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -361,6 +414,109 @@ func TestAccAzureRMKeyVault_justCert(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMKeyVault_softDelete(t *testing.T) {
+	resourceName := "azurerm_key_vault.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKeyVault_softDelete(ri, location, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "soft_delete_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "purge_protection_enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKeyVault_softDeleteRecovery(t *testing.T) {
+	resourceName := "azurerm_key_vault.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKeyVault_softDelete(ri, location, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "soft_delete_enabled", "true"),
+				),
+			},
+			{
+				// removing the Key Vault (without purging it) leaves it soft-deleted
+				Config: testAccAzureRMKeyVault_softDeleteAbsent(ri, location),
+			},
+			{
+				// which is then recovered when the Key Vault is re-created
+				Config: testAccAzureRMKeyVault_softDelete(ri, location, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "soft_delete_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKeyVault_softDeleteCannotBeDisabled(t *testing.T) {
+	resourceName := "azurerm_key_vault.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKeyVault_softDelete(ri, location, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "soft_delete_enabled", "true"),
+				),
+			},
+			{
+				Config:      testAccAzureRMKeyVault_softDelete(ri, location, false, false),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("once Soft Delete has been enabled on a Key Vault it cannot be disabled"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKeyVault_purgeProtectionRequiresSoftDelete(t *testing.T) {
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAzureRMKeyVault_purgeProtectionWithoutSoftDelete(ri, location),
+				ExpectError: regexp.MustCompile("`soft_delete_enabled` must be set to `true`"),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMKeyVaultDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*ArmClient).keyvault.VaultsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
@@ -918,3 +1074,134 @@ access_policy {
 }
 `, accountNum)
 }
+
+func testAccAzureRMKeyVault_softDelete(rInt int, location string, recover bool, softDeleteEnabled bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    key_vault {
+      purge_soft_delete_on_destroy    = true
+      recover_soft_deleted_key_vaults = %t
+    }
+  }
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "vault%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+  sku_name            = "premium"
+  soft_delete_enabled = %t
+}
+`, recover, rInt, location, rInt, softDeleteEnabled)
+}
+
+// testAccAzureRMKeyVault_softDeleteObjectsTemplate is the Key Vault used to test the recovery of the soft-deleted
+// Certificates, Keys and Secrets within it - which are also purged when destroyed, once they're being recovered
+func testAccAzureRMKeyVault_softDeleteObjectsTemplate(rString string, location string, recover bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    key_vault {
+      purge_soft_delete_on_destroy           = %t
+      recover_soft_deleted_key_vault_objects = %t
+    }
+  }
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%s"
+  location = "%s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv-%s"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+  sku_name            = "premium"
+  soft_delete_enabled = true
+
+  access_policy {
+    tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+    object_id = "${data.azurerm_client_config.current.service_principal_object_id}"
+
+    certificate_permissions = [
+      "create",
+      "delete",
+      "get",
+      "list",
+      "purge",
+      "recover",
+      "update",
+    ]
+
+    key_permissions = [
+      "create",
+      "delete",
+      "get",
+      "list",
+      "purge",
+      "recover",
+      "update",
+    ]
+
+    secret_permissions = [
+      "delete",
+      "get",
+      "list",
+      "purge",
+      "recover",
+      "set",
+    ]
+  }
+}
+`, recover, recover, rString, location, rString)
+}
+
+func testAccAzureRMKeyVault_softDeleteAbsent(rInt int, location string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    key_vault {
+      purge_soft_delete_on_destroy = false
+    }
+  }
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+`, rInt, location)
+}
+
+func testAccAzureRMKeyVault_purgeProtectionWithoutSoftDelete(rInt int, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                     = "vault%d"
+  location                 = "${azurerm_resource_group.test.location}"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  tenant_id                = "${data.azurerm_client_config.current.tenant_id}"
+  sku_name                 = "premium"
+  purge_protection_enabled = true
+}
+`, rInt, location, rInt)
+}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -14,7 +13,7 @@ import (
 func TestAccAzureRMKustoCluster_basic(t *testing.T) {
 	resourceName := "azurerm_kusto_cluster.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(6)
+	rs := tf.AccRandString(6)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -39,7 +38,7 @@ func TestAccAzureRMKustoCluster_basic(t *testing.T) {
 func TestAccAzureRMKustoCluster_withTags(t *testing.T) {
 	resourceName := "azurerm_kusto_cluster.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(6)
+	rs := tf.AccRandString(6)
 	preConfig := testAccAzureRMKustoCluster_withTags(ri, rs, testLocation())
 	postConfig := testAccAzureRMKustoCluster_withTagsUpdate(ri, rs, testLocation())
 
@@ -72,7 +71,7 @@ func TestAccAzureRMKustoCluster_withTags(t *testing.T) {
 func TestAccAzureRMKustoCluster_sku(t *testing.T) {
 	resourceName := "azurerm_kusto_cluster.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(6)
+	rs := tf.AccRandString(6)
 	preConfig := testAccAzureRMKustoCluster_basic(ri, rs, testLocation())
 	postConfig := testAccAzureRMKustoCluster_skuUpdate(ri, rs, testLocation())
 
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -14,7 +13,7 @@ import (
 func TestAccAzureRMKustoDatabase_basic(t *testing.T) {
 	resourceName := "azurerm_kusto_database.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(6)
+	rs := tf.AccRandString(6)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -39,7 +38,7 @@ func TestAccAzureRMKustoDatabase_basic(t *testing.T) {
 func TestAccAzureRMKustoDatabase_softDeletePeriod(t *testing.T) {
 	resourceName := "azurerm_kusto_database.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(6)
+	rs := tf.AccRandString(6)
 	preConfig := testAccAzureRMKustoDatabase_softDeletePeriod(ri, rs, testLocation())
 	postConfig := testAccAzureRMKustoDatabase_softDeletePeriodUpdate(ri, rs, testLocation())
 
@@ -69,7 +68,7 @@ func TestAccAzureRMKustoDatabase_softDeletePeriod(t *testing.T) {
 func TestAccAzureRMKustoDatabase_hotCachePeriod(t *testing.T) {
 	resourceName := "azurerm_kusto_database.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(6)
+	rs := tf.AccRandString(6)
 	preConfig := testAccAzureRMKustoDatabase_hotCachePeriod(ri, rs, testLocation())
 	postConfig := testAccAzureRMKustoDatabase_hotCachePeriodUpdate(ri, rs, testLocation())
 
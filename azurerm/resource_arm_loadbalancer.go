@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -11,9 +12,10 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	networkSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/state"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -24,8 +26,13 @@ func resourceArmLoadBalancer() *schema.Resource {
 		Update: resourceArmLoadBalancerCreateUpdate,
 		Delete: resourceArmLoadBalancerDelete,
 
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Importer: azure.ValidateResourceIDPriorToImport(networkSvc.ValidateLoadBalancerID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -64,10 +71,11 @@ func resourceArmLoadBalancer() *schema.Resource {
 						},
 
 						"subnet_id": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							Computed:     true,
-							ValidateFunc: azure.ValidateResourceIDOrEmpty,
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateFunc:     azure.ValidateResourceIDOrEmpty,
+							DiffSuppressFunc: suppress.ResourceIDDifference,
 						},
 
 						"private_ip_address": {
@@ -78,17 +86,19 @@ func resourceArmLoadBalancer() *schema.Resource {
 						},
 
 						"public_ip_address_id": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							Computed:     true,
-							ValidateFunc: azure.ValidateResourceIDOrEmpty,
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateFunc:     azure.ValidateResourceIDOrEmpty,
+							DiffSuppressFunc: suppress.ResourceIDDifference,
 						},
 
 						"public_ip_prefix_id": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							Computed:     true,
-							ValidateFunc: azure.ValidateResourceIDOrEmpty,
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateFunc:     azure.ValidateResourceIDOrEmpty,
+							DiffSuppressFunc: suppress.ResourceIDDifference,
 						},
 
 						"private_ip_address_allocation": {
@@ -157,14 +167,15 @@ func resourceArmLoadBalancer() *schema.Resource {
 
 func resourceArmLoadBalancerCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).network.LoadBalancersClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Load Balancer creation.")
 
 	name := d.Get("name").(string)
 	resGroup := d.Get("resource_group_name").(string)
 
-	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+	if meta.(*ArmClient).Features.RequiresImport && d.IsNewResource() {
 		existing, err := client.Get(ctx, resGroup, name, "")
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -276,7 +287,8 @@ func resourceArmLoadBalancerRead(d *schema.ResourceData, meta interface{}) error
 
 func resourceArmLoadBalancerDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).network.LoadBalancersClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -368,7 +380,7 @@ func flattenLoadBalancerFrontendIpConfiguration(ipConfigs *[]network.FrontendIPC
 			ipConfig["private_ip_address_allocation"] = string(props.PrivateIPAllocationMethod)
 
 			if subnet := props.Subnet; subnet != nil {
-				ipConfig["subnet_id"] = *subnet.ID
+				ipConfig["subnet_id"] = azure.NormalizeResourceID(*subnet.ID)
 			}
 
 			if pip := props.PrivateIPAddress; pip != nil {
@@ -376,7 +388,7 @@ func flattenLoadBalancerFrontendIpConfiguration(ipConfigs *[]network.FrontendIPC
 			}
 
 			if pip := props.PublicIPAddress; pip != nil {
-				ipConfig["public_ip_address_id"] = *pip.ID
+				ipConfig["public_ip_address_id"] = azure.NormalizeResourceID(*pip.ID)
 			}
 
 			if pip := props.PublicIPPrefix; pip != nil {
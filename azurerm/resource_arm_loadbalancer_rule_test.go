@@ -73,7 +73,7 @@ func TestResourceAzureRMLoadBalancerRuleNameLabel_validation(t *testing.T) {
 func TestAccAzureRMLoadBalancerRule_basic(t *testing.T) {
 	var lb network.LoadBalancer
 	ri := tf.AccRandTimeInt()
-	lbRuleName := fmt.Sprintf("LbRule-%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+	lbRuleName := fmt.Sprintf("LbRule-%s", tf.AccRandStringFromCharSet(8, acctest.CharSetAlpha))
 
 	subscriptionID := os.Getenv("ARM_SUBSCRIPTION_ID")
 	lbRule_id := fmt.Sprintf(
@@ -108,7 +108,7 @@ func TestAccAzureRMLoadBalancerRule_basic(t *testing.T) {
 func TestAccAzureRMLoadBalancerRule_disableoutboundsnat(t *testing.T) {
 	var lb network.LoadBalancer
 	ri := tf.AccRandTimeInt()
-	lbRuleName := fmt.Sprintf("LbRule-%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+	lbRuleName := fmt.Sprintf("LbRule-%s", tf.AccRandStringFromCharSet(8, acctest.CharSetAlpha))
 
 	subscriptionID := os.Getenv("ARM_SUBSCRIPTION_ID")
 	lbRule_id := fmt.Sprintf(
@@ -148,7 +148,7 @@ func TestAccAzureRMLoadBalancerRule_requiresImport(t *testing.T) {
 
 	var lb network.LoadBalancer
 	ri := tf.AccRandTimeInt()
-	lbRuleName := fmt.Sprintf("LbRule-%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+	lbRuleName := fmt.Sprintf("LbRule-%s", tf.AccRandStringFromCharSet(8, acctest.CharSetAlpha))
 	location := testLocation()
 
 	subscriptionID := os.Getenv("ARM_SUBSCRIPTION_ID")
@@ -181,7 +181,7 @@ func TestAccAzureRMLoadBalancerRule_requiresImport(t *testing.T) {
 func TestAccAzureRMLoadBalancerRule_removal(t *testing.T) {
 	var lb network.LoadBalancer
 	ri := tf.AccRandTimeInt()
-	lbRuleName := fmt.Sprintf("LbRule-%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+	lbRuleName := fmt.Sprintf("LbRule-%s", tf.AccRandStringFromCharSet(8, acctest.CharSetAlpha))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -210,9 +210,9 @@ func TestAccAzureRMLoadBalancerRule_removal(t *testing.T) {
 func TestAccAzureRMLoadBalancerRule_inconsistentReads(t *testing.T) {
 	var lb network.LoadBalancer
 	ri := tf.AccRandTimeInt()
-	backendPoolName := fmt.Sprintf("LbPool-%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
-	lbRuleName := fmt.Sprintf("LbRule-%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
-	probeName := fmt.Sprintf("LbProbe-%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+	backendPoolName := fmt.Sprintf("LbPool-%s", tf.AccRandStringFromCharSet(8, acctest.CharSetAlpha))
+	lbRuleName := fmt.Sprintf("LbRule-%s", tf.AccRandStringFromCharSet(8, acctest.CharSetAlpha))
+	probeName := fmt.Sprintf("LbProbe-%s", tf.AccRandStringFromCharSet(8, acctest.CharSetAlpha))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -235,8 +235,8 @@ func TestAccAzureRMLoadBalancerRule_inconsistentReads(t *testing.T) {
 func TestAccAzureRMLoadBalancerRule_update(t *testing.T) {
 	var lb network.LoadBalancer
 	ri := tf.AccRandTimeInt()
-	lbRuleName := fmt.Sprintf("LbRule-%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
-	lbRule2Name := fmt.Sprintf("LbRule-%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+	lbRuleName := fmt.Sprintf("LbRule-%s", tf.AccRandStringFromCharSet(8, acctest.CharSetAlpha))
+	lbRule2Name := fmt.Sprintf("LbRule-%s", tf.AccRandStringFromCharSet(8, acctest.CharSetAlpha))
 
 	subscriptionID := os.Getenv("ARM_SUBSCRIPTION_ID")
 	lbRuleID := fmt.Sprintf(
@@ -283,7 +283,7 @@ func TestAccAzureRMLoadBalancerRule_update(t *testing.T) {
 func TestAccAzureRMLoadBalancerRule_disappears(t *testing.T) {
 	var lb network.LoadBalancer
 	ri := tf.AccRandTimeInt()
-	lbRuleName := fmt.Sprintf("LbRule-%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+	lbRuleName := fmt.Sprintf("LbRule-%s", tf.AccRandStringFromCharSet(8, acctest.CharSetAlpha))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -6,7 +6,6 @@ import (
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -199,7 +198,7 @@ func TestAccAzureRMManagedDisk_update(t *testing.T) {
 func TestAccAzureRMManagedDisk_encryption(t *testing.T) {
 	resourceName := "azurerm_managed_disk.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	var d compute.Disk
 
 	resource.ParallelTest(t, resource.TestCase{
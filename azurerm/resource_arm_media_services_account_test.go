@@ -6,7 +6,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -15,7 +14,7 @@ import (
 func TestAccAzureRMMediaServicesAccount_basic(t *testing.T) {
 	resourceName := "azurerm_media_services_account.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(5)
+	rs := tf.AccRandString(5)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -40,7 +39,7 @@ func TestAccAzureRMMediaServicesAccount_basic(t *testing.T) {
 func TestAccAzureRMMediaServicesAccount_multipleAccounts(t *testing.T) {
 	resourceName := "azurerm_media_services_account.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(5)
+	rs := tf.AccRandString(5)
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -70,7 +69,7 @@ func TestAccAzureRMMediaServicesAccount_multipleAccounts(t *testing.T) {
 
 func TestAccAzureRMMediaServicesAccount_multiplePrimaries(t *testing.T) {
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(5)
+	rs := tf.AccRandString(5)
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
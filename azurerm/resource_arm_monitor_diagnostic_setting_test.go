@@ -5,16 +5,16 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func TestAccAzureRMMonitorDiagnosticSetting_eventhub(t *testing.T) {
 	resourceName := "azurerm_monitor_diagnostic_setting.test"
-	ri := acctest.RandIntRange(10000, 99999)
+	ri := tf.AccRandIntRange(10000, 99999)
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -50,7 +50,7 @@ func TestAccAzureRMMonitorDiagnosticSetting_requiresImport(t *testing.T) {
 	}
 
 	resourceName := "azurerm_monitor_diagnostic_setting.test"
-	ri := acctest.RandIntRange(10000, 99999)
+	ri := tf.AccRandIntRange(10000, 99999)
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -74,7 +74,7 @@ func TestAccAzureRMMonitorDiagnosticSetting_requiresImport(t *testing.T) {
 
 func TestAccAzureRMMonitorDiagnosticSetting_logAnalyticsWorkspace(t *testing.T) {
 	resourceName := "azurerm_monitor_diagnostic_setting.test"
-	ri := acctest.RandIntRange(10000, 99999)
+	ri := tf.AccRandIntRange(10000, 99999)
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -104,7 +104,7 @@ func TestAccAzureRMMonitorDiagnosticSetting_logAnalyticsWorkspace(t *testing.T)
 
 func TestAccAzureRMMonitorDiagnosticSetting_logAnalyticsWorkspaceDedicated(t *testing.T) {
 	resourceName := "azurerm_monitor_diagnostic_setting.test"
-	ri := acctest.RandIntRange(10000, 99999)
+	ri := tf.AccRandIntRange(10000, 99999)
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -137,7 +137,7 @@ func TestAccAzureRMMonitorDiagnosticSetting_logAnalyticsWorkspaceDedicated(t *te
 
 func TestAccAzureRMMonitorDiagnosticSetting_storageAccount(t *testing.T) {
 	resourceName := "azurerm_monitor_diagnostic_setting.test"
-	ri := acctest.RandIntRange(10000, 99999)
+	ri := tf.AccRandIntRange(10000, 99999)
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
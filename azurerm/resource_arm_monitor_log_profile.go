@@ -1,6 +1,7 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -13,7 +14,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -27,6 +28,13 @@ func resourceArmMonitorLogProfile() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -35,14 +43,16 @@ func resourceArmMonitorLogProfile() *schema.Resource {
 				ValidateFunc: validate.NoEmptyStrings,
 			},
 			"storage_account_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: azure.ValidateResourceIDOrEmpty,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     azure.ValidateResourceIDOrEmpty,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
 			},
 			"servicebus_rule_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: azure.ValidateResourceIDOrEmpty,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     azure.ValidateResourceIDOrEmpty,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
 			},
 			"locations": {
 				Type:     schema.TypeSet,
@@ -89,10 +99,11 @@ func resourceArmMonitorLogProfile() *schema.Resource {
 
 func resourceArmLogProfileCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).monitor.LogProfilesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
-	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+	if meta.(*ArmClient).Features.RequiresImport && d.IsNewResource() {
 		existing, err := client.Get(ctx, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -135,7 +146,7 @@ func resourceArmLogProfileCreateUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	// Wait for Log Profile to become available
-	if err := resource.Retry(600*time.Second, retryLogProfilesClientGet(name, meta)); err != nil {
+	if err := resource.Retry(600*time.Second, retryLogProfilesClientGet(ctx, name, meta)); err != nil {
 		return fmt.Errorf("Error waiting for Log Profile %q to become available: %+v", name, err)
 	}
 
@@ -151,7 +162,8 @@ func resourceArmLogProfileCreateUpdate(d *schema.ResourceData, meta interface{})
 
 func resourceArmLogProfileRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).monitor.LogProfilesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name, err := parseLogProfileNameFromID(d.Id())
 	if err != nil {
@@ -188,7 +200,8 @@ func resourceArmLogProfileRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceArmLogProfileDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).monitor.LogProfilesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name, err := parseLogProfileNameFromID(d.Id())
 	if err != nil {
@@ -267,10 +280,9 @@ func flattenAzureRmLogProfileRetentionPolicy(input *insights.RetentionPolicy) []
 	return []interface{}{result}
 }
 
-func retryLogProfilesClientGet(name string, meta interface{}) func() *resource.RetryError {
+func retryLogProfilesClientGet(ctx context.Context, name string, meta interface{}) func() *resource.RetryError {
 	return func() *resource.RetryError {
 		client := meta.(*ArmClient).monitor.LogProfilesClient
-		ctx := meta.(*ArmClient).StopContext
 
 		if _, err := client.Get(ctx, name); err != nil {
 			return resource.RetryableError(err)
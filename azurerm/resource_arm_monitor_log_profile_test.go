@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -50,7 +49,7 @@ func TestAccAzureRMMonitorLogProfile(t *testing.T) {
 func testAccAzureRMMonitorLogProfile_basic(t *testing.T) {
 	resourceName := "azurerm_monitor_log_profile.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(10)
+	rs := tf.AccRandString(10)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -80,7 +79,7 @@ func testAccAzureRMMonitorLogProfile_requiresImport(t *testing.T) {
 
 	resourceName := "azurerm_monitor_log_profile.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(10)
+	rs := tf.AccRandString(10)
 	location := testLocation()
 
 	resource.Test(t, resource.TestCase{
@@ -105,7 +104,7 @@ func testAccAzureRMMonitorLogProfile_requiresImport(t *testing.T) {
 func testAccAzureRMMonitorLogProfile_servicebus(t *testing.T) {
 	resourceName := "azurerm_monitor_log_profile.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(10)
+	rs := tf.AccRandString(10)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -125,7 +124,7 @@ func testAccAzureRMMonitorLogProfile_servicebus(t *testing.T) {
 func testAccAzureRMMonitorLogProfile_complete(t *testing.T) {
 	resourceName := "azurerm_monitor_log_profile.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(10)
+	rs := tf.AccRandString(10)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -145,7 +144,7 @@ func testAccAzureRMMonitorLogProfile_complete(t *testing.T) {
 func testAccAzureRMMonitorLogProfile_disappears(t *testing.T) {
 	resourceName := "azurerm_monitor_log_profile.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(10)
+	rs := tf.AccRandString(10)
 	config := testAccAzureRMMonitorLogProfile_basicConfig(ri, rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
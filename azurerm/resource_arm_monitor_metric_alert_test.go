@@ -6,7 +6,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -16,7 +15,7 @@ import (
 func TestAccAzureRMMonitorMetricAlert_basic(t *testing.T) {
 	resourceName := "azurerm_monitor_metric_alert.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -56,7 +55,7 @@ func TestAccAzureRMMonitorMetricAlert_requiresImport(t *testing.T) {
 
 	resourceName := "azurerm_monitor_metric_alert.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -81,7 +80,7 @@ func TestAccAzureRMMonitorMetricAlert_requiresImport(t *testing.T) {
 func TestAccAzureRMMonitorMetricAlert_complete(t *testing.T) {
 	resourceName := "azurerm_monitor_metric_alert.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	config := testAccAzureRMMonitorMetricAlert_complete(ri, rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -136,7 +135,7 @@ func TestAccAzureRMMonitorMetricAlert_complete(t *testing.T) {
 func TestAccAzureRMMonitorMetricAlert_basicAndCompleteUpdate(t *testing.T) {
 	resourceName := "azurerm_monitor_metric_alert.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	location := testLocation()
 	basicConfig := testAccAzureRMMonitorMetricAlert_basic(ri, rs, location)
 	completeConfig := testAccAzureRMMonitorMetricAlert_complete(ri, rs, location)
@@ -3,14 +3,15 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -27,6 +28,13 @@ func resourceArmNetworkDDoSProtectionPlan() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -53,14 +61,15 @@ func resourceArmNetworkDDoSProtectionPlan() *schema.Resource {
 
 func resourceArmNetworkDDoSProtectionPlanCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).network.DDOSProtectionPlansClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for DDoS protection plan creation")
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+	if meta.(*ArmClient).Features.RequiresImport && d.IsNewResource() {
 		existing, err := client.Get(ctx, resourceGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -81,11 +90,14 @@ func resourceArmNetworkDDoSProtectionPlanCreateUpdate(d *schema.ResourceData, me
 		return fmt.Errorf("Error extracting names of Virtual Network: %+v", err)
 	}
 
-	locks.ByName(name, azureNetworkDDoSProtectionPlanResourceName)
-	defer locks.UnlockByName(name, azureNetworkDDoSProtectionPlanResourceName)
-
-	locks.MultipleByName(vnetsToLock, virtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(vnetsToLock, virtualNetworkResourceName)
+	namesToLock := []locks.NamesOfType{
+		{ResourceType: azureNetworkDDoSProtectionPlanResourceName, Names: &[]string{name}},
+		{ResourceType: virtualNetworkResourceName, Names: vnetsToLock},
+	}
+	if err := locks.MultipleByTypeWithContext(ctx, namesToLock...); err != nil {
+		return err
+	}
+	defer locks.UnlockMultipleByType(namesToLock...)
 
 	parameters := network.DdosProtectionPlan{
 		Location: &location,
@@ -117,7 +129,8 @@ func resourceArmNetworkDDoSProtectionPlanCreateUpdate(d *schema.ResourceData, me
 
 func resourceArmNetworkDDoSProtectionPlanRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).network.DDOSProtectionPlansClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -155,7 +168,8 @@ func resourceArmNetworkDDoSProtectionPlanRead(d *schema.ResourceData, meta inter
 
 func resourceArmNetworkDDoSProtectionPlanDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).network.DDOSProtectionPlansClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -180,11 +194,14 @@ func resourceArmNetworkDDoSProtectionPlanDelete(d *schema.ResourceData, meta int
 		return fmt.Errorf("Error extracting names of Virtual Network: %+v", err)
 	}
 
-	locks.ByName(name, azureNetworkDDoSProtectionPlanResourceName)
-	defer locks.UnlockByName(name, azureNetworkDDoSProtectionPlanResourceName)
-
-	locks.MultipleByName(vnetsToLock, virtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(vnetsToLock, virtualNetworkResourceName)
+	namesToLock := []locks.NamesOfType{
+		{ResourceType: azureNetworkDDoSProtectionPlanResourceName, Names: &[]string{name}},
+		{ResourceType: virtualNetworkResourceName, Names: vnetsToLock},
+	}
+	if err := locks.MultipleByTypeWithContext(ctx, namesToLock...); err != nil {
+		return err
+	}
+	defer locks.UnlockMultipleByType(namesToLock...)
 
 	future, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
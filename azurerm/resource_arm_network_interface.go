@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -12,10 +13,12 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	computeSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute"
+	networkSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/state"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -28,8 +31,13 @@ func resourceArmNetworkInterface() *schema.Resource {
 		Update: resourceArmNetworkInterfaceCreateUpdate,
 		Delete: resourceArmNetworkInterfaceDelete,
 
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Importer: azure.ValidateResourceIDPriorToImport(networkSvc.ValidateNetworkInterfaceID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -44,9 +52,10 @@ func resourceArmNetworkInterface() *schema.Resource {
 			"resource_group_name": azure.SchemaResourceGroupName(),
 
 			"network_security_group_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: azure.ValidateResourceIDOrEmpty,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     azure.ValidateResourceIDOrEmpty,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
 			},
 
 			"mac_address": {
@@ -57,10 +66,11 @@ func resourceArmNetworkInterface() *schema.Resource {
 			},
 
 			"virtual_machine_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: azure.ValidateResourceID,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     computeSvc.ValidateVirtualMachineID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
 			},
 
 			"ip_configuration": {
@@ -77,8 +87,8 @@ func resourceArmNetworkInterface() *schema.Resource {
 						"subnet_id": {
 							Type:             schema.TypeString,
 							Optional:         true,
-							DiffSuppressFunc: suppress.CaseDifference,
-							ValidateFunc:     azure.ValidateResourceID,
+							DiffSuppressFunc: suppress.ResourceIDDifference,
+							ValidateFunc:     networkSvc.ValidateSubnetID,
 						},
 
 						"private_ip_address": {
@@ -109,9 +119,10 @@ func resourceArmNetworkInterface() *schema.Resource {
 						},
 
 						"public_ip_address_id": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: azure.ValidateResourceIDOrEmpty,
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     azure.ValidateResourceIDOrEmpty,
+							DiffSuppressFunc: suppress.ResourceIDDifference,
 						},
 
 						"application_gateway_backend_address_pools_ids": {
@@ -133,7 +144,7 @@ func resourceArmNetworkInterface() *schema.Resource {
 							Deprecated: "This field has been deprecated in favour of the `azurerm_network_interface_backend_address_pool_association` resource.",
 							Elem: &schema.Schema{
 								Type:         schema.TypeString,
-								ValidateFunc: azure.ValidateResourceID,
+								ValidateFunc: networkSvc.ValidateLoadBalancerBackendAddressPoolID,
 							},
 							Set: schema.HashString,
 						},
@@ -145,7 +156,7 @@ func resourceArmNetworkInterface() *schema.Resource {
 							Deprecated: "This field has been deprecated in favour of the `azurerm_network_interface_nat_rule_association` resource.",
 							Elem: &schema.Schema{
 								Type:         schema.TypeString,
-								ValidateFunc: azure.ValidateResourceID,
+								ValidateFunc: networkSvc.ValidateLoadBalancerInboundNatRuleID,
 							},
 							Set: schema.HashString,
 						},
@@ -157,7 +168,7 @@ func resourceArmNetworkInterface() *schema.Resource {
 							Deprecated: "This field has been deprecated in favour of the `azurerm_network_interface_application_security_group_association` resource.",
 							Elem: &schema.Schema{
 								Type:         schema.TypeString,
-								ValidateFunc: azure.ValidateResourceID,
+								ValidateFunc: networkSvc.ValidateApplicationSecurityGroupID,
 							},
 							Set: schema.HashString,
 						},
@@ -248,14 +259,15 @@ func resourceArmNetworkInterface() *schema.Resource {
 
 func resourceArmNetworkInterfaceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).network.InterfacesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Network Interface creation.")
 
 	name := d.Get("name").(string)
 	resGroup := d.Get("resource_group_name").(string)
 
-	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+	if meta.(*ArmClient).Features.RequiresImport && d.IsNewResource() {
 		existing, err := client.Get(ctx, resGroup, name, "")
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -278,9 +290,7 @@ func resourceArmNetworkInterfaceCreateUpdate(d *schema.ResourceData, meta interf
 		EnableAcceleratedNetworking: &enableAcceleratedNetworking,
 	}
 
-	locks.ByName(name, networkInterfaceResourceName)
-	defer locks.UnlockByName(name, networkInterfaceResourceName)
-
+	networkSecurityGroupNamesToLock := make([]string, 0)
 	if v, ok := d.GetOk("network_security_group_id"); ok {
 		nsgId := v.(string)
 		properties.NetworkSecurityGroup = &network.SecurityGroup{
@@ -294,8 +304,7 @@ func resourceArmNetworkInterfaceCreateUpdate(d *schema.ResourceData, meta interf
 
 		networkSecurityGroupName := parsedNsgID.Path["networkSecurityGroups"]
 
-		locks.ByName(networkSecurityGroupName, networkSecurityGroupResourceName)
-		defer locks.UnlockByName(networkSecurityGroupName, networkSecurityGroupResourceName)
+		networkSecurityGroupNamesToLock = append(networkSecurityGroupNamesToLock, networkSecurityGroupName)
 	}
 
 	dns, hasDns := d.GetOk("dns_servers")
@@ -326,11 +335,16 @@ func resourceArmNetworkInterfaceCreateUpdate(d *schema.ResourceData, meta interf
 		return fmt.Errorf("Error Building list of Network Interface IP Configurations: %+v", sgErr)
 	}
 
-	locks.MultipleByName(subnetnToLock, subnetResourceName)
-	defer locks.UnlockMultipleByName(subnetnToLock, subnetResourceName)
-
-	locks.MultipleByName(vnnToLock, virtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(vnnToLock, virtualNetworkResourceName)
+	namesToLock := []locks.NamesOfType{
+		{ResourceType: networkInterfaceResourceName, Names: &[]string{name}},
+		{ResourceType: networkSecurityGroupResourceName, Names: &networkSecurityGroupNamesToLock},
+		{ResourceType: virtualNetworkResourceName, Names: vnnToLock},
+		{ResourceType: subnetResourceName, Names: subnetnToLock},
+	}
+	if err := locks.MultipleByTypeWithContext(ctx, namesToLock...); err != nil {
+		return err
+	}
+	defer locks.UnlockMultipleByType(namesToLock...)
 
 	if len(ipConfigs) > 0 {
 		properties.IPConfigurations = &ipConfigs
@@ -367,7 +381,8 @@ func resourceArmNetworkInterfaceCreateUpdate(d *schema.ResourceData, meta interf
 
 func resourceArmNetworkInterfaceRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).network.InterfacesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -440,8 +455,8 @@ func resourceArmNetworkInterfaceRead(d *schema.ResourceData, meta interface{}) e
 		d.Set("applied_dns_servers", appliedDNSServers)
 		d.Set("dns_servers", dnsServers)
 
-		if nsg := props.NetworkSecurityGroup; nsg != nil {
-			d.Set("network_security_group_id", nsg.ID)
+		if nsg := props.NetworkSecurityGroup; nsg != nil && nsg.ID != nil {
+			d.Set("network_security_group_id", azure.NormalizeResourceID(*nsg.ID))
 		} else {
 			d.Set("network_security_group_id", "")
 		}
@@ -455,7 +470,8 @@ func resourceArmNetworkInterfaceRead(d *schema.ResourceData, meta interface{}) e
 
 func resourceArmNetworkInterfaceDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).network.InterfacesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -464,9 +480,7 @@ func resourceArmNetworkInterfaceDelete(d *schema.ResourceData, meta interface{})
 	resGroup := id.ResourceGroup
 	name := id.Path["networkInterfaces"]
 
-	locks.ByName(name, networkInterfaceResourceName)
-	defer locks.UnlockByName(name, networkInterfaceResourceName)
-
+	networkSecurityGroupNamesToLock := make([]string, 0)
 	if v, ok := d.GetOk("network_security_group_id"); ok {
 		networkSecurityGroupId := v.(string)
 		parsedNsgID, err := azure.ParseAzureResourceID(networkSecurityGroupId)
@@ -476,8 +490,7 @@ func resourceArmNetworkInterfaceDelete(d *schema.ResourceData, meta interface{})
 
 		networkSecurityGroupName := parsedNsgID.Path["networkSecurityGroups"]
 
-		locks.ByName(networkSecurityGroupName, networkSecurityGroupResourceName)
-		defer locks.UnlockByName(networkSecurityGroupName, networkSecurityGroupResourceName)
+		networkSecurityGroupNamesToLock = append(networkSecurityGroupNamesToLock, networkSecurityGroupName)
 	}
 
 	configs := d.Get("ip_configuration").([]interface{})
@@ -505,11 +518,16 @@ func resourceArmNetworkInterfaceDelete(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	locks.MultipleByName(&subnetNamesToLock, subnetResourceName)
-	defer locks.UnlockMultipleByName(&subnetNamesToLock, subnetResourceName)
-
-	locks.MultipleByName(&virtualNetworkNamesToLock, virtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(&virtualNetworkNamesToLock, virtualNetworkResourceName)
+	namesToLock := []locks.NamesOfType{
+		{ResourceType: networkInterfaceResourceName, Names: &[]string{name}},
+		{ResourceType: networkSecurityGroupResourceName, Names: &networkSecurityGroupNamesToLock},
+		{ResourceType: virtualNetworkResourceName, Names: &virtualNetworkNamesToLock},
+		{ResourceType: subnetResourceName, Names: &subnetNamesToLock},
+	}
+	if err := locks.MultipleByTypeWithContext(ctx, namesToLock...); err != nil {
+		return err
+	}
+	defer locks.UnlockMultipleByType(namesToLock...)
 
 	future, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
@@ -533,7 +551,7 @@ func flattenNetworkInterfaceIPConfigurations(ipConfigs *[]network.InterfaceIPCon
 		niIPConfig["name"] = *ipConfig.Name
 
 		if props.Subnet != nil && props.Subnet.ID != nil {
-			niIPConfig["subnet_id"] = *props.Subnet.ID
+			niIPConfig["subnet_id"] = azure.NormalizeResourceID(*props.Subnet.ID)
 		}
 
 		niIPConfig["private_ip_address_allocation"] = strings.ToLower(string(props.PrivateIPAllocationMethod))
@@ -547,7 +565,7 @@ func flattenNetworkInterfaceIPConfigurations(ipConfigs *[]network.InterfaceIPCon
 		}
 
 		if props.PublicIPAddress != nil {
-			niIPConfig["public_ip_address_id"] = *props.PublicIPAddress.ID
+			niIPConfig["public_ip_address_id"] = azure.NormalizeResourceID(*props.PublicIPAddress.ID)
 		}
 
 		if props.Primary != nil {
@@ -5,7 +5,6 @@ import (
 	"net/http"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -71,7 +70,7 @@ func testAccAzureRMNetworkPacketCapture_storageAccount(t *testing.T) {
 	resourceName := "azurerm_network_packet_capture.test"
 
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(5)
+	rs := tf.AccRandString(5)
 	location := testLocation()
 
 	resource.Test(t, resource.TestCase{
@@ -98,7 +97,7 @@ func testAccAzureRMNetworkPacketCapture_storageAccountAndLocalDisk(t *testing.T)
 	resourceName := "azurerm_network_packet_capture.test"
 
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(5)
+	rs := tf.AccRandString(5)
 	location := testLocation()
 
 	resource.Test(t, resource.TestCase{
@@ -3,15 +3,18 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	networkSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -23,8 +26,14 @@ func resourceArmNetworkProfile() *schema.Resource {
 		Read:   resourceArmNetworkProfileRead,
 		Update: resourceArmNetworkProfileCreateUpdate,
 		Delete: resourceArmNetworkProfileDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+
+		Importer: azure.ValidateResourceIDPriorToImport(networkSvc.ValidateNetworkProfileID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -61,9 +70,10 @@ func resourceArmNetworkProfile() *schema.Resource {
 										ValidateFunc: validate.NoEmptyStrings,
 									},
 									"subnet_id": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: azure.ValidateResourceID,
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateFunc:     networkSvc.ValidateSubnetID,
+										DiffSuppressFunc: suppress.ResourceIDDifference,
 									},
 								},
 							},
@@ -87,14 +97,15 @@ func resourceArmNetworkProfile() *schema.Resource {
 
 func resourceArmNetworkProfileCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).network.ProfileClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Network Profile creation")
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+	if meta.(*ArmClient).Features.RequiresImport && d.IsNewResource() {
 		existing, err := client.Get(ctx, resourceGroup, name, "")
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -120,14 +131,15 @@ func resourceArmNetworkProfileCreateUpdate(d *schema.ResourceData, meta interfac
 		return fmt.Errorf("Error extracting names of Subnet and Virtual Network: %+v", err)
 	}
 
-	locks.ByName(name, azureNetworkProfileResourceName)
-	defer locks.UnlockByName(name, azureNetworkProfileResourceName)
-
-	locks.MultipleByName(subnetsToLock, subnetResourceName)
-	defer locks.UnlockMultipleByName(subnetsToLock, subnetResourceName)
-
-	locks.MultipleByName(vnetsToLock, virtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(vnetsToLock, virtualNetworkResourceName)
+	namesToLock := []locks.NamesOfType{
+		{ResourceType: azureNetworkProfileResourceName, Names: &[]string{name}},
+		{ResourceType: subnetResourceName, Names: subnetsToLock},
+		{ResourceType: virtualNetworkResourceName, Names: vnetsToLock},
+	}
+	if err := locks.MultipleByTypeWithContext(ctx, namesToLock...); err != nil {
+		return err
+	}
+	defer locks.UnlockMultipleByType(namesToLock...)
 
 	parameters := network.Profile{
 		Location: &location,
@@ -157,7 +169,8 @@ func resourceArmNetworkProfileCreateUpdate(d *schema.ResourceData, meta interfac
 
 func resourceArmNetworkProfileRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).network.ProfileClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -200,7 +213,8 @@ func resourceArmNetworkProfileRead(d *schema.ResourceData, meta interface{}) err
 
 func resourceArmNetworkProfileDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).network.ProfileClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -225,14 +239,15 @@ func resourceArmNetworkProfileDelete(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Error extracting names of Subnet and Virtual Network: %+v", err)
 	}
 
-	locks.ByName(name, azureNetworkProfileResourceName)
-	defer locks.UnlockByName(name, azureNetworkProfileResourceName)
-
-	locks.MultipleByName(subnetsToLock, subnetResourceName)
-	defer locks.UnlockMultipleByName(subnetsToLock, subnetResourceName)
-
-	locks.MultipleByName(vnetsToLock, virtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(vnetsToLock, virtualNetworkResourceName)
+	namesToLock := []locks.NamesOfType{
+		{ResourceType: azureNetworkProfileResourceName, Names: &[]string{name}},
+		{ResourceType: subnetResourceName, Names: subnetsToLock},
+		{ResourceType: virtualNetworkResourceName, Names: vnetsToLock},
+	}
+	if err := locks.MultipleByTypeWithContext(ctx, namesToLock...); err != nil {
+		return err
+	}
+	defer locks.UnlockMultipleByType(namesToLock...)
 
 	if _, err = client.Delete(ctx, resourceGroup, name); err != nil {
 		return fmt.Errorf("Error deleting Network Profile %q (Resource Group %q): %+v", name, resourceGroup, err)
@@ -339,7 +354,7 @@ func flattenNetworkProfileContainerNetworkInterface(input *[]network.ContainerNe
 				}
 
 				if ipProps := ipConfig.IPConfigurationProfilePropertiesFormat; ipProps != nil && ipProps.Subnet != nil && ipProps.Subnet.ID != nil {
-					retIPConfig["subnet_id"] = *ipProps.Subnet.ID
+					retIPConfig["subnet_id"] = azure.NormalizeResourceID(*ipProps.Subnet.ID)
 				}
 
 				retIPConfigs = append(retIPConfigs, retIPConfig)
@@ -0,0 +1,541 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	networkSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// the ID of a Flow Log is a combination of the Network Watcher ID and the Network Security Group ID, since
+// Flow Logs aren't a separate resource in Azure but are configured on the Network Watcher for the NSG
+const networkWatcherFlowLogIDSeparator = "/networkSecurityGroupId"
+
+type NetworkWatcherFlowLogID struct {
+	ResourceGroup          string
+	NetworkWatcherName     string
+	NetworkSecurityGroupID string
+}
+
+func resourceArmNetworkWatcherFlowLog() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmNetworkWatcherFlowLogCreateUpdate,
+		Read:   resourceArmNetworkWatcherFlowLogRead,
+		Update: resourceArmNetworkWatcherFlowLogCreateUpdate,
+		Delete: resourceArmNetworkWatcherFlowLogDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceArmNetworkWatcherFlowLogImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"network_watcher_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"network_security_group_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     networkSvc.ValidateNetworkSecurityGroupID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
+			},
+
+			"storage_account_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+
+			"retention_policy": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+
+						"days": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+
+			"traffic_analytics": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+
+						"workspace_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.UUID,
+						},
+
+						"workspace_region": {
+							Type:             schema.TypeString,
+							Required:         true,
+							StateFunc:        azure.NormalizeLocation,
+							DiffSuppressFunc: azure.SuppressLocationDiff,
+						},
+
+						"workspace_resource_id": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     azure.ValidateResourceID,
+							DiffSuppressFunc: suppress.ResourceIDDifference,
+						},
+
+						"interval_in_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      60,
+							ValidateFunc: validation.IntInSlice([]int{10, 60}),
+						},
+					},
+				},
+			},
+
+			"version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 2),
+			},
+		},
+	}
+}
+
+func resourceArmNetworkWatcherFlowLogCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network.WatcherClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	networkWatcherName := d.Get("network_watcher_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	networkSecurityGroupId := d.Get("network_security_group_id").(string)
+
+	parsedNsgId, err := azure.ParseAzureResourceID(networkSecurityGroupId)
+	if err != nil {
+		return fmt.Errorf("Error parsing Network Security Group ID %q: %+v", networkSecurityGroupId, err)
+	}
+	networkSecurityGroupName := parsedNsgId.Path["networkSecurityGroups"]
+
+	if err := locks.ByNameWithContext(ctx, networkSecurityGroupName, networkSecurityGroupResourceName); err != nil {
+		return err
+	}
+	defer locks.UnlockByName(networkSecurityGroupName, networkSecurityGroupResourceName)
+
+	if d.IsNewResource() && meta.(*ArmClient).Features.RequiresImport {
+		existing, err := retrieveNetworkWatcherFlowLog(d, meta, resourceGroup, networkWatcherName, networkSecurityGroupId)
+		if err != nil {
+			return fmt.Errorf("Error checking for presence of existing Flow Log for Network Security Group %q (Network Watcher %q / Resource Group %q): %+v", networkSecurityGroupId, networkWatcherName, resourceGroup, err)
+		}
+
+		if existing.FlowLogProperties != nil && existing.FlowLogProperties.Enabled != nil && *existing.FlowLogProperties.Enabled {
+			id, err := networkWatcherFlowLogID(ctx, meta, resourceGroup, networkWatcherName, networkSecurityGroupId)
+			if err != nil {
+				return err
+			}
+			return tf.ImportAsExistsError("azurerm_network_watcher_flow_log", id)
+		}
+	}
+
+	parameters := network.FlowLogInformation{
+		TargetResourceID: utils.String(networkSecurityGroupId),
+		FlowLogProperties: &network.FlowLogProperties{
+			StorageID:       utils.String(d.Get("storage_account_id").(string)),
+			Enabled:         utils.Bool(d.Get("enabled").(bool)),
+			RetentionPolicy: expandAzureRmNetworkWatcherFlowLogRetentionPolicy(d.Get("retention_policy").([]interface{})),
+		},
+		FlowAnalyticsConfiguration: expandAzureRmNetworkWatcherFlowLogTrafficAnalytics(d.Get("traffic_analytics").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("version"); ok {
+		parameters.FlowLogProperties.Format = &network.FlowLogFormatParameters{
+			Type:    network.JSON,
+			Version: utils.Int32(int32(v.(int))),
+		}
+	}
+
+	future, err := client.SetFlowLogConfiguration(ctx, resourceGroup, networkWatcherName, parameters)
+	if err != nil {
+		return fmt.Errorf("Error configuring Flow Log for Network Security Group %q (Network Watcher %q / Resource Group %q): %+v", networkSecurityGroupId, networkWatcherName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for the configuration of the Flow Log for Network Security Group %q (Network Watcher %q / Resource Group %q): %+v", networkSecurityGroupId, networkWatcherName, resourceGroup, err)
+	}
+
+	if d.IsNewResource() {
+		id, err := networkWatcherFlowLogID(ctx, meta, resourceGroup, networkWatcherName, networkSecurityGroupId)
+		if err != nil {
+			return err
+		}
+		d.SetId(id)
+	}
+
+	return resourceArmNetworkWatcherFlowLogRead(d, meta)
+}
+
+func resourceArmNetworkWatcherFlowLogRead(d *schema.ResourceData, meta interface{}) error {
+	id, err := parseNetworkWatcherFlowLogID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := retrieveNetworkWatcherFlowLog(d, meta, id.ResourceGroup, id.NetworkWatcherName, id.NetworkSecurityGroupID)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[WARN] Flow Log for Network Security Group %q (Network Watcher %q / Resource Group %q) was not found - removing from state", id.NetworkSecurityGroupID, id.NetworkWatcherName, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Flow Log for Network Security Group %q (Network Watcher %q / Resource Group %q): %+v", id.NetworkSecurityGroupID, id.NetworkWatcherName, id.ResourceGroup, err)
+	}
+
+	d.Set("network_watcher_name", id.NetworkWatcherName)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("network_security_group_id", id.NetworkSecurityGroupID)
+
+	if props := resp.FlowLogProperties; props != nil {
+		d.Set("enabled", props.Enabled)
+		d.Set("storage_account_id", props.StorageID)
+
+		version := 0
+		if props.Format != nil && props.Format.Version != nil {
+			version = int(*props.Format.Version)
+		}
+		d.Set("version", version)
+
+		if err := d.Set("retention_policy", flattenAzureRmNetworkWatcherFlowLogRetentionPolicy(props.RetentionPolicy)); err != nil {
+			return fmt.Errorf("Error setting `retention_policy`: %+v", err)
+		}
+	}
+
+	if err := d.Set("traffic_analytics", flattenAzureRmNetworkWatcherFlowLogTrafficAnalytics(resp.FlowAnalyticsConfiguration)); err != nil {
+		return fmt.Errorf("Error setting `traffic_analytics`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmNetworkWatcherFlowLogDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network.WatcherClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseNetworkWatcherFlowLogID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	parsedNsgId, err := azure.ParseAzureResourceID(id.NetworkSecurityGroupID)
+	if err != nil {
+		return fmt.Errorf("Error parsing Network Security Group ID %q: %+v", id.NetworkSecurityGroupID, err)
+	}
+	networkSecurityGroupName := parsedNsgId.Path["networkSecurityGroups"]
+
+	if err := locks.ByNameWithContext(ctx, networkSecurityGroupName, networkSecurityGroupResourceName); err != nil {
+		return err
+	}
+	defer locks.UnlockByName(networkSecurityGroupName, networkSecurityGroupResourceName)
+
+	resp, err := retrieveNetworkWatcherFlowLog(d, meta, id.ResourceGroup, id.NetworkWatcherName, id.NetworkSecurityGroupID)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Flow Log for Network Security Group %q (Network Watcher %q / Resource Group %q): %+v", id.NetworkSecurityGroupID, id.NetworkWatcherName, id.ResourceGroup, err)
+	}
+
+	// Flow Logs can't be removed from a Network Watcher, only disabled - and the Storage Account must still be specified
+	parameters := network.FlowLogInformation{
+		TargetResourceID: utils.String(id.NetworkSecurityGroupID),
+		FlowLogProperties: &network.FlowLogProperties{
+			Enabled: utils.Bool(false),
+		},
+	}
+
+	if props := resp.FlowLogProperties; props != nil {
+		parameters.FlowLogProperties.StorageID = props.StorageID
+	}
+
+	if config := resp.FlowAnalyticsConfiguration; config != nil && config.NetworkWatcherFlowAnalyticsConfiguration != nil {
+		analytics := *config.NetworkWatcherFlowAnalyticsConfiguration
+		analytics.Enabled = utils.Bool(false)
+		parameters.FlowAnalyticsConfiguration = &network.TrafficAnalyticsProperties{
+			NetworkWatcherFlowAnalyticsConfiguration: &analytics,
+		}
+	}
+
+	future, err := client.SetFlowLogConfiguration(ctx, id.ResourceGroup, id.NetworkWatcherName, parameters)
+	if err != nil {
+		return fmt.Errorf("Error disabling Flow Log for Network Security Group %q (Network Watcher %q / Resource Group %q): %+v", id.NetworkSecurityGroupID, id.NetworkWatcherName, id.ResourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for the Flow Log for Network Security Group %q (Network Watcher %q / Resource Group %q) to be disabled: %+v", id.NetworkSecurityGroupID, id.NetworkWatcherName, id.ResourceGroup, err)
+	}
+
+	return nil
+}
+
+func resourceArmNetworkWatcherFlowLogImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if strings.Contains(d.Id(), networkWatcherFlowLogIDSeparator) {
+		if _, err := parseNetworkWatcherFlowLogID(d.Id()); err != nil {
+			return nil, err
+		}
+
+		return []*schema.ResourceData{d}, nil
+	}
+
+	// otherwise this is the ID of the Network Security Group - so we need to find the Network Watcher for it's region
+	nsgClient := meta.(*ArmClient).network.SecurityGroupClient
+	watcherClient := meta.(*ArmClient).network.WatcherClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	nsgId, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Network Security Group ID %q: %+v", d.Id(), err)
+	}
+	nsgName := nsgId.Path["networkSecurityGroups"]
+	if nsgName == "" {
+		return nil, fmt.Errorf("Expected the ID of a Network Security Group or a Flow Log but got %q", d.Id())
+	}
+
+	nsg, err := nsgClient.Get(ctx, nsgId.ResourceGroup, nsgName, "")
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving Network Security Group %q (Resource Group %q): %+v", nsgName, nsgId.ResourceGroup, err)
+	}
+	if nsg.Location == nil {
+		return nil, fmt.Errorf("Error retrieving Network Security Group %q (Resource Group %q): `location` was nil", nsgName, nsgId.ResourceGroup)
+	}
+
+	watchers, err := watcherClient.ListAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing Network Watchers: %+v", err)
+	}
+
+	if watchers.Value != nil {
+		for _, watcher := range *watchers.Value {
+			if watcher.ID == nil || watcher.Location == nil {
+				continue
+			}
+
+			if azure.NormalizeLocation(*watcher.Location) != azure.NormalizeLocation(*nsg.Location) {
+				continue
+			}
+
+			d.SetId(fmt.Sprintf("%s%s%s", *watcher.ID, networkWatcherFlowLogIDSeparator, *nsg.ID))
+			return []*schema.ResourceData{d}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("Unable to find a Network Watcher in %q for Network Security Group %q (Resource Group %q)", *nsg.Location, nsgName, nsgId.ResourceGroup)
+}
+
+func retrieveNetworkWatcherFlowLog(d *schema.ResourceData, meta interface{}, resourceGroup, networkWatcherName, networkSecurityGroupId string) (network.FlowLogInformation, error) {
+	client := meta.(*ArmClient).network.WatcherClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	parameters := network.FlowLogStatusParameters{
+		TargetResourceID: utils.String(networkSecurityGroupId),
+	}
+
+	future, err := client.GetFlowLogStatus(ctx, resourceGroup, networkWatcherName, parameters)
+	if err != nil {
+		return network.FlowLogInformation{Response: autorest.Response{Response: future.Response()}}, err
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return network.FlowLogInformation{Response: autorest.Response{Response: future.Response()}}, err
+	}
+
+	return future.Result(*client)
+}
+
+func networkWatcherFlowLogID(ctx context.Context, meta interface{}, resourceGroup, networkWatcherName, networkSecurityGroupId string) (string, error) {
+	client := meta.(*ArmClient).network.WatcherClient
+
+	watcher, err := client.Get(ctx, resourceGroup, networkWatcherName)
+	if err != nil {
+		return "", fmt.Errorf("Error retrieving Network Watcher %q (Resource Group %q): %+v", networkWatcherName, resourceGroup, err)
+	}
+	if watcher.ID == nil {
+		return "", fmt.Errorf("Error retrieving Network Watcher %q (Resource Group %q): `id` was nil", networkWatcherName, resourceGroup)
+	}
+
+	return fmt.Sprintf("%s%s%s", *watcher.ID, networkWatcherFlowLogIDSeparator, networkSecurityGroupId), nil
+}
+
+func parseNetworkWatcherFlowLogID(input string) (*NetworkWatcherFlowLogID, error) {
+	parts := strings.SplitN(input, networkWatcherFlowLogIDSeparator, 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Error parsing Flow Log ID %q: expected it to be in the format `{networkWatcherId}%s{networkSecurityGroupId}`", input, networkWatcherFlowLogIDSeparator)
+	}
+
+	watcherId, err := azure.ParseAzureResourceID(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Network Watcher ID %q: %+v", parts[0], err)
+	}
+
+	watcherName := watcherId.Path["networkWatchers"]
+	if watcherName == "" {
+		return nil, fmt.Errorf("Error parsing Flow Log ID %q: `networkWatchers` segment was missing", input)
+	}
+
+	if _, err := azure.ParseAzureResourceID(parts[1]); err != nil {
+		return nil, fmt.Errorf("Error parsing Network Security Group ID %q: %+v", parts[1], err)
+	}
+
+	return &NetworkWatcherFlowLogID{
+		ResourceGroup:          watcherId.ResourceGroup,
+		NetworkWatcherName:     watcherName,
+		NetworkSecurityGroupID: parts[1],
+	}, nil
+}
+
+func expandAzureRmNetworkWatcherFlowLogRetentionPolicy(input []interface{}) *network.RetentionPolicyParameters {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	return &network.RetentionPolicyParameters{
+		Enabled: utils.Bool(v["enabled"].(bool)),
+		Days:    utils.Int32(int32(v["days"].(int))),
+	}
+}
+
+func flattenAzureRmNetworkWatcherFlowLogRetentionPolicy(input *network.RetentionPolicyParameters) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	enabled := false
+	if input.Enabled != nil {
+		enabled = *input.Enabled
+	}
+
+	days := 0
+	if input.Days != nil {
+		days = int(*input.Days)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"enabled": enabled,
+			"days":    days,
+		},
+	}
+}
+
+func expandAzureRmNetworkWatcherFlowLogTrafficAnalytics(input []interface{}) *network.TrafficAnalyticsProperties {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	return &network.TrafficAnalyticsProperties{
+		NetworkWatcherFlowAnalyticsConfiguration: &network.TrafficAnalyticsConfigurationProperties{
+			Enabled:                  utils.Bool(v["enabled"].(bool)),
+			WorkspaceID:              utils.String(v["workspace_id"].(string)),
+			WorkspaceRegion:          utils.String(v["workspace_region"].(string)),
+			WorkspaceResourceID:      utils.String(v["workspace_resource_id"].(string)),
+			TrafficAnalyticsInterval: utils.Int32(int32(v["interval_in_minutes"].(int))),
+		},
+	}
+}
+
+func flattenAzureRmNetworkWatcherFlowLogTrafficAnalytics(input *network.TrafficAnalyticsProperties) []interface{} {
+	if input == nil || input.NetworkWatcherFlowAnalyticsConfiguration == nil {
+		return []interface{}{}
+	}
+
+	config := input.NetworkWatcherFlowAnalyticsConfiguration
+
+	// Traffic Analytics is returned (disabled) even when it's never been configured
+	if config.WorkspaceID == nil || *config.WorkspaceID == "" {
+		return []interface{}{}
+	}
+
+	enabled := false
+	if config.Enabled != nil {
+		enabled = *config.Enabled
+	}
+
+	workspaceRegion := ""
+	if config.WorkspaceRegion != nil {
+		workspaceRegion = azure.NormalizeLocation(*config.WorkspaceRegion)
+	}
+
+	workspaceResourceId := ""
+	if config.WorkspaceResourceID != nil {
+		workspaceResourceId = *config.WorkspaceResourceID
+	}
+
+	interval := 60
+	if config.TrafficAnalyticsInterval != nil {
+		interval = int(*config.TrafficAnalyticsInterval)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"enabled":               enabled,
+			"workspace_id":          *config.WorkspaceID,
+			"workspace_region":      workspaceRegion,
+			"workspace_resource_id": workspaceResourceId,
+			"interval_in_minutes":   interval,
+		},
+	}
+}
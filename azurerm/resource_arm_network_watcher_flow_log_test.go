@@ -0,0 +1,524 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestParseNetworkWatcherFlowLogID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected *NetworkWatcherFlowLogID
+	}{
+		{
+			// missing the NSG
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkWatchers/watcher1",
+			Expected: nil,
+		},
+		{
+			// missing the Network Watcher
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/networkSecurityGroupId/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group2/providers/Microsoft.Network/networkSecurityGroups/nsg1",
+			Expected: nil,
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkWatchers/watcher1/networkSecurityGroupId/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group2/providers/Microsoft.Network/networkSecurityGroups/nsg1",
+			Expected: &NetworkWatcherFlowLogID{
+				ResourceGroup:          "group1",
+				NetworkWatcherName:     "watcher1",
+				NetworkSecurityGroupID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group2/providers/Microsoft.Network/networkSecurityGroups/nsg1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := parseNetworkWatcherFlowLogID(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %+v", err)
+		}
+
+		if v.Expected == nil {
+			t.Fatalf("Expected an error but got a value for %q", v.Input)
+		}
+
+		if *actual != *v.Expected {
+			t.Fatalf("Expected %+v but got %+v", *v.Expected, *actual)
+		}
+	}
+}
+
+func testAccAzureRMNetworkWatcherFlowLog_basic(t *testing.T) {
+	resourceName := "azurerm_network_watcher_flow_log.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNetworkWatcherFlowLogDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkWatcherFlowLog_basicConfig(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkWatcherFlowLogExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "retention_policy.0.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_analytics.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAzureRMNetworkWatcherFlowLog_disabled(t *testing.T) {
+	resourceName := "azurerm_network_watcher_flow_log.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNetworkWatcherFlowLogDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkWatcherFlowLog_basicConfig(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkWatcherFlowLogExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccAzureRMNetworkWatcherFlowLog_disabledConfig(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkWatcherFlowLogExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAzureRMNetworkWatcherFlowLog_trafficAnalytics(t *testing.T) {
+	resourceName := "azurerm_network_watcher_flow_log.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNetworkWatcherFlowLogDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkWatcherFlowLog_basicConfig(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkWatcherFlowLogExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "traffic_analytics.#", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMNetworkWatcherFlowLog_trafficAnalyticsConfig(ri, location, true, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkWatcherFlowLogExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "traffic_analytics.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_analytics.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_analytics.0.interval_in_minutes", "10"),
+					resource.TestCheckResourceAttrSet(resourceName, "traffic_analytics.0.workspace_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "traffic_analytics.0.workspace_resource_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMNetworkWatcherFlowLog_trafficAnalyticsConfig(ri, location, false, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkWatcherFlowLogExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "traffic_analytics.0.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_analytics.0.interval_in_minutes", "60"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAzureRMNetworkWatcherFlowLog_retentionPolicy(t *testing.T) {
+	resourceName := "azurerm_network_watcher_flow_log.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNetworkWatcherFlowLogDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkWatcherFlowLog_retentionPolicyConfig(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkWatcherFlowLogExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "retention_policy.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "retention_policy.0.days", "7"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAzureRMNetworkWatcherFlowLog_updateStorageAccount(t *testing.T) {
+	resourceName := "azurerm_network_watcher_flow_log.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNetworkWatcherFlowLogDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkWatcherFlowLog_basicConfig(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkWatcherFlowLogExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "storage_account_id", "azurerm_storage_account.test", "id"),
+				),
+			},
+			{
+				Config: testAccAzureRMNetworkWatcherFlowLog_updateStorageAccountConfig(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkWatcherFlowLogExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "storage_account_id", "azurerm_storage_account.test2", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAzureRMNetworkWatcherFlowLog_version(t *testing.T) {
+	resourceName := "azurerm_network_watcher_flow_log.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNetworkWatcherFlowLogDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkWatcherFlowLog_versionConfig(ri, location, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkWatcherFlowLogExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMNetworkWatcherFlowLog_versionConfig(ri, location, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkWatcherFlowLogExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAzureRMNetworkWatcherFlowLog_importByNetworkSecurityGroupId(t *testing.T) {
+	resourceName := "azurerm_network_watcher_flow_log.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNetworkWatcherFlowLogDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkWatcherFlowLog_basicConfig(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkWatcherFlowLogExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["azurerm_network_security_group.test"]
+					if !ok {
+						return "", fmt.Errorf("Not found: azurerm_network_security_group.test")
+					}
+
+					return rs.Primary.ID, nil
+				},
+			},
+		},
+	})
+}
+
+func testCheckAzureRMNetworkWatcherFlowLogExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*ArmClient).network.WatcherClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parseNetworkWatcherFlowLogID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		future, err := client.GetFlowLogStatus(ctx, id.ResourceGroup, id.NetworkWatcherName, network.FlowLogStatusParameters{TargetResourceID: utils.String(id.NetworkSecurityGroupID)})
+		if err != nil {
+			return fmt.Errorf("Bad: GetFlowLogStatus on watcherClient: %+v", err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Bad: waiting for GetFlowLogStatus on watcherClient: %+v", err)
+		}
+
+		if _, err := future.Result(*client); err != nil {
+			return fmt.Errorf("Bad: retrieving the Flow Log for Network Security Group %q: %+v", id.NetworkSecurityGroupID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMNetworkWatcherFlowLogDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).network.WatcherClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_network_watcher_flow_log" {
+			continue
+		}
+
+		id, err := parseNetworkWatcherFlowLogID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		future, err := client.GetFlowLogStatus(ctx, id.ResourceGroup, id.NetworkWatcherName, network.FlowLogStatusParameters{TargetResourceID: utils.String(id.NetworkSecurityGroupID)})
+		if err != nil {
+			// the Network Watcher (or Resource Group) no longer exists
+			return nil
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return nil
+		}
+
+		resp, err := future.Result(*client)
+		if err != nil {
+			return nil
+		}
+
+		if props := resp.FlowLogProperties; props != nil && props.Enabled != nil && *props.Enabled {
+			return fmt.Errorf("Flow Log for Network Security Group %q is still enabled", id.NetworkSecurityGroupID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMNetworkWatcherFlowLog_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-watcher-%d"
+  location = "%s"
+}
+
+resource "azurerm_network_security_group" "test" {
+  name                = "acctestNSG%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_network_watcher" "test" {
+  name                = "acctestNW-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                = "acctestsa%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+
+  account_tier              = "Standard"
+  account_kind              = "StorageV2"
+  account_replication_type  = "LRS"
+  enable_https_traffic_only = true
+}
+`, rInt, location, rInt, rInt, rInt%1000000)
+}
+
+func testAccAzureRMNetworkWatcherFlowLog_basicConfig(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_watcher_flow_log" "test" {
+  network_watcher_name = "${azurerm_network_watcher.test.name}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+
+  network_security_group_id = "${azurerm_network_security_group.test.id}"
+  storage_account_id        = "${azurerm_storage_account.test.id}"
+  enabled                   = true
+
+  retention_policy {
+    enabled = false
+    days    = 0
+  }
+}
+`, testAccAzureRMNetworkWatcherFlowLog_template(rInt, location))
+}
+
+func testAccAzureRMNetworkWatcherFlowLog_disabledConfig(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_watcher_flow_log" "test" {
+  network_watcher_name = "${azurerm_network_watcher.test.name}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+
+  network_security_group_id = "${azurerm_network_security_group.test.id}"
+  storage_account_id        = "${azurerm_storage_account.test.id}"
+  enabled                   = false
+
+  retention_policy {
+    enabled = false
+    days    = 0
+  }
+}
+`, testAccAzureRMNetworkWatcherFlowLog_template(rInt, location))
+}
+
+func testAccAzureRMNetworkWatcherFlowLog_retentionPolicyConfig(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_watcher_flow_log" "test" {
+  network_watcher_name = "${azurerm_network_watcher.test.name}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+
+  network_security_group_id = "${azurerm_network_security_group.test.id}"
+  storage_account_id        = "${azurerm_storage_account.test.id}"
+  enabled                   = true
+
+  retention_policy {
+    enabled = true
+    days    = 7
+  }
+}
+`, testAccAzureRMNetworkWatcherFlowLog_template(rInt, location))
+}
+
+func testAccAzureRMNetworkWatcherFlowLog_updateStorageAccountConfig(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account" "test2" {
+  name                = "acctestsa2%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+
+  account_tier              = "Standard"
+  account_kind              = "StorageV2"
+  account_replication_type  = "LRS"
+  enable_https_traffic_only = true
+}
+
+resource "azurerm_network_watcher_flow_log" "test" {
+  network_watcher_name = "${azurerm_network_watcher.test.name}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+
+  network_security_group_id = "${azurerm_network_security_group.test.id}"
+  storage_account_id        = "${azurerm_storage_account.test2.id}"
+  enabled                   = true
+
+  retention_policy {
+    enabled = false
+    days    = 0
+  }
+}
+`, testAccAzureRMNetworkWatcherFlowLog_template(rInt, location), rInt%1000000)
+}
+
+func testAccAzureRMNetworkWatcherFlowLog_trafficAnalyticsConfig(rInt int, location string, enabled bool, interval int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestLAW-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_network_watcher_flow_log" "test" {
+  network_watcher_name = "${azurerm_network_watcher.test.name}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+
+  network_security_group_id = "${azurerm_network_security_group.test.id}"
+  storage_account_id        = "${azurerm_storage_account.test.id}"
+  enabled                   = true
+
+  retention_policy {
+    enabled = false
+    days    = 0
+  }
+
+  traffic_analytics {
+    enabled               = %t
+    workspace_id          = "${azurerm_log_analytics_workspace.test.workspace_id}"
+    workspace_region      = "${azurerm_log_analytics_workspace.test.location}"
+    workspace_resource_id = "${azurerm_log_analytics_workspace.test.id}"
+    interval_in_minutes   = %d
+  }
+}
+`, testAccAzureRMNetworkWatcherFlowLog_template(rInt, location), rInt, enabled, interval)
+}
+
+func testAccAzureRMNetworkWatcherFlowLog_versionConfig(rInt int, location string, version int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_watcher_flow_log" "test" {
+  network_watcher_name = "${azurerm_network_watcher.test.name}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+
+  network_security_group_id = "${azurerm_network_security_group.test.id}"
+  storage_account_id        = "${azurerm_storage_account.test.id}"
+  enabled                   = true
+  version                   = %d
+
+  retention_policy {
+    enabled = false
+    days    = 0
+  }
+}
+`, testAccAzureRMNetworkWatcherFlowLog_template(rInt, location), version)
+}
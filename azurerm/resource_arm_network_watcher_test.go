@@ -65,6 +65,15 @@ func TestAccAzureRMNetworkWatcher(t *testing.T) {
 			"withFilters":                testAccAzureRMNetworkPacketCapture_withFilters,
 			"requiresImport":             testAccAzureRMNetworkPacketCapture_requiresImport,
 		},
+		"FlowLog": {
+			"basic":                testAccAzureRMNetworkWatcherFlowLog_basic,
+			"disabled":             testAccAzureRMNetworkWatcherFlowLog_disabled,
+			"trafficAnalytics":     testAccAzureRMNetworkWatcherFlowLog_trafficAnalytics,
+			"retentionPolicy":      testAccAzureRMNetworkWatcherFlowLog_retentionPolicy,
+			"updateStorageAccount": testAccAzureRMNetworkWatcherFlowLog_updateStorageAccount,
+			"version":              testAccAzureRMNetworkWatcherFlowLog_version,
+			"importByNsgId":        testAccAzureRMNetworkWatcherFlowLog_importByNetworkSecurityGroupId,
+		},
 	}
 
 	for group, m := range testCases {
@@ -3,13 +3,14 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/notificationhubs/mgmt/2017-04-01/notificationhubs"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -24,6 +25,13 @@ func resourceArmNotificationHubAuthorizationRule() *schema.Resource {
 		},
 		// TODO: customizeDiff for send+listen when manage selected
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -78,7 +86,8 @@ func resourceArmNotificationHubAuthorizationRule() *schema.Resource {
 
 func resourceArmNotificationHubAuthorizationRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).notificationHubs.HubsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	notificationHubName := d.Get("notification_hub_name").(string)
@@ -89,7 +98,7 @@ func resourceArmNotificationHubAuthorizationRuleCreateUpdate(d *schema.ResourceD
 	send := d.Get("send").(bool)
 	listen := d.Get("listen").(bool)
 
-	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+	if meta.(*ArmClient).Features.RequiresImport && d.IsNewResource() {
 		existing, err := client.GetAuthorizationRule(ctx, resourceGroup, namespaceName, notificationHubName, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -102,11 +111,14 @@ func resourceArmNotificationHubAuthorizationRuleCreateUpdate(d *schema.ResourceD
 		}
 	}
 
-	locks.ByName(notificationHubName, notificationHubResourceName)
-	defer locks.UnlockByName(notificationHubName, notificationHubResourceName)
-
-	locks.ByName(namespaceName, notificationHubNamespaceResourceName)
-	defer locks.UnlockByName(namespaceName, notificationHubNamespaceResourceName)
+	namesToLock := []locks.NamesOfType{
+		{ResourceType: notificationHubResourceName, Names: &[]string{notificationHubName}},
+		{ResourceType: notificationHubNamespaceResourceName, Names: &[]string{namespaceName}},
+	}
+	if err := locks.MultipleByTypeWithContext(ctx, namesToLock...); err != nil {
+		return err
+	}
+	defer locks.UnlockMultipleByType(namesToLock...)
 
 	parameters := notificationhubs.SharedAccessAuthorizationRuleCreateOrUpdateParameters{
 		Properties: &notificationhubs.SharedAccessAuthorizationRuleProperties{
@@ -134,7 +146,8 @@ func resourceArmNotificationHubAuthorizationRuleCreateUpdate(d *schema.ResourceD
 
 func resourceArmNotificationHubAuthorizationRuleRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).notificationHubs.HubsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -181,7 +194,8 @@ func resourceArmNotificationHubAuthorizationRuleRead(d *schema.ResourceData, met
 
 func resourceArmNotificationHubAuthorizationRuleDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).notificationHubs.HubsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -192,11 +206,14 @@ func resourceArmNotificationHubAuthorizationRuleDelete(d *schema.ResourceData, m
 	notificationHubName := id.Path["notificationHubs"]
 	name := id.Path["AuthorizationRules"]
 
-	locks.ByName(notificationHubName, notificationHubResourceName)
-	defer locks.UnlockByName(notificationHubName, notificationHubResourceName)
-
-	locks.ByName(namespaceName, notificationHubNamespaceResourceName)
-	defer locks.UnlockByName(namespaceName, notificationHubNamespaceResourceName)
+	namesToLock := []locks.NamesOfType{
+		{ResourceType: notificationHubResourceName, Names: &[]string{notificationHubName}},
+		{ResourceType: notificationHubNamespaceResourceName, Names: &[]string{namespaceName}},
+	}
+	if err := locks.MultipleByTypeWithContext(ctx, namesToLock...); err != nil {
+		return err
+	}
+	defer locks.UnlockMultipleByType(namesToLock...)
 
 	resp, err := client.DeleteAuthorizationRule(ctx, resourceGroup, namespaceName, notificationHubName, name)
 	if err != nil {
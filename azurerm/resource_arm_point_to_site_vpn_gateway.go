@@ -0,0 +1,287 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	networkSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmPointToSiteVpnGateway() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmPointToSiteVpnGatewayCreateUpdate,
+		Read:   resourceArmPointToSiteVpnGatewayRead,
+		Update: resourceArmPointToSiteVpnGatewayCreateUpdate,
+		Delete: resourceArmPointToSiteVpnGatewayDelete,
+
+		Importer: azure.ValidateResourceIDPriorToImport(networkSvc.ValidatePointToSiteVpnGatewayID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
+			Delete: schema.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"virtual_hub_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     networkSvc.ValidateVirtualHubID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
+			},
+
+			"vpn_server_configuration_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     networkSvc.ValidateVpnServerConfigurationID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
+			},
+
+			"scale_unit": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"vpn_client_address_prefixes": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.CIDR,
+				},
+			},
+
+			"custom_route_address_prefixes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.CIDR,
+				},
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceArmPointToSiteVpnGatewayCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network.PointToSiteVpnGatewaysClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Point-to-Site VPN Gateway creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if meta.(*ArmClient).Features.RequiresImport && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Point-to-Site VPN Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_point_to_site_vpn_gateway", *existing.ID)
+		}
+	}
+
+	virtualHubId := d.Get("virtual_hub_id").(string)
+	parsedVirtualHubId, err := networkSvc.ParseVirtualHubID(virtualHubId)
+	if err != nil {
+		return err
+	}
+
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	vpnServerConfigurationId := d.Get("vpn_server_configuration_id").(string)
+	scaleUnit := d.Get("scale_unit").(int)
+	vpnClientAddressPrefixes := d.Get("vpn_client_address_prefixes").([]interface{})
+	customRouteAddressPrefixes := d.Get("custom_route_address_prefixes").([]interface{})
+	t := d.Get("tags").(map[string]interface{})
+
+	parameters := network.P2SVpnGateway{
+		Location: utils.String(location),
+		P2SVpnGatewayProperties: &network.P2SVpnGatewayProperties{
+			VirtualHub: &network.SubResource{
+				ID: utils.String(virtualHubId),
+			},
+			P2SVpnServerConfiguration: &network.SubResource{
+				ID: utils.String(vpnServerConfigurationId),
+			},
+			VpnGatewayScaleUnit: utils.Int32(int32(scaleUnit)),
+			VpnClientAddressPool: &network.AddressSpace{
+				AddressPrefixes: utils.ExpandStringSlice(vpnClientAddressPrefixes),
+			},
+		},
+		Tags: tags.Expand(t),
+	}
+
+	if len(customRouteAddressPrefixes) > 0 {
+		parameters.P2SVpnGatewayProperties.CustomRoutes = &network.AddressSpace{
+			AddressPrefixes: utils.ExpandStringSlice(customRouteAddressPrefixes),
+		}
+	}
+
+	// the Point-to-Site VPN Gateway is provisioned into the Virtual Hub
+	if err := locks.ByNameWithContext(ctx, parsedVirtualHubId.Name, virtualHubResourceName); err != nil {
+		return err
+	}
+	defer locks.UnlockByName(parsedVirtualHubId.Name, virtualHubResourceName)
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Point-to-Site VPN Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Point-to-Site VPN Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Point-to-Site VPN Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Point-to-Site VPN Gateway %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmPointToSiteVpnGatewayRead(d, meta)
+}
+
+func resourceArmPointToSiteVpnGatewayRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network.PointToSiteVpnGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := networkSvc.ParsePointToSiteVpnGatewayID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Point-to-Site VPN Gateway %q (Resource Group %q) was not found - removing from state", id.Name, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Point-to-Site VPN Gateway %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	if props := resp.P2SVpnGatewayProperties; props != nil {
+		virtualHubId := ""
+		if props.VirtualHub != nil && props.VirtualHub.ID != nil {
+			virtualHubId = *props.VirtualHub.ID
+		}
+		d.Set("virtual_hub_id", virtualHubId)
+
+		vpnServerConfigurationId := ""
+		if props.P2SVpnServerConfiguration != nil && props.P2SVpnServerConfiguration.ID != nil {
+			vpnServerConfigurationId = *props.P2SVpnServerConfiguration.ID
+		}
+		d.Set("vpn_server_configuration_id", vpnServerConfigurationId)
+
+		scaleUnit := 0
+		if props.VpnGatewayScaleUnit != nil {
+			scaleUnit = int(*props.VpnGatewayScaleUnit)
+		}
+		d.Set("scale_unit", scaleUnit)
+
+		vpnClientAddressPrefixes := make([]interface{}, 0)
+		if pool := props.VpnClientAddressPool; pool != nil {
+			vpnClientAddressPrefixes = utils.FlattenStringSlice(pool.AddressPrefixes)
+		}
+		if err := d.Set("vpn_client_address_prefixes", vpnClientAddressPrefixes); err != nil {
+			return fmt.Errorf("Error setting `vpn_client_address_prefixes`: %+v", err)
+		}
+
+		customRouteAddressPrefixes := make([]interface{}, 0)
+		if routes := props.CustomRoutes; routes != nil {
+			customRouteAddressPrefixes = utils.FlattenStringSlice(routes.AddressPrefixes)
+		}
+		if err := d.Set("custom_route_address_prefixes", customRouteAddressPrefixes); err != nil {
+			return fmt.Errorf("Error setting `custom_route_address_prefixes`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceArmPointToSiteVpnGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network.PointToSiteVpnGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := networkSvc.ParsePointToSiteVpnGatewayID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	parsedVirtualHubId, err := networkSvc.ParseVirtualHubID(d.Get("virtual_hub_id").(string))
+	if err != nil {
+		return err
+	}
+
+	if err := locks.ByNameWithContext(ctx, parsedVirtualHubId.Name, virtualHubResourceName); err != nil {
+		return err
+	}
+	defer locks.UnlockByName(parsedVirtualHubId.Name, virtualHubResourceName)
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		// deleted outside of Terraform
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Point-to-Site VPN Gateway %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for the deletion of Point-to-Site VPN Gateway %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+	}
+
+	return nil
+}
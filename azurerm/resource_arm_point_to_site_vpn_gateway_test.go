@@ -0,0 +1,223 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	networkSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMPointToSiteVpnGateway_basic(t *testing.T) {
+	resourceName := "azurerm_point_to_site_vpn_gateway.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPointToSiteVpnGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPointToSiteVpnGateway_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPointToSiteVpnGatewayExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPointToSiteVpnGateway_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_point_to_site_vpn_gateway.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPointToSiteVpnGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPointToSiteVpnGateway_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPointToSiteVpnGatewayExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMPointToSiteVpnGateway_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_point_to_site_vpn_gateway"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPointToSiteVpnGateway_update(t *testing.T) {
+	resourceName := "azurerm_point_to_site_vpn_gateway.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPointToSiteVpnGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPointToSiteVpnGateway_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPointToSiteVpnGatewayExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMPointToSiteVpnGateway_updated(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPointToSiteVpnGatewayExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "scale_unit", "2"),
+					resource.TestCheckResourceAttr(resourceName, "custom_route_address_prefixes.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMPointToSiteVpnGatewayExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Point-to-Site VPN Gateway not found: %s", resourceName)
+		}
+
+		id, err := networkSvc.ParsePointToSiteVpnGatewayID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).network.PointToSiteVpnGatewaysClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.Name); err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Point-to-Site VPN Gateway %q (Resource Group %q) does not exist", id.Name, id.ResourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on network.PointToSiteVpnGatewaysClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMPointToSiteVpnGatewayDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).network.PointToSiteVpnGatewaysClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_point_to_site_vpn_gateway" {
+			continue
+		}
+
+		id, err := networkSvc.ParsePointToSiteVpnGatewayID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.Name); err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Get on network.PointToSiteVpnGatewaysClient: %+v", err)
+			}
+		}
+
+		return nil
+	}
+
+	return nil
+}
+
+func testAccAzureRMPointToSiteVpnGateway_basic(rInt int, location string) string {
+	template := testAccAzureRMPointToSiteVpnGateway_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_point_to_site_vpn_gateway" "test" {
+  name                        = "acctestp2sVPNG-%d"
+  location                    = "${azurerm_resource_group.test.location}"
+  resource_group_name         = "${azurerm_resource_group.test.name}"
+  virtual_hub_id              = "${azurerm_virtual_hub.test.id}"
+  vpn_server_configuration_id = "${azurerm_vpn_server_configuration.test.id}"
+  scale_unit                  = 1
+  vpn_client_address_prefixes = ["10.1.0.0/24"]
+}
+`, template, rInt)
+}
+
+func testAccAzureRMPointToSiteVpnGateway_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMPointToSiteVpnGateway_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_point_to_site_vpn_gateway" "import" {
+  name                        = "${azurerm_point_to_site_vpn_gateway.test.name}"
+  location                    = "${azurerm_point_to_site_vpn_gateway.test.location}"
+  resource_group_name         = "${azurerm_point_to_site_vpn_gateway.test.resource_group_name}"
+  virtual_hub_id              = "${azurerm_point_to_site_vpn_gateway.test.virtual_hub_id}"
+  vpn_server_configuration_id = "${azurerm_point_to_site_vpn_gateway.test.vpn_server_configuration_id}"
+  scale_unit                  = 1
+  vpn_client_address_prefixes = ["10.1.0.0/24"]
+}
+`, template)
+}
+
+func testAccAzureRMPointToSiteVpnGateway_updated(rInt int, location string) string {
+	template := testAccAzureRMPointToSiteVpnGateway_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_point_to_site_vpn_gateway" "test" {
+  name                          = "acctestp2sVPNG-%d"
+  location                      = "${azurerm_resource_group.test.location}"
+  resource_group_name           = "${azurerm_resource_group.test.name}"
+  virtual_hub_id                = "${azurerm_virtual_hub.test.id}"
+  vpn_server_configuration_id   = "${azurerm_vpn_server_configuration.test.id}"
+  scale_unit                    = 2
+  vpn_client_address_prefixes   = ["10.1.0.0/24"]
+  custom_route_address_prefixes = ["10.2.0.0/24"]
+
+  tags = {
+    Hello = "World"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMPointToSiteVpnGateway_template(rInt int, location string) string {
+	template := testAccAzureRMVpnServerConfiguration_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub" "test" {
+  name                = "acctestvhub-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  virtual_wan_id      = "${azurerm_virtual_wan.test.id}"
+  address_prefix      = "10.0.0.0/24"
+}
+`, template, rInt)
+}
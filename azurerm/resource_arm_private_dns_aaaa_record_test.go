@@ -0,0 +1,303 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/privatedns/mgmt/2018-09-01/privatedns"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+)
+
+func TestAccAzureRMPrivateDnsAaaaRecord_basic(t *testing.T) {
+	resourceName := "azurerm_private_dns_aaaa_record.test"
+	ri := tf.AccRandTimeInt()
+	config := testAccAzureRMPrivateDnsAaaaRecord_basic(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateDnsAaaaRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateDnsAaaaRecordExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPrivateDnsAaaaRecord_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_private_dns_aaaa_record.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateDnsAaaaRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPrivateDnsAaaaRecord_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateDnsAaaaRecordExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMPrivateDnsAaaaRecord_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_private_dns_aaaa_record"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPrivateDnsAaaaRecord_updateRecords(t *testing.T) {
+	resourceName := "azurerm_private_dns_aaaa_record.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	preConfig := testAccAzureRMPrivateDnsAaaaRecord_basic(ri, location)
+	postConfig := testAccAzureRMPrivateDnsAaaaRecord_updateRecords(ri, location)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateDnsAaaaRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateDnsAaaaRecordExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "records.#", "2"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateDnsAaaaRecordExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "records.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPrivateDnsAaaaRecord_withTags(t *testing.T) {
+	resourceName := "azurerm_private_dns_aaaa_record.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	preConfig := testAccAzureRMPrivateDnsAaaaRecord_withTags(ri, location)
+	postConfig := testAccAzureRMPrivateDnsAaaaRecord_withTagsUpdate(ri, location)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateDnsAaaaRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateDnsAaaaRecordExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateDnsAaaaRecordExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMPrivateDnsAaaaRecordExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		aaaaName := rs.Primary.Attributes["name"]
+		zoneName := rs.Primary.Attributes["zone_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Private DNS AAAA record: %s", aaaaName)
+		}
+
+		conn := testAccProvider.Meta().(*ArmClient).privateDns.RecordSetsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := conn.Get(ctx, resourceGroup, zoneName, privatedns.AAAA, aaaaName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get AAAA RecordSet: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Private DNS AAAA record %s (resource group: %s) does not exist", aaaaName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMPrivateDnsAaaaRecordDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).privateDns.RecordSetsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_private_dns_aaaa_record" {
+			continue
+		}
+
+		aaaaName := rs.Primary.Attributes["name"]
+		zoneName := rs.Primary.Attributes["zone_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := conn.Get(ctx, resourceGroup, zoneName, privatedns.AAAA, aaaaName)
+
+		if err != nil {
+			if resp.StatusCode == http.StatusNotFound {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Private DNS AAAA record still exists:\n%#v", resp.RecordSetProperties)
+	}
+
+	return nil
+}
+
+func testAccAzureRMPrivateDnsAaaaRecord_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_private_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_private_dns_aaaa_record" "test" {
+  name                = "myaaaarecord%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  zone_name           = "${azurerm_private_dns_zone.test.name}"
+  ttl                 = 300
+  records             = ["fd5d:70bc:930e:d008::7334", "fd5d:70bc:930e:d008::7335"]
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMPrivateDnsAaaaRecord_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMPrivateDnsAaaaRecord_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_aaaa_record" "import" {
+  name                = "${azurerm_private_dns_aaaa_record.test.name}"
+  resource_group_name = "${azurerm_private_dns_aaaa_record.test.resource_group_name}"
+  zone_name           = "${azurerm_private_dns_aaaa_record.test.zone_name}"
+  ttl                 = 300
+  records             = ["fd5d:70bc:930e:d008::7334", "fd5d:70bc:930e:d008::7335"]
+}
+`, template)
+}
+
+func testAccAzureRMPrivateDnsAaaaRecord_updateRecords(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_private_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_private_dns_aaaa_record" "test" {
+  name                = "myaaaarecord%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  zone_name           = "${azurerm_private_dns_zone.test.name}"
+  ttl                 = 300
+  records             = ["fd5d:70bc:930e:d008::7334", "fd5d:70bc:930e:d008::7335", "fd5d:70bc:930e:d008::7336"]
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMPrivateDnsAaaaRecord_withTags(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_private_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_private_dns_aaaa_record" "test" {
+  name                = "myaaaarecord%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  zone_name           = "${azurerm_private_dns_zone.test.name}"
+  ttl                 = 300
+  records             = ["fd5d:70bc:930e:d008::7334", "fd5d:70bc:930e:d008::7335"]
+
+  tags = {
+    environment = "Production"
+    cost_center = "MSFT"
+  }
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMPrivateDnsAaaaRecord_withTagsUpdate(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_private_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_private_dns_aaaa_record" "test" {
+  name                = "myaaaarecord%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  zone_name           = "${azurerm_private_dns_zone.test.name}"
+  ttl                 = 300
+  records             = ["fd5d:70bc:930e:d008::7334", "fd5d:70bc:930e:d008::7335"]
+
+  tags = {
+    environment = "staging"
+  }
+}
+`, rInt, location, rInt, rInt)
+}
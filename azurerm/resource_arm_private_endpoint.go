@@ -0,0 +1,444 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	networkSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmPrivateEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmPrivateEndpointCreateUpdate,
+		Read:   resourceArmPrivateEndpointRead,
+		Update: resourceArmPrivateEndpointCreateUpdate,
+		Delete: resourceArmPrivateEndpointDelete,
+
+		Importer: azure.ValidateResourceIDPriorToImport(networkSvc.ValidatePrivateEndpointID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.PrivateLinkName,
+			},
+
+			"location": azure.SchemaLocation(),
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"subnet_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     networkSvc.ValidateSubnetID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
+			},
+
+			"private_service_connection": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validate.PrivateLinkName,
+						},
+
+						"is_manual_connection": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+
+						"private_connection_resource_id": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateFunc:     azure.ValidateResourceID,
+							DiffSuppressFunc: suppress.ResourceIDDifference,
+						},
+
+						"subresource_names": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+						},
+
+						"request_message": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 140),
+						},
+
+						"private_ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"network_interface_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceArmPrivateEndpointCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network.PrivateEndpointClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if meta.(*ArmClient).Features.RequiresImport && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Private Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_private_endpoint", *existing.ID)
+		}
+	}
+
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	subnetId := d.Get("subnet_id").(string)
+	t := d.Get("tags").(map[string]interface{})
+
+	connections := d.Get("private_service_connection").([]interface{})
+	connection := connections[0].(map[string]interface{})
+	isManual := connection["is_manual_connection"].(bool)
+	requestMessage := connection["request_message"].(string)
+	if !isManual && requestMessage != "" {
+		return fmt.Errorf("`request_message` can only be specified when `is_manual_connection` is set to `true`")
+	}
+
+	parsedSubnetId, err := networkSvc.ParseSubnetID(subnetId)
+	if err != nil {
+		return err
+	}
+
+	parameters := network.PrivateEndpoint{
+		Location: utils.String(location),
+		PrivateEndpointProperties: &network.PrivateEndpointProperties{
+			Subnet: &network.Subnet{
+				ID: utils.String(subnetId),
+			},
+		},
+		Tags: tags.Expand(t),
+	}
+
+	serviceConnections := expandArmPrivateEndpointServiceConnection(connection)
+	if isManual {
+		parameters.PrivateEndpointProperties.ManualPrivateLinkServiceConnections = serviceConnections
+	} else {
+		parameters.PrivateEndpointProperties.PrivateLinkServiceConnections = serviceConnections
+	}
+
+	// the Subnet is locked since the Private Endpoint (and it's Network Interface) is provisioned into it
+	if err := locks.ByNameWithContext(ctx, parsedSubnetId.Name, subnetResourceName); err != nil {
+		return err
+	}
+	defer locks.UnlockByName(parsedSubnetId.Name, subnetResourceName)
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Private Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Private Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	// connections which aren't manual are approved automatically, however this happens asynchronously
+	if !isManual {
+		log.Printf("[DEBUG] Waiting for the Connection for Private Endpoint %q (Resource Group %q) to be approved", name, resourceGroup)
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"Pending"},
+			Target:     []string{"Approved"},
+			Refresh:    privateEndpointConnectionStatusRefreshFunc(ctx, client, resourceGroup, name),
+			Timeout:    d.Timeout(schema.TimeoutCreate),
+			MinTimeout: 5 * time.Second,
+		}
+		if !d.IsNewResource() {
+			stateConf.Timeout = d.Timeout(schema.TimeoutUpdate)
+		}
+
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("Error waiting for the Connection for Private Endpoint %q (Resource Group %q) to be approved: %+v", name, resourceGroup, err)
+		}
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Private Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read ID of Private Endpoint %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmPrivateEndpointRead(d, meta)
+}
+
+func resourceArmPrivateEndpointRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network.PrivateEndpointClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := networkSvc.ParsePrivateEndpointID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Private Endpoint %q does not exist - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error reading Private Endpoint %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	if props := resp.PrivateEndpointProperties; props != nil {
+		subnetId := ""
+		if props.Subnet != nil && props.Subnet.ID != nil {
+			subnetId = *props.Subnet.ID
+		}
+		d.Set("subnet_id", subnetId)
+
+		privateIpAddress, err := retrievePrivateEndpointPrivateIPAddress(ctx, meta, props.NetworkInterfaces)
+		if err != nil {
+			return fmt.Errorf("Error retrieving the Private IP Address for Private Endpoint %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+
+		if err := d.Set("private_service_connection", flattenArmPrivateEndpointServiceConnection(props.PrivateLinkServiceConnections, props.ManualPrivateLinkServiceConnections, privateIpAddress)); err != nil {
+			return fmt.Errorf("Error setting `private_service_connection`: %+v", err)
+		}
+
+		if err := d.Set("network_interface_ids", flattenArmPrivateLinkServiceNetworkInterfaceIDs(props.NetworkInterfaces)); err != nil {
+			return fmt.Errorf("Error setting `network_interface_ids`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceArmPrivateEndpointDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network.PrivateEndpointClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := networkSvc.ParsePrivateEndpointID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	parsedSubnetId, err := networkSvc.ParseSubnetID(d.Get("subnet_id").(string))
+	if err != nil {
+		return err
+	}
+
+	if err := locks.ByNameWithContext(ctx, parsedSubnetId.Name, subnetResourceName); err != nil {
+		return err
+	}
+	defer locks.UnlockByName(parsedSubnetId.Name, subnetResourceName)
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Private Endpoint %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Private Endpoint %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func privateEndpointConnectionStatusRefreshFunc(ctx context.Context, client *network.PrivateEndpointsClient, resourceGroup, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			return nil, "", fmt.Errorf("Error retrieving Private Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		status := "Pending"
+		if props := resp.PrivateEndpointProperties; props != nil && props.PrivateLinkServiceConnections != nil {
+			for _, connection := range *props.PrivateLinkServiceConnections {
+				if connection.PrivateLinkServiceConnectionProperties == nil || connection.PrivateLinkServiceConnectionState == nil {
+					continue
+				}
+
+				if v := connection.PrivateLinkServiceConnectionState.Status; v != nil && *v != "" {
+					status = *v
+				}
+			}
+		}
+
+		if strings.EqualFold(status, "Rejected") || strings.EqualFold(status, "Disconnected") {
+			return resp, status, fmt.Errorf("the Connection was %s", strings.ToLower(status))
+		}
+
+		return resp, status, nil
+	}
+}
+
+func retrievePrivateEndpointPrivateIPAddress(ctx context.Context, meta interface{}, input *[]network.Interface) (string, error) {
+	client := meta.(*ArmClient).network.InterfacesClient
+
+	if input == nil || len(*input) == 0 || (*input)[0].ID == nil {
+		return "", nil
+	}
+
+	// the Network Interface for a Private Endpoint is managed by Azure, but contains the Private IP Address
+	nicId, err := networkSvc.ParseNetworkInterfaceID(*(*input)[0].ID)
+	if err != nil {
+		return "", err
+	}
+
+	nic, err := client.Get(ctx, nicId.ResourceGroup, nicId.Name, "")
+	if err != nil {
+		return "", fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", nicId.Name, nicId.ResourceGroup, err)
+	}
+
+	if props := nic.InterfacePropertiesFormat; props != nil && props.IPConfigurations != nil {
+		for _, config := range *props.IPConfigurations {
+			if config.InterfaceIPConfigurationPropertiesFormat != nil && config.InterfaceIPConfigurationPropertiesFormat.PrivateIPAddress != nil {
+				return *config.InterfaceIPConfigurationPropertiesFormat.PrivateIPAddress, nil
+			}
+		}
+	}
+
+	return "", nil
+}
+
+func expandArmPrivateEndpointServiceConnection(input map[string]interface{}) *[]network.PrivateLinkServiceConnection {
+	connection := network.PrivateLinkServiceConnection{
+		Name: utils.String(input["name"].(string)),
+		PrivateLinkServiceConnectionProperties: &network.PrivateLinkServiceConnectionProperties{
+			PrivateLinkServiceID: utils.String(input["private_connection_resource_id"].(string)),
+			GroupIds:             utils.ExpandStringSlice(input["subresource_names"].([]interface{})),
+		},
+	}
+
+	if v := input["request_message"].(string); v != "" {
+		connection.PrivateLinkServiceConnectionProperties.RequestMessage = utils.String(v)
+	}
+
+	return &[]network.PrivateLinkServiceConnection{connection}
+}
+
+func flattenArmPrivateEndpointServiceConnection(serviceConnections *[]network.PrivateLinkServiceConnection, manualServiceConnections *[]network.PrivateLinkServiceConnection, privateIpAddress string) []interface{} {
+	results := make([]interface{}, 0)
+
+	flatten := func(input *[]network.PrivateLinkServiceConnection, isManual bool) {
+		if input == nil {
+			return
+		}
+
+		for _, item := range *input {
+			name := ""
+			if item.Name != nil {
+				name = *item.Name
+			}
+
+			privateConnectionResourceId := ""
+			subresourceNames := make([]interface{}, 0)
+			requestMessage := ""
+			status := ""
+			if props := item.PrivateLinkServiceConnectionProperties; props != nil {
+				if props.PrivateLinkServiceID != nil {
+					privateConnectionResourceId = *props.PrivateLinkServiceID
+				}
+
+				subresourceNames = utils.FlattenStringSlice(props.GroupIds)
+
+				if props.RequestMessage != nil {
+					requestMessage = *props.RequestMessage
+				}
+
+				if state := props.PrivateLinkServiceConnectionState; state != nil && state.Status != nil {
+					status = *state.Status
+				}
+			}
+
+			results = append(results, map[string]interface{}{
+				"name":                           name,
+				"is_manual_connection":           isManual,
+				"private_connection_resource_id": privateConnectionResourceId,
+				"subresource_names":              subresourceNames,
+				"request_message":                requestMessage,
+				"private_ip_address":             privateIpAddress,
+				"status":                         status,
+			})
+		}
+	}
+
+	flatten(serviceConnections, false)
+	flatten(manualServiceConnections, true)
+
+	return results
+}
@@ -0,0 +1,219 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMPrivateEndpoint_basic(t *testing.T) {
+	resourceName := "azurerm_private_endpoint.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPrivateEndpoint_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "private_service_connection.0.status", "Approved"),
+					resource.TestCheckResourceAttrSet(resourceName, "private_service_connection.0.private_ip_address"),
+					resource.TestCheckResourceAttr(resourceName, "network_interface_ids.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPrivateEndpoint_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_private_endpoint.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPrivateEndpoint_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateEndpointExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMPrivateEndpoint_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_private_endpoint"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPrivateEndpoint_requestMessage(t *testing.T) {
+	resourceName := "azurerm_private_endpoint.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPrivateEndpoint_requestMessage(ri, location, "CATS: ALL YOUR BASE ARE BELONG TO US."),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "private_service_connection.0.status", "Pending"),
+					resource.TestCheckResourceAttr(resourceName, "private_service_connection.0.request_message", "CATS: ALL YOUR BASE ARE BELONG TO US."),
+				),
+			},
+			{
+				Config: testAccAzureRMPrivateEndpoint_requestMessage(ri, location, "CAPTAIN: WHAT YOU SAY!!"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "private_service_connection.0.request_message", "CAPTAIN: WHAT YOU SAY!!"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMPrivateEndpointExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Private Endpoint not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).network.PrivateEndpointClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		if resp, err := client.Get(ctx, resourceGroup, name, ""); err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Private Endpoint %q (Resource Group %q) does not exist", name, resourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on network.PrivateEndpointClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMPrivateEndpointDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).network.PrivateEndpointClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_private_endpoint" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		if resp, err := client.Get(ctx, resourceGroup, name, ""); err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Get on network.PrivateEndpointClient: %+v", err)
+			}
+		}
+
+		return nil
+	}
+
+	return nil
+}
+
+func testAccAzureRMPrivateEndpoint_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_subnet" "endpoint" {
+  name                 = "acctestsnet-endpoint-%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.5.2.0/24"
+
+  private_endpoint_network_policies_enabled = false
+}
+`, testAccAzureRMPrivateLinkService_basic(rInt, location), rInt)
+}
+
+func testAccAzureRMPrivateEndpoint_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_endpoint" "test" {
+  name                = "acctest-privatelink-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  subnet_id           = "${azurerm_subnet.endpoint.id}"
+
+  private_service_connection {
+    name                           = "acctest-privatelink-psc-%d"
+    private_connection_resource_id = "${azurerm_private_link_service.test.id}"
+    is_manual_connection           = false
+  }
+}
+`, testAccAzureRMPrivateEndpoint_template(rInt, location), rInt, rInt)
+}
+
+func testAccAzureRMPrivateEndpoint_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_endpoint" "import" {
+  name                = "${azurerm_private_endpoint.test.name}"
+  location            = "${azurerm_private_endpoint.test.location}"
+  resource_group_name = "${azurerm_private_endpoint.test.resource_group_name}"
+  subnet_id           = "${azurerm_private_endpoint.test.subnet_id}"
+
+  private_service_connection {
+    name                           = "acctest-privatelink-psc-%d"
+    private_connection_resource_id = "${azurerm_private_link_service.test.id}"
+    is_manual_connection           = false
+  }
+}
+`, testAccAzureRMPrivateEndpoint_basic(rInt, location), rInt)
+}
+
+func testAccAzureRMPrivateEndpoint_requestMessage(rInt int, location string, msg string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_endpoint" "test" {
+  name                = "acctest-privatelink-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  subnet_id           = "${azurerm_subnet.endpoint.id}"
+
+  private_service_connection {
+    name                           = "acctest-privatelink-psc-%d"
+    private_connection_resource_id = "${azurerm_private_link_service.test.id}"
+    is_manual_connection           = true
+    request_message                = %q
+  }
+}
+`, testAccAzureRMPrivateEndpoint_template(rInt, location), rInt, rInt, msg)
+}
@@ -0,0 +1,406 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	networkSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmPrivateLinkService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmPrivateLinkServiceCreateUpdate,
+		Read:   resourceArmPrivateLinkServiceRead,
+		Update: resourceArmPrivateLinkServiceCreateUpdate,
+		Delete: resourceArmPrivateLinkServiceDelete,
+
+		Importer: azure.ValidateResourceIDPriorToImport(networkSvc.ValidatePrivateLinkServiceID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.PrivateLinkName,
+			},
+
+			"location": azure.SchemaLocation(),
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"auto_approval_subscription_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.UUID,
+				},
+				Set: schema.HashString,
+			},
+
+			"visibility_subscription_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.UUID,
+				},
+				Set: schema.HashString,
+			},
+
+			// only a single NAT IP Configuration can be marked as primary
+			"nat_ip_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 8,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.PrivateLinkName,
+						},
+
+						"private_ip_address": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.IPv4Address,
+						},
+
+						"private_ip_address_version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(network.IPv4),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.IPv4),
+							}, false),
+						},
+
+						"subnet_id": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     networkSvc.ValidateSubnetID,
+							DiffSuppressFunc: suppress.ResourceIDDifference,
+						},
+
+						"primary": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"load_balancer_frontend_ip_configuration_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: networkSvc.ValidateLoadBalancerFrontendIPConfigurationID,
+				},
+				Set: schema.HashString,
+			},
+
+			"alias": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"network_interface_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceArmPrivateLinkServiceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network.PrivateLinkServiceClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if meta.(*ArmClient).Features.RequiresImport && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Private Link Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_private_link_service", *existing.ID)
+		}
+	}
+
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	t := d.Get("tags").(map[string]interface{})
+
+	parameters := network.PrivateLinkService{
+		Location: utils.String(location),
+		PrivateLinkServiceProperties: &network.PrivateLinkServiceProperties{
+			AutoApproval: &network.PrivateLinkServicePropertiesAutoApproval{
+				Subscriptions: utils.ExpandStringSlice(d.Get("auto_approval_subscription_ids").(*schema.Set).List()),
+			},
+			Visibility: &network.PrivateLinkServicePropertiesVisibility{
+				Subscriptions: utils.ExpandStringSlice(d.Get("visibility_subscription_ids").(*schema.Set).List()),
+			},
+			IPConfigurations:                     expandArmPrivateLinkServiceIPConfiguration(d.Get("nat_ip_configuration").([]interface{})),
+			LoadBalancerFrontendIPConfigurations: expandArmPrivateLinkServiceFrontendIPConfiguration(d.Get("load_balancer_frontend_ip_configuration_ids").(*schema.Set).List()),
+		},
+		Tags: tags.Expand(t),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Private Link Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Private Link Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Private Link Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read ID of Private Link Service %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmPrivateLinkServiceRead(d, meta)
+}
+
+func resourceArmPrivateLinkServiceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network.PrivateLinkServiceClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := networkSvc.ParsePrivateLinkServiceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Private Link Service %q does not exist - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error reading Private Link Service %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	if props := resp.PrivateLinkServiceProperties; props != nil {
+		d.Set("alias", props.Alias)
+
+		var autoApprovalSubscriptionIds []interface{}
+		if props.AutoApproval != nil {
+			autoApprovalSubscriptionIds = utils.FlattenStringSlice(props.AutoApproval.Subscriptions)
+		}
+		if err := d.Set("auto_approval_subscription_ids", schema.NewSet(schema.HashString, autoApprovalSubscriptionIds)); err != nil {
+			return fmt.Errorf("Error setting `auto_approval_subscription_ids`: %+v", err)
+		}
+
+		var visibilitySubscriptionIds []interface{}
+		if props.Visibility != nil {
+			visibilitySubscriptionIds = utils.FlattenStringSlice(props.Visibility.Subscriptions)
+		}
+		if err := d.Set("visibility_subscription_ids", schema.NewSet(schema.HashString, visibilitySubscriptionIds)); err != nil {
+			return fmt.Errorf("Error setting `visibility_subscription_ids`: %+v", err)
+		}
+
+		if err := d.Set("nat_ip_configuration", flattenArmPrivateLinkServiceIPConfiguration(props.IPConfigurations)); err != nil {
+			return fmt.Errorf("Error setting `nat_ip_configuration`: %+v", err)
+		}
+
+		if err := d.Set("load_balancer_frontend_ip_configuration_ids", schema.NewSet(schema.HashString, flattenArmPrivateLinkServiceFrontendIPConfiguration(props.LoadBalancerFrontendIPConfigurations))); err != nil {
+			return fmt.Errorf("Error setting `load_balancer_frontend_ip_configuration_ids`: %+v", err)
+		}
+
+		if err := d.Set("network_interface_ids", flattenArmPrivateLinkServiceNetworkInterfaceIDs(props.NetworkInterfaces)); err != nil {
+			return fmt.Errorf("Error setting `network_interface_ids`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceArmPrivateLinkServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network.PrivateLinkServiceClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := networkSvc.ParsePrivateLinkServiceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Private Link Service %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Private Link Service %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandArmPrivateLinkServiceIPConfiguration(input []interface{}) *[]network.PrivateLinkServiceIPConfiguration {
+	results := make([]network.PrivateLinkServiceIPConfiguration, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		privateIpAddress := v["private_ip_address"].(string)
+
+		result := network.PrivateLinkServiceIPConfiguration{
+			Name: utils.String(v["name"].(string)),
+			PrivateLinkServiceIPConfigurationProperties: &network.PrivateLinkServiceIPConfigurationProperties{
+				PrivateIPAllocationMethod: network.Dynamic,
+				PrivateIPAddressVersion:   network.IPVersion(v["private_ip_address_version"].(string)),
+				Subnet: &network.Subnet{
+					ID: utils.String(v["subnet_id"].(string)),
+				},
+				Primary: utils.Bool(v["primary"].(bool)),
+			},
+		}
+
+		if privateIpAddress != "" {
+			result.PrivateLinkServiceIPConfigurationProperties.PrivateIPAddress = utils.String(privateIpAddress)
+			result.PrivateLinkServiceIPConfigurationProperties.PrivateIPAllocationMethod = network.Static
+		}
+
+		results = append(results, result)
+	}
+
+	return &results
+}
+
+func flattenArmPrivateLinkServiceIPConfiguration(input *[]network.PrivateLinkServiceIPConfiguration) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		privateIpAddress := ""
+		privateIpAddressVersion := ""
+		subnetId := ""
+		primary := false
+		if props := item.PrivateLinkServiceIPConfigurationProperties; props != nil {
+			// the Private IP Address is only set by the user when it's Static
+			if props.PrivateIPAddress != nil && props.PrivateIPAllocationMethod == network.Static {
+				privateIpAddress = *props.PrivateIPAddress
+			}
+
+			privateIpAddressVersion = string(props.PrivateIPAddressVersion)
+
+			if props.Subnet != nil && props.Subnet.ID != nil {
+				subnetId = *props.Subnet.ID
+			}
+
+			if props.Primary != nil {
+				primary = *props.Primary
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                       name,
+			"private_ip_address":         privateIpAddress,
+			"private_ip_address_version": privateIpAddressVersion,
+			"subnet_id":                  subnetId,
+			"primary":                    primary,
+		})
+	}
+
+	return results
+}
+
+func expandArmPrivateLinkServiceFrontendIPConfiguration(input []interface{}) *[]network.FrontendIPConfiguration {
+	results := make([]network.FrontendIPConfiguration, 0)
+
+	for _, item := range input {
+		results = append(results, network.FrontendIPConfiguration{
+			ID: utils.String(item.(string)),
+		})
+	}
+
+	return &results
+}
+
+func flattenArmPrivateLinkServiceFrontendIPConfiguration(input *[]network.FrontendIPConfiguration) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		if item.ID != nil {
+			results = append(results, *item.ID)
+		}
+	}
+
+	return results
+}
+
+func flattenArmPrivateLinkServiceNetworkInterfaceIDs(input *[]network.Interface) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		if item.ID != nil {
+			results = append(results, *item.ID)
+		}
+	}
+
+	return results
+}
@@ -2,6 +2,7 @@ package azurerm
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2018-01-10/siterecovery"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -9,7 +10,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	networkSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -22,6 +24,12 @@ func resourceArmRecoveryServicesNetworkMapping() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -53,15 +61,15 @@ func resourceArmRecoveryServicesNetworkMapping() *schema.Resource {
 				Type:             schema.TypeString,
 				Required:         true,
 				ForceNew:         true,
-				ValidateFunc:     azure.ValidateResourceID,
-				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc:     networkSvc.ValidateVirtualNetworkID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
 			},
 			"target_network_id": {
 				Type:             schema.TypeString,
 				Required:         true,
 				ForceNew:         true,
-				ValidateFunc:     azure.ValidateResourceID,
-				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc:     networkSvc.ValidateVirtualNetworkID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
 			},
 		},
 	}
@@ -77,7 +85,8 @@ func resourceArmRecoveryNetworkMappingCreate(d *schema.ResourceData, meta interf
 	name := d.Get("name").(string)
 
 	client := meta.(*ArmClient).recoveryServices.NetworkMappingClient(resGroup, vaultName)
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	//get network name from id
 	parsedSourceNetworkId, err := azure.ParseAzureResourceID(sourceNetworkId)
@@ -92,7 +101,7 @@ func resourceArmRecoveryNetworkMappingCreate(d *schema.ResourceData, meta interf
 		}
 	}
 
-	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+	if meta.(*ArmClient).Features.RequiresImport && d.IsNewResource() {
 		existing, err := client.Get(ctx, fabricName, sourceNetworkName, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -145,7 +154,8 @@ func resourceArmRecoveryNetworkMappingRead(d *schema.ResourceData, meta interfac
 	name := id.Path["replicationNetworkMappings"]
 
 	client := meta.(*ArmClient).recoveryServices.NetworkMappingClient(resGroup, vaultName)
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resp, err := client.Get(ctx, fabricName, networkName, name)
 	if err != nil {
@@ -187,7 +197,8 @@ func resourceArmRecoveryNetworkMappingDelete(d *schema.ResourceData, meta interf
 	name := id.Path["replicationNetworkMappings"]
 
 	client := meta.(*ArmClient).recoveryServices.NetworkMappingClient(resGroup, vaultName)
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	future, err := client.Delete(ctx, fabricName, networkName, name)
 	if err != nil {
@@ -4,9 +4,9 @@ import (
 	"bytes"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
 	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2018-01-10/siterecovery"
@@ -16,6 +16,9 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	computeSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute"
+	resourceSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -28,6 +31,12 @@ func resourceArmRecoveryServicesReplicatedVm() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(120 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -53,22 +62,22 @@ func resourceArmRecoveryServicesReplicatedVm() *schema.Resource {
 				Type:             schema.TypeString,
 				Required:         true,
 				ForceNew:         true,
-				ValidateFunc:     azure.ValidateResourceID,
-				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc:     computeSvc.ValidateVirtualMachineID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
 			},
 			"target_recovery_fabric_id": {
 				Type:             schema.TypeString,
 				Required:         true,
 				ForceNew:         true,
 				ValidateFunc:     azure.ValidateResourceID,
-				DiffSuppressFunc: suppress.CaseDifference,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
 			},
 			"recovery_replication_policy_id": {
 				Type:             schema.TypeString,
 				Required:         true,
 				ForceNew:         true,
 				ValidateFunc:     azure.ValidateResourceID,
-				DiffSuppressFunc: suppress.CaseDifference,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
 			},
 			"source_recovery_protection_container_name": {
 				Type:         schema.TypeString,
@@ -81,21 +90,21 @@ func resourceArmRecoveryServicesReplicatedVm() *schema.Resource {
 				Required:         true,
 				ForceNew:         true,
 				ValidateFunc:     azure.ValidateResourceID,
-				DiffSuppressFunc: suppress.CaseDifference,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
 			},
 			"target_resource_group_id": {
 				Type:             schema.TypeString,
 				Required:         true,
 				ForceNew:         true,
-				ValidateFunc:     azure.ValidateResourceID,
-				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc:     resourceSvc.ValidateResourceGroupID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
 			},
 			"target_availability_set_id": {
 				Type:             schema.TypeString,
 				Optional:         true,
 				ForceNew:         true,
 				ValidateFunc:     azure.ValidateResourceID,
-				DiffSuppressFunc: suppress.CaseDifference,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
 			},
 			"managed_disk": {
 				Type:       schema.TypeSet,
@@ -110,21 +119,21 @@ func resourceArmRecoveryServicesReplicatedVm() *schema.Resource {
 							Required:         true,
 							ForceNew:         true,
 							ValidateFunc:     validate.NoEmptyStrings,
-							DiffSuppressFunc: suppress.CaseDifference,
+							DiffSuppressFunc: suppress.ResourceIDDifference,
 						},
 						"staging_storage_account_id": {
 							Type:             schema.TypeString,
 							Required:         true,
 							ForceNew:         true,
 							ValidateFunc:     azure.ValidateResourceID,
-							DiffSuppressFunc: suppress.CaseDifference,
+							DiffSuppressFunc: suppress.ResourceIDDifference,
 						},
 						"target_resource_group_id": {
 							Type:             schema.TypeString,
 							Required:         true,
 							ForceNew:         true,
-							ValidateFunc:     azure.ValidateResourceID,
-							DiffSuppressFunc: suppress.CaseDifference,
+							ValidateFunc:     resourceSvc.ValidateResourceGroupID,
+							DiffSuppressFunc: suppress.ResourceIDDifference,
 						},
 						"target_disk_type": {
 							Type:     schema.TypeString,
@@ -177,9 +186,10 @@ func resourceArmRecoveryReplicatedItemCreate(d *schema.ResourceData, meta interf
 	}
 
 	client := meta.(*ArmClient).recoveryServices.ReplicationMigrationItemsClient(resGroup, vaultName)
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+	if meta.(*ArmClient).Features.RequiresImport && d.IsNewResource() {
 		existing, err := client.Get(ctx, fabricName, sourceProtectionContainerName, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -253,7 +263,8 @@ func resourceArmRecoveryReplicatedItemRead(d *schema.ResourceData, meta interfac
 	name := id.Path["replicationProtectedItems"]
 
 	client := meta.(*ArmClient).recoveryServices.ReplicationMigrationItemsClient(resGroup, vaultName)
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resp, err := client.Get(ctx, fabricName, protectionContainerName, name)
 	if err != nil {
@@ -316,7 +327,8 @@ func resourceArmRecoveryReplicatedItemDelete(d *schema.ResourceData, meta interf
 	}
 
 	client := meta.(*ArmClient).recoveryServices.ReplicationMigrationItemsClient(resGroup, vaultName)
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	future, err := client.Delete(ctx, fabricName, protectionContainerName, name, disableProtectionInput)
 	if err != nil {
 		return fmt.Errorf("Error deleting recovery services replicated vm %s (vault %s): %+v", name, vaultName, err)
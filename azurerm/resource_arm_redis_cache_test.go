@@ -5,7 +5,6 @@ import (
 	"net/http"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -306,7 +305,7 @@ func TestAccAzureRMRedisCache_BackupDisabled(t *testing.T) {
 func TestAccAzureRMRedisCache_BackupEnabled(t *testing.T) {
 	resourceName := "azurerm_redis_cache.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	config := testAccAzureRMRedisCacheBackupEnabled(ri, rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -338,7 +337,7 @@ func TestAccAzureRMRedisCache_BackupEnabled(t *testing.T) {
 func TestAccAzureRMRedisCache_BackupEnabledDisabled(t *testing.T) {
 	resourceName := "azurerm_redis_cache.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	location := testLocation()
 	config := testAccAzureRMRedisCacheBackupEnabled(ri, rs, location)
 	updatedConfig := testAccAzureRMRedisCacheBackupDisabled(ri, location)
@@ -377,7 +376,7 @@ func TestAccAzureRMRedisCache_BackupEnabledDisabled(t *testing.T) {
 func TestAccAzureRMRedisCache_AOFBackupEnabled(t *testing.T) {
 	resourceName := "azurerm_redis_cache.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	config := testAccAzureRMRedisCacheAOFBackupEnabled(ri, rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -405,7 +404,7 @@ func TestAccAzureRMRedisCache_AOFBackupEnabled(t *testing.T) {
 func TestAccAzureRMRedisCache_AOFBackupEnabledDisabled(t *testing.T) {
 	resourceName := "azurerm_redis_cache.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	location := testLocation()
 	config := testAccAzureRMRedisCacheAOFBackupEnabled(ri, rs, location)
 	updatedConfig := testAccAzureRMRedisCacheAOFBackupDisabled(ri, location)
@@ -623,7 +622,7 @@ func testCheckAzureRMRedisCacheDestroy(s *terraform.State) error {
 func TestAccAzureRMRedisCache_SubscribeAllEvents(t *testing.T) {
 	resourceName := "azurerm_redis_cache.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	config := testAccAzureRMRedisCacheSubscribeAllEvents(ri, rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1,28 +1,44 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	resourceSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+// resourceGroupNestedResourcesTimeout is how long we wait for the list of Resources within a Resource Group
+// to become consistent before raising an error that the Resource Group still contains Resources
+const resourceGroupNestedResourcesTimeout = 3 * time.Minute
+
 func resourceArmResourceGroup() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmResourceGroupCreateUpdate,
 		Read:   resourceArmResourceGroupRead,
 		Update: resourceArmResourceGroupCreateUpdate,
 		Delete: resourceArmResourceGroupDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+
+		Importer: azure.ValidateResourceIDPriorToImport(resourceSvc.ValidateResourceGroupID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -37,13 +53,14 @@ func resourceArmResourceGroup() *schema.Resource {
 
 func resourceArmResourceGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).resource.GroupsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	t := d.Get("tags").(map[string]interface{})
 
-	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+	if meta.(*ArmClient).Features.RequiresImport && d.IsNewResource() {
 		existing, err := client.Get(ctx, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -77,14 +94,15 @@ func resourceArmResourceGroupCreateUpdate(d *schema.ResourceData, meta interface
 
 func resourceArmResourceGroupRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).resource.GroupsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := azure.ParseAzureResourceID(d.Id())
+	id, err := resourceSvc.ParseResourceGroupID(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error parsing Azure Resource ID %q: %+v", d.Id(), err)
+		return err
 	}
 
-	name := id.ResourceGroup
+	name := id.Name
 
 	resp, err := client.Get(ctx, name)
 	if err != nil {
@@ -106,14 +124,74 @@ func resourceArmResourceGroupRead(d *schema.ResourceData, meta interface{}) erro
 
 func resourceArmResourceGroupDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).resource.GroupsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := azure.ParseAzureResourceID(d.Id())
+	id, err := resourceSvc.ParseResourceGroupID(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error parsing Azure Resource ID %q: %+v", d.Id(), err)
+		return err
 	}
 
-	name := id.ResourceGroup
+	name := id.Name
+
+	if meta.(*ArmClient).Features.ResourceGroup.PreventDeletionIfContainsResources {
+		resourcesClient := meta.(*ArmClient).resource.ResourcesClient
+
+		// Resources managed by Terraform within this Resource Group will have been deleted by this point,
+		// as such anything remaining has been provisioned through some other means. However the list of
+		// Resources is eventually consistent - so Resources which were just deleted can continue to be
+		// returned for a short while, as such we poll for a few minutes before raising an error
+		pollingTimeout := resourceGroupNestedResourcesPollingTimeout(ctx)
+		if pollingTimeout <= 0 {
+			return fmt.Errorf("Error listing Resources within Resource Group %q: %+v", name, context.DeadlineExceeded)
+		}
+
+		nestedResourceIds := make([]string, 0)
+		resourceGroupWasNotFound := false
+		err := resource.Retry(pollingTimeout, func() *resource.RetryError {
+			results, err := resourcesClient.ListByResourceGroupComplete(ctx, name, "", "", utils.Int32(int32(500)))
+			if err != nil {
+				if utils.ResponseWasNotFound(results.Response().Response) {
+					resourceGroupWasNotFound = true
+					return nil
+				}
+
+				return resource.NonRetryableError(fmt.Errorf("Error listing Resources within Resource Group %q: %+v", name, err))
+			}
+
+			nestedResourceIds = make([]string, 0)
+			for results.NotDone() {
+				val := results.Value()
+				if val.ID != nil {
+					nestedResourceIds = append(nestedResourceIds, *val.ID)
+				}
+
+				if err := results.NextWithContext(ctx); err != nil {
+					return resource.NonRetryableError(fmt.Errorf("Error retrieving next page of Resources within Resource Group %q: %+v", name, err))
+				}
+			}
+
+			if len(nestedResourceIds) > 0 {
+				log.Printf("[DEBUG] Resource Group %q still contains %d Resources - waiting for the list of Resources to become consistent..", name, len(nestedResourceIds))
+				return resource.RetryableError(resourceGroupContainsItemsError(name, nestedResourceIds))
+			}
+
+			return nil
+		})
+
+		if resourceGroupWasNotFound {
+			log.Printf("[DEBUG] Resource Group %q was not found - assuming it's been deleted", name)
+			return nil
+		}
+
+		if err != nil {
+			if len(nestedResourceIds) > 0 {
+				return resourceGroupContainsItemsError(name, nestedResourceIds)
+			}
+
+			return err
+		}
+	}
 
 	deleteFuture, err := client.Delete(ctx, name)
 	if err != nil {
@@ -135,3 +213,50 @@ func resourceArmResourceGroupDelete(d *schema.ResourceData, meta interface{}) er
 
 	return nil
 }
+
+// resourceGroupNestedResourcesPollingTimeout returns how long to poll for the list of Resources within a Resource
+// Group to become consistent, which is limited to the time remaining before the deadline of the specified context
+func resourceGroupNestedResourcesPollingTimeout(ctx context.Context) time.Duration {
+	timeout := resourceGroupNestedResourcesTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		if remaining := time.Until(deadline); remaining < timeout {
+			timeout = remaining
+		}
+	}
+
+	return timeout
+}
+
+func resourceGroupContainsItemsError(name string, nestedResourceIds []string) error {
+	formattedResourceUris := make([]string, 0)
+	for _, id := range nestedResourceIds {
+		formattedResourceUris = append(formattedResourceUris, fmt.Sprintf("* `%s`", id))
+	}
+	sort.Strings(formattedResourceUris)
+
+	return fmt.Errorf(`Error deleting Resource Group %[1]q: the Resource Group still contains Resources.
+
+Terraform is configured to check for Resources within the Resource Group when deleting the Resource Group - and
+raise an error if nested Resources still exist to avoid unintentionally deleting these Resources.
+
+Terraform has detected that the following Resources still exist within the Resource Group:
+
+%[2]s
+
+This feature is intended to avoid the unintentional destruction of nested Resources provisioned through some
+other means (for example, the Azure Portal or an ARM Template Deployment) - as such you must either remove these
+Resources, or disable this behaviour using the feature flag %[3]s within the %[4]s block when
+configuring the Provider, for example:
+
+provider "azurerm" {
+  features {
+    resource_group {
+      prevent_deletion_if_contains_resources = false
+    }
+  }
+}
+
+When that feature flag is set, Terraform will skip checking for any Resources within the Resource Group and
+delete this using the Azure API directly (which will clear up any nested resources).
+`, name, strings.Join(formattedResourceUris, "\n"), "`prevent_deletion_if_contains_resources`", "`features`")
+}
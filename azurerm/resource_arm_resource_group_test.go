@@ -1,9 +1,12 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -11,6 +14,54 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 )
 
+func TestResourceGroupContainsItemsError(t *testing.T) {
+	nestedResourceIds := []string{
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network2",
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+	}
+
+	err := resourceGroupContainsItemsError("group1", nestedResourceIds)
+	if err == nil {
+		t.Fatalf("Expected an error but didn't get one")
+	}
+	message := err.Error()
+
+	if !strings.HasPrefix(message, `Error deleting Resource Group "group1": the Resource Group still contains Resources.`) {
+		t.Fatalf("Expected the error to start with the Resource Group name but got:\n%s", message)
+	}
+
+	// the Resource IDs should be output as a sorted list
+	expectedResources := "* `/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1`\n" +
+		"* `/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network2`\n"
+	if !strings.Contains(message, expectedResources) {
+		t.Fatalf("Expected the error to contain the sorted list of Resources:\n%s\nbut got:\n%s", expectedResources, message)
+	}
+
+	for _, expected := range []string{"`prevent_deletion_if_contains_resources`", "`features`", "prevent_deletion_if_contains_resources = false"} {
+		if !strings.Contains(message, expected) {
+			t.Fatalf("Expected the error to contain %q but got:\n%s", expected, message)
+		}
+	}
+}
+
+func TestResourceGroupNestedResourcesPollingTimeout(t *testing.T) {
+	if actual := resourceGroupNestedResourcesPollingTimeout(context.Background()); actual != resourceGroupNestedResourcesTimeout {
+		t.Fatalf("Expected %s without a deadline but got %s", resourceGroupNestedResourcesTimeout, actual)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+	if actual := resourceGroupNestedResourcesPollingTimeout(ctx); actual != resourceGroupNestedResourcesTimeout {
+		t.Fatalf("Expected %s with a later deadline but got %s", resourceGroupNestedResourcesTimeout, actual)
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	if actual := resourceGroupNestedResourcesPollingTimeout(ctx); actual <= 0 || actual > time.Minute {
+		t.Fatalf("Expected at most 1m with an earlier deadline but got %s", actual)
+	}
+}
+
 func TestAccAzureRMResourceGroup_basic(t *testing.T) {
 	resourceName := "azurerm_resource_group.test"
 	ri := tf.AccRandTimeInt()
@@ -2,10 +2,10 @@ package azurerm
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	networkSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -23,8 +25,13 @@ func resourceArmRoute() *schema.Resource {
 		Update: resourceArmRouteCreateUpdate,
 		Delete: resourceArmRouteDelete,
 
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Importer: azure.ValidateResourceIDPriorToImport(networkSvc.ValidateRouteID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -74,7 +81,8 @@ func resourceArmRoute() *schema.Resource {
 
 func resourceArmRouteCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).network.RoutesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	rtName := d.Get("route_table_name").(string)
@@ -83,7 +91,7 @@ func resourceArmRouteCreateUpdate(d *schema.ResourceData, meta interface{}) erro
 	addressPrefix := d.Get("address_prefix").(string)
 	nextHopType := d.Get("next_hop_type").(string)
 
-	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+	if meta.(*ArmClient).Features.RequiresImport && d.IsNewResource() {
 		existing, err := client.Get(ctx, resGroup, rtName, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -96,7 +104,9 @@ func resourceArmRouteCreateUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	locks.ByName(rtName, routeTableResourceName)
+	if err := locks.ByNameWithContext(ctx, rtName, routeTableResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(rtName, routeTableResourceName)
 
 	route := network.Route{
@@ -134,15 +144,16 @@ func resourceArmRouteCreateUpdate(d *schema.ResourceData, meta interface{}) erro
 
 func resourceArmRouteRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).network.RoutesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := azure.ParseAzureResourceID(d.Id())
+	id, err := networkSvc.ParseRouteID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	rtName := id.Path["routeTables"]
-	routeName := id.Path["routes"]
+	rtName := id.RouteTableName
+	routeName := id.Name
 
 	resp, err := client.Get(ctx, resGroup, rtName, routeName)
 	if err != nil {
@@ -168,17 +179,20 @@ func resourceArmRouteRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceArmRouteDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).network.RoutesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := azure.ParseAzureResourceID(d.Id())
+	id, err := networkSvc.ParseRouteID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	rtName := id.Path["routeTables"]
-	routeName := id.Path["routes"]
+	rtName := id.RouteTableName
+	routeName := id.Name
 
-	locks.ByName(rtName, routeTableResourceName)
+	if err := locks.ByNameWithContext(ctx, rtName, routeTableResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(rtName, routeTableResourceName)
 
 	future, err := client.Delete(ctx, resGroup, rtName, routeName)
@@ -114,7 +114,7 @@ func TestAccAzureRMSnapshot_requiresImport(t *testing.T) {
 func TestAccAzureRMSnapshot_encryption(t *testing.T) {
 	resourceName := "azurerm_snapshot.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	config := testAccAzureRMSnapshot_encryption(ri, rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -208,7 +208,7 @@ func TestAccAzureRMSnapshot_fromExistingSnapshot(t *testing.T) {
 func TestAccAzureRMSnapshot_fromUnmanagedDisk(t *testing.T) {
 	resourceName := "azurerm_snapshot.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	config := testAccAzureRMSnapshot_fromUnmanagedDisk(ri, rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
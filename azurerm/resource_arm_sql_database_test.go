@@ -170,7 +170,7 @@ func TestAccAzureRMSqlDatabase_restorePointInTime(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 	location := testLocation()
 	preConfig := testAccAzureRMSqlDatabase_basic(ri, location)
-	timeToRestore := time.Now().Add(15 * time.Minute)
+	timeToRestore := tf.AccTimeNow().Add(15 * time.Minute)
 	formattedTime := timeToRestore.UTC().Format(time.RFC3339)
 	postCongif := testAccAzureRMSqlDatabase_restorePointInTime(ri, formattedTime, testLocation())
 
@@ -15,9 +15,9 @@ import (
 )
 
 /*
-	---Testing for Success---
-	Test a basic SQL virtual network rule configuration setup and update scenario, and
-	validate that new property is set correctly.
+---Testing for Success---
+Test a basic SQL virtual network rule configuration setup and update scenario, and
+validate that new property is set correctly.
 */
 func TestAccAzureRMSqlVirtualNetworkRule_basic(t *testing.T) {
 	resourceName := "azurerm_sql_virtual_network_rule.test"
@@ -80,9 +80,9 @@ func TestAccAzureRMSqlVirtualNetworkRule_requiresImport(t *testing.T) {
 }
 
 /*
-	---Testing for Success---
-	Test an update to the SQL Virtual Network Rule to connect to a different subnet, and
-	validate that new subnet is set correctly.
+---Testing for Success---
+Test an update to the SQL Virtual Network Rule to connect to a different subnet, and
+validate that new subnet is set correctly.
 */
 func TestAccAzureRMSqlVirtualNetworkRule_switchSubnets(t *testing.T) {
 	resourceName := "azurerm_sql_virtual_network_rule.test"
@@ -119,7 +119,7 @@ func TestAccAzureRMSqlVirtualNetworkRule_switchSubnets(t *testing.T) {
 }
 
 /*
-	---Testing for Success---
+---Testing for Success---
 */
 func TestAccAzureRMSqlVirtualNetworkRule_disappears(t *testing.T) {
 	resourceName := "azurerm_sql_virtual_network_rule.test"
@@ -144,9 +144,9 @@ func TestAccAzureRMSqlVirtualNetworkRule_disappears(t *testing.T) {
 }
 
 /*
-	--Testing for Success--
-	Test if we are able to create a vnet without the SQL endpoint, but SQL rule
-	is still applied since the endpoint validation will be set to false.
+--Testing for Success--
+Test if we are able to create a vnet without the SQL endpoint, but SQL rule
+is still applied since the endpoint validation will be set to false.
 */
 func TestAccAzureRMSqlVirtualNetworkRule_IgnoreEndpointValid(t *testing.T) {
 	resourceName := "azurerm_sql_virtual_network_rule.test"
@@ -169,9 +169,9 @@ func TestAccAzureRMSqlVirtualNetworkRule_IgnoreEndpointValid(t *testing.T) {
 }
 
 /*
-	--Testing for Failure--
-	Test if we are able to create a vnet with out the SQL endpoint, but SQL rule
-	is still applied since the endpoint validation will be set to false.
+--Testing for Failure--
+Test if we are able to create a vnet with out the SQL endpoint, but SQL rule
+is still applied since the endpoint validation will be set to false.
 */
 func TestAccAzureRMSqlVirtualNetworkRule_IgnoreEndpointInvalid(t *testing.T) {
 	ri := tf.AccRandTimeInt()
@@ -191,9 +191,9 @@ func TestAccAzureRMSqlVirtualNetworkRule_IgnoreEndpointInvalid(t *testing.T) {
 }
 
 /*
-	--Testing for Success--
-	Test if we are able to create multiple subnets and connect multiple subnets to the
-	SQL server.
+--Testing for Success--
+Test if we are able to create multiple subnets and connect multiple subnets to the
+SQL server.
 */
 func TestAccAzureRMSqlVirtualNetworkRule_multipleSubnets(t *testing.T) {
 	resourceName1 := "azurerm_sql_virtual_network_rule.rule1"
@@ -220,8 +220,8 @@ func TestAccAzureRMSqlVirtualNetworkRule_multipleSubnets(t *testing.T) {
 }
 
 /*
-	--Testing for Failure--
-	Validation Function Tests - Invalid Name Validations
+--Testing for Failure--
+Validation Function Tests - Invalid Name Validations
 */
 func TestResourceAzureRMSqlVirtualNetworkRule_invalidNameValidation(t *testing.T) {
 	cases := []struct {
@@ -281,8 +281,8 @@ func TestResourceAzureRMSqlVirtualNetworkRule_invalidNameValidation(t *testing.T
 }
 
 /*
-	--Testing for Success--
-	Validation Function Tests - (Barely) Valid Name Validations
+--Testing for Success--
+Validation Function Tests - (Barely) Valid Name Validations
 */
 func TestResourceAzureRMSqlVirtualNetworkRule_validNameValidation(t *testing.T) {
 	cases := []struct {
@@ -346,7 +346,7 @@ func TestResourceAzureRMSqlVirtualNetworkRule_validNameValidation(t *testing.T)
 }
 
 /*
-	Test Check function to assert if a rule exists or not.
+Test Check function to assert if a rule exists or not.
 */
 func testCheckAzureRMSqlVirtualNetworkRuleExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -376,7 +376,7 @@ func testCheckAzureRMSqlVirtualNetworkRuleExists(resourceName string) resource.T
 }
 
 /*
-	Test Check function to delete a rule.
+Test Check function to delete a rule.
 */
 func testCheckAzureRMSqlVirtualNetworkRuleDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
@@ -407,7 +407,7 @@ func testCheckAzureRMSqlVirtualNetworkRuleDestroy(s *terraform.State) error {
 }
 
 /*
-	Test Check function to assert if that a rule gets deleted.
+Test Check function to assert if that a rule gets deleted.
 */
 func testCheckAzureRMSqlVirtualNetworkRuleDisappears(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -449,8 +449,8 @@ func testCheckAzureRMSqlVirtualNetworkRuleDisappears(resourceName string) resour
 }
 
 /*
-	(This test configuration is intended to succeed.)
-	Basic Provisioning Configuration
+(This test configuration is intended to succeed.)
+Basic Provisioning Configuration
 */
 func testAccAzureRMSqlVirtualNetworkRule_basic(rInt int, location string) string {
 	return fmt.Sprintf(`
@@ -507,9 +507,9 @@ resource "azurerm_sql_virtual_network_rule" "import" {
 }
 
 /*
-	(This test configuration is intended to succeed.)
-	Basic Provisioning Update Configuration (all other properties would recreate the rule)
-	ignore_missing_vnet_service_endpoint (false ==> true)
+(This test configuration is intended to succeed.)
+Basic Provisioning Update Configuration (all other properties would recreate the rule)
+ignore_missing_vnet_service_endpoint (false ==> true)
 */
 func testAccAzureRMSqlVirtualNetworkRule_withUpdates(rInt int, location string) string {
 	return fmt.Sprintf(`
@@ -553,9 +553,9 @@ resource "azurerm_sql_virtual_network_rule" "test" {
 }
 
 /*
-	(This test configuration is intended to succeed.)
-	This test is designed to set up a scenario where a user would want to update the subnet
-	on a given SQL virtual network rule. This configuration sets up the resources initially.
+(This test configuration is intended to succeed.)
+This test is designed to set up a scenario where a user would want to update the subnet
+on a given SQL virtual network rule. This configuration sets up the resources initially.
 */
 func testAccAzureRMSqlVirtualNetworkRule_subnetSwitchPre(rInt int, location string) string {
 	return fmt.Sprintf(`
@@ -606,10 +606,10 @@ resource "azurerm_sql_virtual_network_rule" "test" {
 }
 
 /*
-	(This test configuration is intended to succeed.)
-	This test is designed to set up a scenario where a user would want to update the subnet
-	on a given SQL virtual network rule. This configuration contains the update from
-	azurerm_subnet.test1 to azurerm_subnet.test2.
+(This test configuration is intended to succeed.)
+This test is designed to set up a scenario where a user would want to update the subnet
+on a given SQL virtual network rule. This configuration contains the update from
+azurerm_subnet.test1 to azurerm_subnet.test2.
 */
 func testAccAzureRMSqlVirtualNetworkRule_subnetSwitchPost(rInt int, location string) string {
 	return fmt.Sprintf(`
@@ -660,10 +660,10 @@ resource "azurerm_sql_virtual_network_rule" "test" {
 }
 
 /*
-	(This test configuration is intended to succeed.)
-	Succeeds because subnet's service_endpoints does not include 'Microsoft.Sql' and the SQL
-    virtual network rule is set to *not* validate that the service_endpoint includes that value.
-    The endpoint is purposefully set to Microsoft.Storage.
+		(This test configuration is intended to succeed.)
+		Succeeds because subnet's service_endpoints does not include 'Microsoft.Sql' and the SQL
+	    virtual network rule is set to *not* validate that the service_endpoint includes that value.
+	    The endpoint is purposefully set to Microsoft.Storage.
 */
 func testAccAzureRMSqlVirtualNetworkRule_ignoreEndpointValid(rInt int, location string) string {
 	return fmt.Sprintf(`
@@ -707,10 +707,10 @@ resource "azurerm_sql_virtual_network_rule" "test" {
 }
 
 /*
-	(This test configuration is intended to fail.)
-	Fails because subnet's service_endpoints does not include 'Microsoft.Sql' and the SQL
-    virtual network rule is set to validate that the service_endpoint includes that value.
-    The endpoint is purposefully set to Microsoft.Storage.
+		(This test configuration is intended to fail.)
+		Fails because subnet's service_endpoints does not include 'Microsoft.Sql' and the SQL
+	    virtual network rule is set to validate that the service_endpoint includes that value.
+	    The endpoint is purposefully set to Microsoft.Storage.
 */
 func testAccAzureRMSqlVirtualNetworkRule_ignoreEndpointInvalid(rInt int, location string) string {
 	return fmt.Sprintf(`
@@ -754,9 +754,9 @@ resource "azurerm_sql_virtual_network_rule" "test" {
 }
 
 /*
-	(This test configuration is intended to succeed.)
-	This configuration sets up 3 subnets in 2 different virtual networks, and adds
-	SQL virtual network rules for all 3 subnets to the SQL server.
+(This test configuration is intended to succeed.)
+This configuration sets up 3 subnets in 2 different virtual networks, and adds
+SQL virtual network rules for all 3 subnets to the SQL server.
 */
 func testAccAzureRMSqlVirtualNetworkRule_multipleSubnets(rInt int, location string) string {
 	return fmt.Sprintf(`
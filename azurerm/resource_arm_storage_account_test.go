@@ -10,7 +10,6 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -59,7 +58,7 @@ func TestValidateArmStorageAccountName(t *testing.T) {
 func TestAccAzureRMStorageAccount_basic(t *testing.T) {
 	resourceName := "azurerm_storage_account.testsa"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	location := testLocation()
 	preConfig := testAccAzureRMStorageAccount_basic(ri, rs, location)
 	postConfig := testAccAzureRMStorageAccount_update(ri, rs, location)
@@ -106,7 +105,7 @@ func TestAccAzureRMStorageAccount_requiresImport(t *testing.T) {
 
 	resourceName := "azurerm_storage_account.testsa"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -131,7 +130,7 @@ func TestAccAzureRMStorageAccount_requiresImport(t *testing.T) {
 func TestAccAzureRMStorageAccount_writeLock(t *testing.T) {
 	resourceName := "azurerm_storage_account.testsa"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -171,7 +170,7 @@ func TestAccAzureRMStorageAccount_writeLock(t *testing.T) {
 func TestAccAzureRMStorageAccount_premium(t *testing.T) {
 	resourceName := "azurerm_storage_account.testsa"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	location := testLocation()
 	preConfig := testAccAzureRMStorageAccount_premium(ri, rs, location)
 
@@ -202,7 +201,7 @@ func TestAccAzureRMStorageAccount_premium(t *testing.T) {
 func TestAccAzureRMStorageAccount_disappears(t *testing.T) {
 	resourceName := "azurerm_storage_account.testsa"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	preConfig := testAccAzureRMStorageAccount_basic(ri, rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -229,7 +228,7 @@ func TestAccAzureRMStorageAccount_disappears(t *testing.T) {
 func TestAccAzureRMStorageAccount_blobConnectionString(t *testing.T) {
 	resourceName := "azurerm_storage_account.testsa"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	preConfig := testAccAzureRMStorageAccount_basic(ri, rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -255,7 +254,7 @@ func TestAccAzureRMStorageAccount_blobEncryption(t *testing.T) {
 
 	resourceName := "azurerm_storage_account.testsa"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	location := testLocation()
 	preConfig := testAccAzureRMStorageAccount_blobEncryption(ri, rs, location)
 	postConfig := testAccAzureRMStorageAccount_blobEncryptionDisabled(ri, rs, location)
@@ -296,7 +295,7 @@ func TestAccAzureRMStorageAccount_fileEncryption(t *testing.T) {
 
 	resourceName := "azurerm_storage_account.testsa"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	location := testLocation()
 	preConfig := testAccAzureRMStorageAccount_fileEncryption(ri, rs, location)
 	postConfig := testAccAzureRMStorageAccount_fileEncryptionDisabled(ri, rs, location)
@@ -332,7 +331,7 @@ func TestAccAzureRMStorageAccount_fileEncryption(t *testing.T) {
 func TestAccAzureRMStorageAccount_enableHttpsTrafficOnly(t *testing.T) {
 	resourceName := "azurerm_storage_account.testsa"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	location := testLocation()
 	preConfig := testAccAzureRMStorageAccount_enableHttpsTrafficOnly(ri, rs, location)
 	postConfig := testAccAzureRMStorageAccount_enableHttpsTrafficOnlyDisabled(ri, rs, location)
@@ -368,7 +367,7 @@ func TestAccAzureRMStorageAccount_enableHttpsTrafficOnly(t *testing.T) {
 func TestAccAzureRMStorageAccount_isHnsEnabled(t *testing.T) {
 	resourceName := "azurerm_storage_account.testsa"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	location := testLocation()
 	preConfig := testAccAzureRMStorageAccount_isHnsEnabledTrue(ri, rs, location)
 	postConfig := testAccAzureRMStorageAccount_isHnsEnabledFalse(ri, rs, location)
@@ -404,7 +403,7 @@ func TestAccAzureRMStorageAccount_isHnsEnabled(t *testing.T) {
 func TestAccAzureRMStorageAccount_blobStorageWithUpdate(t *testing.T) {
 	resourceName := "azurerm_storage_account.testsa"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	location := testLocation()
 	preConfig := testAccAzureRMStorageAccount_blobStorage(ri, rs, location)
 	postConfig := testAccAzureRMStorageAccount_blobStorageUpdate(ri, rs, location)
@@ -441,7 +440,7 @@ func TestAccAzureRMStorageAccount_blobStorageWithUpdate(t *testing.T) {
 func TestAccAzureRMStorageAccount_blockBlobStorage(t *testing.T) {
 	resourceName := "azurerm_storage_account.testsa"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -469,7 +468,7 @@ func TestAccAzureRMStorageAccount_blockBlobStorage(t *testing.T) {
 func TestAccAzureRMStorageAccount_fileStorageWithUpdate(t *testing.T) {
 	resourceName := "azurerm_storage_account.testsa"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	location := testLocation()
 	preConfig := testAccAzureRMStorageAccount_fileStorage(ri, rs, location)
 	postConfig := testAccAzureRMStorageAccount_fileStorageUpdate(ri, rs, location)
@@ -507,7 +506,7 @@ func TestAccAzureRMStorageAccount_fileStorageWithUpdate(t *testing.T) {
 func TestAccAzureRMStorageAccount_storageV2WithUpdate(t *testing.T) {
 	resourceName := "azurerm_storage_account.testsa"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	location := testLocation()
 	preConfig := testAccAzureRMStorageAccount_storageV2(ri, rs, location)
 	postConfig := testAccAzureRMStorageAccount_storageV2Update(ri, rs, location)
@@ -544,7 +543,7 @@ func TestAccAzureRMStorageAccount_storageV2WithUpdate(t *testing.T) {
 func TestAccAzureRMStorageAccount_NonStandardCasing(t *testing.T) {
 	resourceName := "azurerm_storage_account.testsa"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	preConfig := testAccAzureRMStorageAccount_nonStandardCasing(ri, rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -576,7 +575,7 @@ func TestAccAzureRMStorageAccount_enableIdentity(t *testing.T) {
 	resourceName := "azurerm_storage_account.testsa"
 
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	config := testAccAzureRMStorageAccount_identity(ri, rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -601,7 +600,7 @@ func TestAccAzureRMStorageAccount_updateResourceByEnablingIdentity(t *testing.T)
 	resourceName := "azurerm_storage_account.testsa"
 
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 
 	basicResourceNoManagedIdentity := testAccAzureRMStorageAccount_basic(ri, rs, testLocation())
 	managedIdentityEnabled := testAccAzureRMStorageAccount_identity(ri, rs, testLocation())
@@ -636,7 +635,7 @@ func TestAccAzureRMStorageAccount_updateResourceByEnablingIdentity(t *testing.T)
 func TestAccAzureRMStorageAccount_networkRules(t *testing.T) {
 	resourceName := "azurerm_storage_account.testsa"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	location := testLocation()
 	preConfig := testAccAzureRMStorageAccount_networkRules(ri, rs, location)
 	postConfig := testAccAzureRMStorageAccount_networkRulesUpdate(ri, rs, location)
@@ -677,7 +676,7 @@ func TestAccAzureRMStorageAccount_networkRules(t *testing.T) {
 func TestAccAzureRMStorageAccount_networkRulesDeleted(t *testing.T) {
 	resourceName := "azurerm_storage_account.testsa"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	location := testLocation()
 	preConfig := testAccAzureRMStorageAccount_networkRules(ri, rs, location)
 	postConfig := testAccAzureRMStorageAccount_networkRulesReverted(ri, rs, location)
@@ -710,7 +709,7 @@ func TestAccAzureRMStorageAccount_networkRulesDeleted(t *testing.T) {
 func TestAccAzureRMStorageAccount_enableAdvancedThreatProtection(t *testing.T) {
 	resourceName := "azurerm_storage_account.testsa"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	location := testLocation()
 	preConfig := testAccAzureRMStorageAccount_enableAdvancedThreatProtection(ri, rs, location)
 	postConfig := testAccAzureRMStorageAccount_enableAdvancedThreatProtectionDisabled(ri, rs, location)
@@ -751,7 +750,7 @@ func TestAccAzureRMStorageAccount_enableAdvancedThreatProtection(t *testing.T) {
 func TestAccAzureRMStorageAccount_queueProperties(t *testing.T) {
 	resourceName := "azurerm_storage_account.testsa"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	location := testLocation()
 	preConfig := testAccAzureRMStorageAccount_queueProperties(ri, rs, location)
 	postConfig := testAccAzureRMStorageAccount_queuePropertiesUpdated(ri, rs, location)
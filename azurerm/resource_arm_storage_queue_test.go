@@ -56,7 +56,7 @@ func TestResourceAzureRMStorageQueueName_Validation(t *testing.T) {
 func TestAccAzureRMStorageQueue_basic(t *testing.T) {
 	resourceName := "azurerm_storage_queue.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -87,7 +87,7 @@ func TestAccAzureRMStorageQueue_requiresImport(t *testing.T) {
 
 	resourceName := "azurerm_storage_queue.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -112,7 +112,7 @@ func TestAccAzureRMStorageQueue_requiresImport(t *testing.T) {
 func TestAccAzureRMStorageQueue_metaData(t *testing.T) {
 	resourceName := "azurerm_storage_queue.test"
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
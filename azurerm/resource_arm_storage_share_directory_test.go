@@ -6,7 +6,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -15,7 +14,7 @@ import (
 
 func TestAccAzureRMStorageShareDirectory_basic(t *testing.T) {
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(5))
+	rs := strings.ToLower(tf.AccRandString(5))
 	location := testLocation()
 	resourceName := "azurerm_storage_share_directory.test"
 
@@ -41,7 +40,7 @@ func TestAccAzureRMStorageShareDirectory_basic(t *testing.T) {
 
 func TestAccAzureRMStorageShareDirectory_uppercase(t *testing.T) {
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(5))
+	rs := strings.ToLower(tf.AccRandString(5))
 	location := testLocation()
 	resourceName := "azurerm_storage_share_directory.test"
 
@@ -72,7 +71,7 @@ func TestAccAzureRMStorageShareDirectory_requiresImport(t *testing.T) {
 	}
 
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(5))
+	rs := strings.ToLower(tf.AccRandString(5))
 	location := testLocation()
 	resourceName := "azurerm_storage_share_directory.test"
 
@@ -97,7 +96,7 @@ func TestAccAzureRMStorageShareDirectory_requiresImport(t *testing.T) {
 
 func TestAccAzureRMStorageShareDirectory_complete(t *testing.T) {
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(5))
+	rs := strings.ToLower(tf.AccRandString(5))
 	location := testLocation()
 	resourceName := "azurerm_storage_share_directory.test"
 
@@ -123,7 +122,7 @@ func TestAccAzureRMStorageShareDirectory_complete(t *testing.T) {
 
 func TestAccAzureRMStorageShareDirectory_update(t *testing.T) {
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(5))
+	rs := strings.ToLower(tf.AccRandString(5))
 	location := testLocation()
 	resourceName := "azurerm_storage_share_directory.test"
 
@@ -159,7 +158,7 @@ func TestAccAzureRMStorageShareDirectory_update(t *testing.T) {
 }
 func TestAccAzureRMStorageShareDirectory_nested(t *testing.T) {
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(5))
+	rs := strings.ToLower(tf.AccRandString(5))
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
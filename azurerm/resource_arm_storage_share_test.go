@@ -5,7 +5,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -14,7 +13,7 @@ import (
 
 func TestAccAzureRMStorageShare_basic(t *testing.T) {
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	location := testLocation()
 	resourceName := "azurerm_storage_share.test"
 
@@ -45,7 +44,7 @@ func TestAccAzureRMStorageShare_requiresImport(t *testing.T) {
 	}
 
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	location := testLocation()
 	resourceName := "azurerm_storage_share.test"
 
@@ -70,7 +69,7 @@ func TestAccAzureRMStorageShare_requiresImport(t *testing.T) {
 
 func TestAccAzureRMStorageShare_disappears(t *testing.T) {
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	location := testLocation()
 	resourceName := "azurerm_storage_share.test"
 
@@ -93,7 +92,7 @@ func TestAccAzureRMStorageShare_disappears(t *testing.T) {
 
 func TestAccAzureRMStorageShare_metaData(t *testing.T) {
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	location := testLocation()
 	resourceName := "azurerm_storage_share.test"
 
@@ -130,7 +129,7 @@ func TestAccAzureRMStorageShare_metaData(t *testing.T) {
 
 func TestAccAzureRMStorageShare_acl(t *testing.T) {
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	location := testLocation()
 	resourceName := "azurerm_storage_share.test"
 
@@ -167,7 +166,7 @@ func TestAccAzureRMStorageShare_acl(t *testing.T) {
 
 func TestAccAzureRMStorageShare_updateQuota(t *testing.T) {
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	location := testLocation()
 	resourceName := "azurerm_storage_share.test"
 
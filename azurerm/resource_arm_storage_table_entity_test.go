@@ -6,7 +6,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -16,7 +15,7 @@ import (
 
 func TestAccAzureRMTableEntity_basic(t *testing.T) {
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(5))
+	rs := strings.ToLower(tf.AccRandString(5))
 	location := testLocation()
 	resourceName := "azurerm_storage_table_entity.test"
 
@@ -47,7 +46,7 @@ func TestAccAzureRMTableEntity_requiresImport(t *testing.T) {
 	}
 
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(5))
+	rs := strings.ToLower(tf.AccRandString(5))
 	location := testLocation()
 	resourceName := "azurerm_storage_table_entity.test"
 
@@ -72,7 +71,7 @@ func TestAccAzureRMTableEntity_requiresImport(t *testing.T) {
 
 func TestAccAzureRMTableEntity_update(t *testing.T) {
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(5))
+	rs := strings.ToLower(tf.AccRandString(5))
 	location := testLocation()
 	resourceName := "azurerm_storage_table_entity.test"
 
@@ -5,7 +5,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -17,7 +16,7 @@ func TestAccAzureRMStorageTable_basic(t *testing.T) {
 	resourceName := "azurerm_storage_table.test"
 
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	config := testAccAzureRMStorageTable_basic(ri, rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -49,7 +48,7 @@ func TestAccAzureRMStorageTable_requiresImport(t *testing.T) {
 	resourceName := "azurerm_storage_table.test"
 
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -73,7 +72,7 @@ func TestAccAzureRMStorageTable_requiresImport(t *testing.T) {
 
 func TestAccAzureRMStorageTable_disappears(t *testing.T) {
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	config := testAccAzureRMStorageTable_basic(ri, rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -95,7 +94,7 @@ func TestAccAzureRMStorageTable_disappears(t *testing.T) {
 
 func TestAccAzureRMStorageTable_acl(t *testing.T) {
 	ri := tf.AccRandTimeInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	rs := strings.ToLower(tf.AccRandString(11))
 	location := testLocation()
 	resourceName := "azurerm_storage_table.test"
 
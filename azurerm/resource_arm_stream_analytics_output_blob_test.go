@@ -5,8 +5,6 @@ import (
 	"net/http"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
-
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -16,7 +14,7 @@ import (
 func TestAccAzureRMStreamAnalyticsOutputBlob_avro(t *testing.T) {
 	resourceName := "azurerm_stream_analytics_output_blob.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(5)
+	rs := tf.AccRandString(5)
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -46,7 +44,7 @@ func TestAccAzureRMStreamAnalyticsOutputBlob_avro(t *testing.T) {
 func TestAccAzureRMStreamAnalyticsOutputBlob_csv(t *testing.T) {
 	resourceName := "azurerm_stream_analytics_output_blob.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(5)
+	rs := tf.AccRandString(5)
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -76,7 +74,7 @@ func TestAccAzureRMStreamAnalyticsOutputBlob_csv(t *testing.T) {
 func TestAccAzureRMStreamAnalyticsOutputBlob_json(t *testing.T) {
 	resourceName := "azurerm_stream_analytics_output_blob.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(5)
+	rs := tf.AccRandString(5)
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -106,7 +104,7 @@ func TestAccAzureRMStreamAnalyticsOutputBlob_json(t *testing.T) {
 func TestAccAzureRMStreamAnalyticsOutputBlob_update(t *testing.T) {
 	resourceName := "azurerm_stream_analytics_output_blob.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(5)
+	rs := tf.AccRandString(5)
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -147,7 +145,7 @@ func TestAccAzureRMStreamAnalyticsOutputBlob_requiresImport(t *testing.T) {
 
 	resourceName := "azurerm_stream_analytics_output_blob.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(5)
+	rs := tf.AccRandString(5)
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -5,8 +5,6 @@ import (
 	"net/http"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
-
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -16,7 +14,7 @@ import (
 func TestAccAzureRMStreamAnalyticsOutputSql_basic(t *testing.T) {
 	resourceName := "azurerm_stream_analytics_output_mssql.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(5)
+	rs := tf.AccRandString(5)
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -46,7 +44,7 @@ func TestAccAzureRMStreamAnalyticsOutputSql_basic(t *testing.T) {
 func TestAccAzureRMStreamAnalyticsOutputSql_update(t *testing.T) {
 	resourceName := "azurerm_stream_analytics_output_mssql.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(5)
+	rs := tf.AccRandString(5)
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -87,7 +85,7 @@ func TestAccAzureRMStreamAnalyticsOutputSql_requiresImport(t *testing.T) {
 
 	resourceName := "azurerm_stream_analytics_output_mssql.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(5)
+	rs := tf.AccRandString(5)
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -5,8 +5,6 @@ import (
 	"net/http"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
-
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -16,7 +14,7 @@ import (
 func TestAccAzureRMStreamAnalyticsStreamInputBlob_avro(t *testing.T) {
 	resourceName := "azurerm_stream_analytics_stream_input_blob.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -46,7 +44,7 @@ func TestAccAzureRMStreamAnalyticsStreamInputBlob_avro(t *testing.T) {
 func TestAccAzureRMStreamAnalyticsStreamInputBlob_csv(t *testing.T) {
 	resourceName := "azurerm_stream_analytics_stream_input_blob.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -76,7 +74,7 @@ func TestAccAzureRMStreamAnalyticsStreamInputBlob_csv(t *testing.T) {
 func TestAccAzureRMStreamAnalyticsStreamInputBlob_json(t *testing.T) {
 	resourceName := "azurerm_stream_analytics_stream_input_blob.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -106,7 +104,7 @@ func TestAccAzureRMStreamAnalyticsStreamInputBlob_json(t *testing.T) {
 func TestAccAzureRMStreamAnalyticsStreamInputBlob_update(t *testing.T) {
 	resourceName := "azurerm_stream_analytics_stream_input_blob.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -147,7 +145,7 @@ func TestAccAzureRMStreamAnalyticsStreamInputBlob_requiresImport(t *testing.T) {
 
 	resourceName := "azurerm_stream_analytics_stream_input_blob.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(4)
+	rs := tf.AccRandString(4)
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -3,15 +3,18 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
 	networksvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -23,8 +26,14 @@ func resourceArmSubnet() *schema.Resource {
 		Read:   resourceArmSubnetRead,
 		Update: resourceArmSubnetCreateUpdate,
 		Delete: resourceArmSubnetDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+
+		Importer: azure.ValidateResourceIDPriorToImport(networksvc.ValidateSubnetID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -48,15 +57,17 @@ func resourceArmSubnet() *schema.Resource {
 			},
 
 			"network_security_group_id": {
-				Type:       schema.TypeString,
-				Optional:   true,
-				Deprecated: "Use the `azurerm_subnet_network_security_group_association` resource instead.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Deprecated:       "Use the `azurerm_subnet_network_security_group_association` resource instead.",
+				DiffSuppressFunc: suppress.ResourceIDDifference,
 			},
 
 			"route_table_id": {
-				Type:       schema.TypeString,
-				Optional:   true,
-				Deprecated: "Use the `azurerm_subnet_route_table_association` resource instead.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Deprecated:       "Use the `azurerm_subnet_route_table_association` resource instead.",
+				DiffSuppressFunc: suppress.ResourceIDDifference,
 			},
 
 			"ip_configurations": {
@@ -73,6 +84,18 @@ func resourceArmSubnet() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"private_endpoint_network_policies_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"private_link_service_network_policies_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
 			"delegation": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -131,7 +154,8 @@ func resourceArmSubnet() *schema.Resource {
 
 func resourceArmSubnetCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).network.SubnetsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Subnet creation.")
 
@@ -139,7 +163,7 @@ func resourceArmSubnetCreateUpdate(d *schema.ResourceData, meta interface{}) err
 	vnetName := d.Get("virtual_network_name").(string)
 	resGroup := d.Get("resource_group_name").(string)
 
-	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+	if meta.(*ArmClient).Features.RequiresImport && d.IsNewResource() {
 		existing, err := client.Get(ctx, resGroup, vnetName, name, "")
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -154,47 +178,69 @@ func resourceArmSubnetCreateUpdate(d *schema.ResourceData, meta interface{}) err
 
 	addressPrefix := d.Get("address_prefix").(string)
 
-	locks.ByName(vnetName, virtualNetworkResourceName)
-	defer locks.UnlockByName(vnetName, virtualNetworkResourceName)
-
 	properties := network.SubnetPropertiesFormat{
-		AddressPrefix: &addressPrefix,
+		AddressPrefix:                     &addressPrefix,
+		PrivateEndpointNetworkPolicies:    utils.String(expandSubnetNetworkPolicy(d.Get("private_endpoint_network_policies_enabled").(bool))),
+		PrivateLinkServiceNetworkPolicies: utils.String(expandSubnetNetworkPolicy(d.Get("private_link_service_network_policies_enabled").(bool))),
 	}
 
+	networkSecurityGroupNamesToLock := make([]string, 0)
 	if v, ok := d.GetOk("network_security_group_id"); ok {
 		nsgId := v.(string)
 		properties.NetworkSecurityGroup = &network.SecurityGroup{
 			ID: &nsgId,
 		}
 
-		parsedNsgId, err := networksvc.ParseNetworkSecurityGroupResourceID(nsgId)
+		parsedNsgId, err := networksvc.ParseNetworkSecurityGroupID(nsgId)
 		if err != nil {
 			return err
 		}
 
-		locks.ByName(parsedNsgId.Name, networkSecurityGroupResourceName)
-		defer locks.UnlockByName(parsedNsgId.Name, networkSecurityGroupResourceName)
+		networkSecurityGroupNamesToLock = append(networkSecurityGroupNamesToLock, parsedNsgId.Name)
 	} else {
 		properties.NetworkSecurityGroup = nil
 	}
 
+	routeTableNamesToLock := make([]string, 0)
 	if v, ok := d.GetOk("route_table_id"); ok {
 		rtId := v.(string)
 		properties.RouteTable = &network.RouteTable{
 			ID: &rtId,
 		}
 
-		parsedRouteTableId, err := networksvc.ParseRouteTableResourceID(rtId)
+		parsedRouteTableId, err := networksvc.ParseRouteTableID(rtId)
 		if err != nil {
 			return err
 		}
 
-		locks.ByName(parsedRouteTableId.Name, routeTableResourceName)
-		defer locks.UnlockByName(parsedRouteTableId.Name, routeTableResourceName)
+		routeTableNamesToLock = append(routeTableNamesToLock, parsedRouteTableId.Name)
 	} else {
 		properties.RouteTable = nil
 	}
 
+	namesToLock := []locks.NamesOfType{
+		{ResourceType: networkSecurityGroupResourceName, Names: &networkSecurityGroupNamesToLock},
+		{ResourceType: routeTableResourceName, Names: &routeTableNamesToLock},
+		{ResourceType: virtualNetworkResourceName, Names: &[]string{vnetName}},
+	}
+	if err := locks.MultipleByTypeWithContext(ctx, namesToLock...); err != nil {
+		return err
+	}
+	defer locks.UnlockMultipleByType(namesToLock...)
+
+	// the NAT Gateway is managed via the `azurerm_subnet_nat_gateway_association` resource
+	// so we need to retain any existing association when updating the Subnet
+	if !d.IsNewResource() {
+		existing, err := client.Get(ctx, resGroup, vnetName, name, "")
+		if err != nil {
+			return fmt.Errorf("Error retrieving existing Subnet %q (Virtual Network %q / Resource Group %q): %+v", name, vnetName, resGroup, err)
+		}
+
+		if props := existing.SubnetPropertiesFormat; props != nil {
+			properties.NatGateway = props.NatGateway
+		}
+	}
+
 	serviceEndpoints := expandSubnetServiceEndpoints(d)
 	properties.ServiceEndpoints = &serviceEndpoints
 
@@ -230,15 +276,16 @@ func resourceArmSubnetCreateUpdate(d *schema.ResourceData, meta interface{}) err
 
 func resourceArmSubnetRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).network.SubnetsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := azure.ParseAzureResourceID(d.Id())
+	id, err := networksvc.ParseSubnetID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	vnetName := id.Path["virtualNetworks"]
-	name := id.Path["subnets"]
+	vnetName := id.VirtualNetworkName
+	name := id.Name
 
 	resp, err := client.Get(ctx, resGroup, vnetName, name, "")
 
@@ -257,15 +304,15 @@ func resourceArmSubnetRead(d *schema.ResourceData, meta interface{}) error {
 	if props := resp.SubnetPropertiesFormat; props != nil {
 		d.Set("address_prefix", props.AddressPrefix)
 
-		var securityGroupId *string
-		if props.NetworkSecurityGroup != nil {
-			securityGroupId = props.NetworkSecurityGroup.ID
+		var securityGroupId string
+		if props.NetworkSecurityGroup != nil && props.NetworkSecurityGroup.ID != nil {
+			securityGroupId = azure.NormalizeResourceID(*props.NetworkSecurityGroup.ID)
 		}
 		d.Set("network_security_group_id", securityGroupId)
 
 		var routeTableId string
 		if props.RouteTable != nil && props.RouteTable.ID != nil {
-			routeTableId = *props.RouteTable.ID
+			routeTableId = azure.NormalizeResourceID(*props.RouteTable.ID)
 		}
 		d.Set("route_table_id", routeTableId)
 
@@ -283,6 +330,9 @@ func resourceArmSubnetRead(d *schema.ResourceData, meta interface{}) error {
 		if err := d.Set("delegation", delegation); err != nil {
 			return fmt.Errorf("Error flattening `delegation`: %+v", err)
 		}
+
+		d.Set("private_endpoint_network_policies_enabled", flattenSubnetNetworkPolicy(props.PrivateEndpointNetworkPolicies))
+		d.Set("private_link_service_network_policies_enabled", flattenSubnetNetworkPolicy(props.PrivateLinkServiceNetworkPolicies))
 	}
 
 	return nil
@@ -290,43 +340,49 @@ func resourceArmSubnetRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceArmSubnetDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).network.SubnetsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := azure.ParseAzureResourceID(d.Id())
+	id, err := networksvc.ParseSubnetID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["subnets"]
-	vnetName := id.Path["virtualNetworks"]
+	name := id.Name
+	vnetName := id.VirtualNetworkName
 
+	networkSecurityGroupNamesToLock := make([]string, 0)
 	if v, ok := d.GetOk("network_security_group_id"); ok {
 		networkSecurityGroupId := v.(string)
-		parsedNetworkSecurityGroupId, err2 := networksvc.ParseNetworkSecurityGroupResourceID(networkSecurityGroupId)
+		parsedNetworkSecurityGroupId, err2 := networksvc.ParseNetworkSecurityGroupID(networkSecurityGroupId)
 		if err2 != nil {
 			return err2
 		}
 
-		locks.ByName(parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName)
-		defer locks.UnlockByName(parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName)
+		networkSecurityGroupNamesToLock = append(networkSecurityGroupNamesToLock, parsedNetworkSecurityGroupId.Name)
 	}
 
+	routeTableNamesToLock := make([]string, 0)
 	if v, ok := d.GetOk("route_table_id"); ok {
 		rtId := v.(string)
-		parsedRouteTableId, err2 := networksvc.ParseRouteTableResourceID(rtId)
+		parsedRouteTableId, err2 := networksvc.ParseRouteTableID(rtId)
 		if err2 != nil {
 			return err2
 		}
 
-		locks.ByName(parsedRouteTableId.Name, routeTableResourceName)
-		defer locks.UnlockByName(parsedRouteTableId.Name, routeTableResourceName)
+		routeTableNamesToLock = append(routeTableNamesToLock, parsedRouteTableId.Name)
 	}
 
-	locks.ByName(vnetName, virtualNetworkResourceName)
-	defer locks.UnlockByName(vnetName, virtualNetworkResourceName)
-
-	locks.ByName(name, subnetResourceName)
-	defer locks.UnlockByName(name, subnetResourceName)
+	namesToLock := []locks.NamesOfType{
+		{ResourceType: networkSecurityGroupResourceName, Names: &networkSecurityGroupNamesToLock},
+		{ResourceType: routeTableResourceName, Names: &routeTableNamesToLock},
+		{ResourceType: virtualNetworkResourceName, Names: &[]string{vnetName}},
+		{ResourceType: subnetResourceName, Names: &[]string{name}},
+	}
+	if err := locks.MultipleByTypeWithContext(ctx, namesToLock...); err != nil {
+		return err
+	}
+	defer locks.UnlockMultipleByType(namesToLock...)
 
 	future, err := client.Delete(ctx, resGroup, vnetName, name)
 	if err != nil {
@@ -340,6 +396,21 @@ func resourceArmSubnetDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// the Network Policies for Private Endpoints / Private Link Services on a Subnet are returned as a string
+// (either `Enabled` or `Disabled`) and need to be disabled before these can be provisioned into the Subnet
+func expandSubnetNetworkPolicy(enabled bool) string {
+	if enabled {
+		return "Enabled"
+	}
+
+	return "Disabled"
+}
+
+func flattenSubnetNetworkPolicy(input *string) bool {
+	// these policies are enabled by default, so they're only disabled when explicitly set
+	return input == nil || !strings.EqualFold(*input, "Disabled")
+}
+
 func expandSubnetServiceEndpoints(d *schema.ResourceData) []network.ServiceEndpointPropertiesFormat {
 	serviceEndpoints := d.Get("service_endpoints").([]interface{})
 	endpoints := make([]network.ServiceEndpointPropertiesFormat, 0)
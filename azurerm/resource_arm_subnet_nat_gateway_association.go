@@ -0,0 +1,244 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	networkSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmSubnetNatGatewayAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSubnetNatGatewayAssociationCreate,
+		Read:   resourceArmSubnetNatGatewayAssociationRead,
+		Delete: resourceArmSubnetNatGatewayAssociationDelete,
+
+		Importer: azure.ValidateResourceIDPriorToImport(networkSvc.ValidateSubnetID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"subnet_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     networkSvc.ValidateSubnetID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
+			},
+
+			"nat_gateway_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     networkSvc.ValidateNatGatewayID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
+			},
+		},
+	}
+}
+
+func resourceArmSubnetNatGatewayAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network.SubnetsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Subnet <-> NAT Gateway Association creation.")
+
+	subnetId := d.Get("subnet_id").(string)
+	natGatewayId := d.Get("nat_gateway_id").(string)
+
+	parsedSubnetId, err := networkSvc.ParseSubnetID(subnetId)
+	if err != nil {
+		return err
+	}
+
+	parsedNatGatewayId, err := networkSvc.ParseNatGatewayID(natGatewayId)
+	if err != nil {
+		return err
+	}
+
+	subnetName := parsedSubnetId.Name
+	virtualNetworkName := parsedSubnetId.VirtualNetworkName
+	resourceGroup := parsedSubnetId.ResourceGroup
+
+	namesToLock := []locks.NamesOfType{
+		{ResourceType: natGatewayResourceName, Names: &[]string{parsedNatGatewayId.Name}},
+		{ResourceType: virtualNetworkResourceName, Names: &[]string{virtualNetworkName}},
+		{ResourceType: subnetResourceName, Names: &[]string{subnetName}},
+	}
+	if err := locks.MultipleByTypeWithContext(ctx, namesToLock...); err != nil {
+		return err
+	}
+	defer locks.UnlockMultipleByType(namesToLock...)
+
+	subnet, err := client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(subnet.Response) {
+			return fmt.Errorf("Subnet %q (Virtual Network %q / Resource Group %q) was not found!", subnetName, virtualNetworkName, resourceGroup)
+		}
+
+		return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, err)
+	}
+
+	if props := subnet.SubnetPropertiesFormat; props != nil {
+		if meta.(*ArmClient).Features.RequiresImport {
+			if gateway := props.NatGateway; gateway != nil {
+				// we're intentionally not checking the ID - if there's a NAT Gateway, it needs to be imported
+				if gateway.ID != nil && subnet.ID != nil {
+					return tf.ImportAsExistsError("azurerm_subnet_nat_gateway_association", *subnet.ID)
+				}
+			}
+		}
+
+		props.NatGateway = &network.SubResource{
+			ID: utils.String(natGatewayId),
+		}
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, virtualNetworkName, subnetName, subnet)
+	if err != nil {
+		return fmt.Errorf("Error updating NAT Gateway Association for Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for completion of NAT Gateway Association for Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, err)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmSubnetNatGatewayAssociationRead(d, meta)
+}
+
+func resourceArmSubnetNatGatewayAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network.SubnetsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := networkSvc.ParseSubnetID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	virtualNetworkName := id.VirtualNetworkName
+	subnetName := id.Name
+
+	resp, err := client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Subnet %q (Virtual Network %q / Resource Group %q) could not be found - removing from state!", subnetName, virtualNetworkName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, err)
+	}
+
+	props := resp.SubnetPropertiesFormat
+	if props == nil {
+		return fmt.Errorf("Error: `properties` was nil for Subnet %q (Virtual Network %q / Resource Group %q)", subnetName, virtualNetworkName, resourceGroup)
+	}
+
+	natGateway := props.NatGateway
+	if natGateway == nil || natGateway.ID == nil {
+		log.Printf("[DEBUG] Subnet %q (Virtual Network %q / Resource Group %q) doesn't have a NAT Gateway - removing from state!", subnetName, virtualNetworkName, resourceGroup)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("subnet_id", resp.ID)
+	d.Set("nat_gateway_id", azure.NormalizeResourceID(*natGateway.ID))
+
+	return nil
+}
+
+func resourceArmSubnetNatGatewayAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network.SubnetsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := networkSvc.ParseSubnetID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	virtualNetworkName := id.VirtualNetworkName
+	subnetName := id.Name
+
+	// retrieve the subnet
+	read, err := client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(read.Response) {
+			log.Printf("[DEBUG] Subnet %q (Virtual Network %q / Resource Group %q) could not be found - removing from state!", subnetName, virtualNetworkName, resourceGroup)
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, err)
+	}
+
+	props := read.SubnetPropertiesFormat
+	if props == nil {
+		return fmt.Errorf("`Properties` was nil for Subnet %q (Virtual Network %q / Resource Group %q)", subnetName, virtualNetworkName, resourceGroup)
+	}
+
+	if props.NatGateway == nil || props.NatGateway.ID == nil {
+		log.Printf("[DEBUG] Subnet %q (Virtual Network %q / Resource Group %q) has no NAT Gateway - removing from state!", subnetName, virtualNetworkName, resourceGroup)
+		return nil
+	}
+
+	// once we have the nat gateway id to lock on, lock on that
+	parsedNatGatewayId, err := networkSvc.ParseNatGatewayID(*props.NatGateway.ID)
+	if err != nil {
+		return err
+	}
+
+	namesToLock := []locks.NamesOfType{
+		{ResourceType: natGatewayResourceName, Names: &[]string{parsedNatGatewayId.Name}},
+		{ResourceType: virtualNetworkResourceName, Names: &[]string{virtualNetworkName}},
+		{ResourceType: subnetResourceName, Names: &[]string{subnetName}},
+	}
+	if err := locks.MultipleByTypeWithContext(ctx, namesToLock...); err != nil {
+		return err
+	}
+	defer locks.UnlockMultipleByType(namesToLock...)
+
+	// then re-retrieve it to ensure we've got the latest state
+	read, err = client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(read.Response) {
+			log.Printf("[DEBUG] Subnet %q (Virtual Network %q / Resource Group %q) could not be found - removing from state!", subnetName, virtualNetworkName, resourceGroup)
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, err)
+	}
+
+	read.SubnetPropertiesFormat.NatGateway = nil
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, virtualNetworkName, subnetName, read)
+	if err != nil {
+		return fmt.Errorf("Error removing NAT Gateway Association from Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for removal of NAT Gateway Association from Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, err)
+	}
+
+	return nil
+}
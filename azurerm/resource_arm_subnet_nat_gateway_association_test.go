@@ -0,0 +1,263 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	networkSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMSubnetNatGatewayAssociation_basic(t *testing.T) {
+	resourceName := "azurerm_subnet_nat_gateway_association.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		// intentional since this is a Virtual Resource
+		CheckDestroy: testCheckAzureRMSubnetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSubnetNatGatewayAssociation_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubnetNatGatewayAssociationExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSubnetNatGatewayAssociation_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_subnet_nat_gateway_association.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		// intentional since this is a Virtual Resource
+		CheckDestroy: testCheckAzureRMSubnetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSubnetNatGatewayAssociation_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubnetNatGatewayAssociationExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMSubnetNatGatewayAssociation_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_subnet_nat_gateway_association"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSubnetNatGatewayAssociation_subnetUpdate(t *testing.T) {
+	resourceName := "azurerm_subnet_nat_gateway_association.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		// intentional since this is a Virtual Resource
+		CheckDestroy: testCheckAzureRMSubnetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSubnetNatGatewayAssociation_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubnetNatGatewayAssociationExists(resourceName),
+				),
+			},
+			{
+				// updating the Subnet shouldn't remove the NAT Gateway association
+				Config: testAccAzureRMSubnetNatGatewayAssociation_subnetUpdated(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubnetNatGatewayAssociationExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSubnetNatGatewayAssociation_deleted(t *testing.T) {
+	resourceName := "azurerm_subnet_nat_gateway_association.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		// intentional since this is a Virtual Resource
+		CheckDestroy: testCheckAzureRMSubnetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSubnetNatGatewayAssociation_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubnetNatGatewayAssociationExists(resourceName),
+					testCheckAzureRMSubnetNatGatewayAssociationDisappears(resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMSubnetNatGatewayAssociationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := networkSvc.ParseSubnetID(rs.Primary.Attributes["subnet_id"])
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).network.SubnetsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Subnet %q (Virtual Network %q / Resource Group: %q) does not exist", id.Name, id.VirtualNetworkName, id.ResourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on subnetClient: %+v", err)
+		}
+
+		props := resp.SubnetPropertiesFormat
+		if props == nil {
+			return fmt.Errorf("Properties was nil for Subnet %q (Virtual Network %q / Resource Group: %q)", id.Name, id.VirtualNetworkName, id.ResourceGroup)
+		}
+
+		if props.NatGateway == nil || props.NatGateway.ID == nil {
+			return fmt.Errorf("No NAT Gateway association exists for Subnet %q (Virtual Network %q / Resource Group: %q)", id.Name, id.VirtualNetworkName, id.ResourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMSubnetNatGatewayAssociationDisappears(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := networkSvc.ParseSubnetID(rs.Primary.Attributes["subnet_id"])
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).network.SubnetsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		read, err := client.Get(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(read.Response) {
+				return fmt.Errorf("Error retrieving Subnet %q (Network %q / Resource Group %q): %+v", id.Name, id.VirtualNetworkName, id.ResourceGroup, err)
+			}
+		}
+
+		read.SubnetPropertiesFormat.NatGateway = nil
+
+		future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name, read)
+		if err != nil {
+			return fmt.Errorf("Error updating Subnet %q (Network %q / Resource Group %q): %+v", id.Name, id.VirtualNetworkName, id.ResourceGroup, err)
+		}
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for completion of Subnet %q (Network %q / Resource Group %q): %+v", id.Name, id.VirtualNetworkName, id.ResourceGroup, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMSubnetNatGatewayAssociation_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_nat_gateway" "test" {
+  name                = "acctest-NatGateway-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMSubnetNatGatewayAssociation_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurerm_subnet_nat_gateway_association" "test" {
+  subnet_id      = "${azurerm_subnet.test.id}"
+  nat_gateway_id = "${azurerm_nat_gateway.test.id}"
+}
+`, testAccAzureRMSubnetNatGatewayAssociation_template(rInt, location))
+}
+
+func testAccAzureRMSubnetNatGatewayAssociation_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_subnet_nat_gateway_association" "import" {
+  subnet_id      = "${azurerm_subnet_nat_gateway_association.test.subnet_id}"
+  nat_gateway_id = "${azurerm_subnet_nat_gateway_association.test.nat_gateway_id}"
+}
+`, testAccAzureRMSubnetNatGatewayAssociation_basic(rInt, location))
+}
+
+func testAccAzureRMSubnetNatGatewayAssociation_subnetUpdated(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+  service_endpoints    = ["Microsoft.Storage"]
+}
+
+resource "azurerm_subnet_nat_gateway_association" "test" {
+  subnet_id      = "${azurerm_subnet.test.id}"
+  nat_gateway_id = "${azurerm_nat_gateway.test.id}"
+}
+`, testAccAzureRMSubnetNatGatewayAssociation_template(rInt, location))
+}
@@ -272,6 +272,41 @@ func TestAccAzureRMSubnet_serviceEndpoints(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMSubnet_networkPolicies(t *testing.T) {
+	resourceName := "azurerm_subnet.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSubnetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSubnet_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubnetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "private_endpoint_network_policies_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "private_link_service_network_policies_enabled", "true"),
+				),
+			},
+			{
+				Config: testAccAzureRMSubnet_networkPoliciesDisabled(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubnetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "private_endpoint_network_policies_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "private_link_service_network_policies_enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAzureRMSubnet_serviceEndpointsVNetUpdate(t *testing.T) {
 	resourceName := "azurerm_subnet.test"
 	ri := tf.AccRandTimeInt()
@@ -884,3 +919,29 @@ resource "azurerm_subnet" "test" {
 }
 `, rInt, location, rInt, rInt)
 }
+
+func testAccAzureRMSubnet_networkPoliciesDisabled(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctestsubnet%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+
+  private_endpoint_network_policies_enabled     = false
+  private_link_service_network_policies_enabled = false
+}
+`, rInt, location, rInt, rInt)
+}
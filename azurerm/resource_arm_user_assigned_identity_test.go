@@ -6,7 +6,6 @@ import (
 
 	"net/http"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -17,7 +16,7 @@ import (
 func TestAccAzureRMUserAssignedIdentity_basic(t *testing.T) {
 	resourceName := "azurerm_user_assigned_identity.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(14)
+	rs := tf.AccRandString(14)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -48,7 +47,7 @@ func TestAccAzureRMUserAssignedIdentity_requiresImport(t *testing.T) {
 
 	resourceName := "azurerm_user_assigned_identity.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(14)
+	rs := tf.AccRandString(14)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
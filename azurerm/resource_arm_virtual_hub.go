@@ -0,0 +1,293 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	networkSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+var virtualHubResourceName = "azurerm_virtual_hub"
+
+func resourceArmVirtualHub() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVirtualHubCreateUpdate,
+		Read:   resourceArmVirtualHubRead,
+		Update: resourceArmVirtualHubCreateUpdate,
+		Delete: resourceArmVirtualHubDelete,
+
+		Importer: azure.ValidateResourceIDPriorToImport(networkSvc.ValidateVirtualHubID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"virtual_wan_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     networkSvc.ValidateVirtualWanID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
+			},
+
+			"address_prefix": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CIDR,
+			},
+
+			"route": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address_prefixes": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.CIDR,
+							},
+						},
+
+						"next_hop_ip_address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.IPv4Address,
+						},
+					},
+				},
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceArmVirtualHubCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network.VirtualHubClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Virtual Hub creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if err := locks.ByNameWithContext(ctx, name, virtualHubResourceName); err != nil {
+		return err
+	}
+	defer locks.UnlockByName(name, virtualHubResourceName)
+
+	existing, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("Error checking for presence of existing Virtual Hub %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	if meta.(*ArmClient).Features.RequiresImport && d.IsNewResource() {
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_virtual_hub", *existing.ID)
+		}
+	}
+
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	addressPrefix := d.Get("address_prefix").(string)
+	virtualWanId := d.Get("virtual_wan_id").(string)
+	route := d.Get("route").(*schema.Set).List()
+	t := d.Get("tags").(map[string]interface{})
+
+	parameters := network.VirtualHub{
+		Location: utils.String(location),
+		VirtualHubProperties: &network.VirtualHubProperties{
+			AddressPrefix: utils.String(addressPrefix),
+			VirtualWan: &network.SubResource{
+				ID: utils.String(virtualWanId),
+			},
+			RouteTable: expandArmVirtualHubRoute(route),
+		},
+		Tags: tags.Expand(t),
+	}
+
+	// Virtual Network Connections are managed via the `azurerm_virtual_hub_connection` resource
+	// so we need to retain any existing connections when updating the Virtual Hub
+	if props := existing.VirtualHubProperties; props != nil {
+		parameters.VirtualHubProperties.VirtualNetworkConnections = props.VirtualNetworkConnections
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Virtual Hub %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Virtual Hub %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Virtual Hub %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Virtual Hub %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmVirtualHubRead(d, meta)
+}
+
+func resourceArmVirtualHubRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network.VirtualHubClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := networkSvc.ParseVirtualHubID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Virtual Hub %q (Resource Group %q) was not found - removing from state", id.Name, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Virtual Hub %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	if props := resp.VirtualHubProperties; props != nil {
+		d.Set("address_prefix", props.AddressPrefix)
+
+		virtualWanId := ""
+		if props.VirtualWan != nil && props.VirtualWan.ID != nil {
+			virtualWanId = *props.VirtualWan.ID
+		}
+		d.Set("virtual_wan_id", virtualWanId)
+
+		if err := d.Set("route", flattenArmVirtualHubRoute(props.RouteTable)); err != nil {
+			return fmt.Errorf("Error setting `route`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceArmVirtualHubDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network.VirtualHubClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := networkSvc.ParseVirtualHubID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := locks.ByNameWithContext(ctx, id.Name, virtualHubResourceName); err != nil {
+		return err
+	}
+	defer locks.UnlockByName(id.Name, virtualHubResourceName)
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		// deleted outside of Terraform
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Virtual Hub %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for the deletion of Virtual Hub %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandArmVirtualHubRoute(input []interface{}) *network.VirtualHubRouteTable {
+	if len(input) == 0 {
+		return nil
+	}
+
+	results := make([]network.VirtualHubRoute, 0)
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+
+		v := item.(map[string]interface{})
+		addressPrefixes := v["address_prefixes"].([]interface{})
+		nextHopIpAddress := v["next_hop_ip_address"].(string)
+
+		results = append(results, network.VirtualHubRoute{
+			AddressPrefixes:  utils.ExpandStringSlice(addressPrefixes),
+			NextHopIPAddress: utils.String(nextHopIpAddress),
+		})
+	}
+
+	return &network.VirtualHubRouteTable{
+		Routes: &results,
+	}
+}
+
+func flattenArmVirtualHubRoute(input *network.VirtualHubRouteTable) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil || input.Routes == nil {
+		return results
+	}
+
+	for _, item := range *input.Routes {
+		nextHopIpAddress := ""
+		if item.NextHopIPAddress != nil {
+			nextHopIpAddress = *item.NextHopIPAddress
+		}
+
+		results = append(results, map[string]interface{}{
+			"address_prefixes":    utils.FlattenStringSlice(item.AddressPrefixes),
+			"next_hop_ip_address": nextHopIpAddress,
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,260 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	networkSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmVirtualHubConnection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVirtualHubConnectionCreate,
+		Read:   resourceArmVirtualHubConnectionRead,
+		Delete: resourceArmVirtualHubConnectionDelete,
+
+		Importer: azure.ValidateResourceIDPriorToImport(networkSvc.ValidateVirtualHubConnectionID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"virtual_hub_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     networkSvc.ValidateVirtualHubID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
+			},
+
+			"remote_virtual_network_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     networkSvc.ValidateVirtualNetworkID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
+			},
+
+			"allow_hub_to_remote_vnet_transit": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"allow_remote_vnet_to_use_hub_vnet_gateways": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"enable_internet_security": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceArmVirtualHubConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network.VirtualHubClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Virtual Hub Connection creation.")
+
+	name := d.Get("name").(string)
+	virtualHubId, err := networkSvc.ParseVirtualHubID(d.Get("virtual_hub_id").(string))
+	if err != nil {
+		return err
+	}
+
+	// Virtual Hub Connections can only be modified via the Virtual Hub - so we lock on that
+	if err := locks.ByNameWithContext(ctx, virtualHubId.Name, virtualHubResourceName); err != nil {
+		return err
+	}
+	defer locks.UnlockByName(virtualHubId.Name, virtualHubResourceName)
+
+	hub, err := client.Get(ctx, virtualHubId.ResourceGroup, virtualHubId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(hub.Response) {
+			return fmt.Errorf("Virtual Hub %q (Resource Group %q) was not found!", virtualHubId.Name, virtualHubId.ResourceGroup)
+		}
+
+		return fmt.Errorf("Error retrieving Virtual Hub %q (Resource Group %q): %+v", virtualHubId.Name, virtualHubId.ResourceGroup, err)
+	}
+
+	if hub.VirtualHubProperties == nil {
+		return fmt.Errorf("Error: `properties` was nil for Virtual Hub %q (Resource Group %q)", virtualHubId.Name, virtualHubId.ResourceGroup)
+	}
+
+	connections := make([]network.HubVirtualNetworkConnection, 0)
+	if existing := hub.VirtualHubProperties.VirtualNetworkConnections; existing != nil {
+		for _, connection := range *existing {
+			if connection.Name != nil && strings.EqualFold(*connection.Name, name) {
+				if meta.(*ArmClient).Features.RequiresImport {
+					id := networkSvc.NewVirtualHubConnectionID(virtualHubId.SubscriptionId, virtualHubId.ResourceGroup, virtualHubId.Name, name)
+					return tf.ImportAsExistsError("azurerm_virtual_hub_connection", id.String())
+				}
+
+				continue
+			}
+
+			connections = append(connections, connection)
+		}
+	}
+
+	connections = append(connections, network.HubVirtualNetworkConnection{
+		Name: utils.String(name),
+		HubVirtualNetworkConnectionProperties: &network.HubVirtualNetworkConnectionProperties{
+			RemoteVirtualNetwork: &network.SubResource{
+				ID: utils.String(d.Get("remote_virtual_network_id").(string)),
+			},
+			AllowHubToRemoteVnetTransit:         utils.Bool(d.Get("allow_hub_to_remote_vnet_transit").(bool)),
+			AllowRemoteVnetToUseHubVnetGateways: utils.Bool(d.Get("allow_remote_vnet_to_use_hub_vnet_gateways").(bool)),
+			EnableInternetSecurity:              utils.Bool(d.Get("enable_internet_security").(bool)),
+		},
+	})
+	hub.VirtualHubProperties.VirtualNetworkConnections = &connections
+
+	future, err := client.CreateOrUpdate(ctx, virtualHubId.ResourceGroup, virtualHubId.Name, hub)
+	if err != nil {
+		return fmt.Errorf("Error adding Connection %q to Virtual Hub %q (Resource Group %q): %+v", name, virtualHubId.Name, virtualHubId.ResourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for addition of Connection %q to Virtual Hub %q (Resource Group %q): %+v", name, virtualHubId.Name, virtualHubId.ResourceGroup, err)
+	}
+
+	connectionsClient := meta.(*ArmClient).network.HubVirtualNetworkConnectionClient
+	resp, err := connectionsClient.Get(ctx, virtualHubId.ResourceGroup, virtualHubId.Name, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Connection %q (Virtual Hub %q / Resource Group %q): %+v", name, virtualHubId.Name, virtualHubId.ResourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read ID of Connection %q (Virtual Hub %q / Resource Group %q)", name, virtualHubId.Name, virtualHubId.ResourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmVirtualHubConnectionRead(d, meta)
+}
+
+func resourceArmVirtualHubConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network.HubVirtualNetworkConnectionClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := networkSvc.ParseVirtualHubConnectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualHubName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Connection %q (Virtual Hub %q / Resource Group %q) was not found - removing from state", id.Name, id.VirtualHubName, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Connection %q (Virtual Hub %q / Resource Group %q): %+v", id.Name, id.VirtualHubName, id.ResourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("virtual_hub_id", networkSvc.NewVirtualHubID(id.SubscriptionId, id.ResourceGroup, id.VirtualHubName).String())
+
+	if props := resp.HubVirtualNetworkConnectionProperties; props != nil {
+		remoteVirtualNetworkId := ""
+		if props.RemoteVirtualNetwork != nil && props.RemoteVirtualNetwork.ID != nil {
+			remoteVirtualNetworkId = *props.RemoteVirtualNetwork.ID
+		}
+		d.Set("remote_virtual_network_id", remoteVirtualNetworkId)
+		d.Set("allow_hub_to_remote_vnet_transit", props.AllowHubToRemoteVnetTransit)
+		d.Set("allow_remote_vnet_to_use_hub_vnet_gateways", props.AllowRemoteVnetToUseHubVnetGateways)
+		d.Set("enable_internet_security", props.EnableInternetSecurity)
+	}
+
+	return nil
+}
+
+func resourceArmVirtualHubConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network.VirtualHubClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := networkSvc.ParseVirtualHubConnectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := locks.ByNameWithContext(ctx, id.VirtualHubName, virtualHubResourceName); err != nil {
+		return err
+	}
+	defer locks.UnlockByName(id.VirtualHubName, virtualHubResourceName)
+
+	hub, err := client.Get(ctx, id.ResourceGroup, id.VirtualHubName)
+	if err != nil {
+		// the Virtual Hub (and therefore the Connection) has been deleted outside of Terraform
+		if utils.ResponseWasNotFound(hub.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Virtual Hub %q (Resource Group %q): %+v", id.VirtualHubName, id.ResourceGroup, err)
+	}
+
+	if hub.VirtualHubProperties == nil || hub.VirtualHubProperties.VirtualNetworkConnections == nil {
+		return nil
+	}
+
+	found := false
+	connections := make([]network.HubVirtualNetworkConnection, 0)
+	for _, connection := range *hub.VirtualHubProperties.VirtualNetworkConnections {
+		if connection.Name != nil && strings.EqualFold(*connection.Name, id.Name) {
+			found = true
+			continue
+		}
+
+		connections = append(connections, connection)
+	}
+
+	if !found {
+		log.Printf("[DEBUG] Connection %q was not found in Virtual Hub %q (Resource Group %q) - assuming removed", id.Name, id.VirtualHubName, id.ResourceGroup)
+		return nil
+	}
+
+	hub.VirtualHubProperties.VirtualNetworkConnections = &connections
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualHubName, hub)
+	if err != nil {
+		return fmt.Errorf("Error removing Connection %q from Virtual Hub %q (Resource Group %q): %+v", id.Name, id.VirtualHubName, id.ResourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for removal of Connection %q from Virtual Hub %q (Resource Group %q): %+v", id.Name, id.VirtualHubName, id.ResourceGroup, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,268 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	networkSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMVirtualHub_basic(t *testing.T) {
+	resourceName := "azurerm_virtual_hub.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualHubDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualHub_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualHubExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualHub_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_virtual_hub.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualHubDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualHub_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualHubExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMVirtualHub_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_virtual_hub"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualHub_routes(t *testing.T) {
+	resourceName := "azurerm_virtual_hub.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualHubDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualHub_route(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualHubExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMVirtualHub_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualHubExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualHub_tags(t *testing.T) {
+	resourceName := "azurerm_virtual_hub.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualHubDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualHub_tags(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualHubExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Hello", "World"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMVirtualHubExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Virtual Hub not found: %s", resourceName)
+		}
+
+		id, err := networkSvc.ParseVirtualHubID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).network.VirtualHubClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.Name); err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Virtual Hub %q (Resource Group %q) does not exist", id.Name, id.ResourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on network.VirtualHubClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMVirtualHubDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).network.VirtualHubClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_virtual_hub" {
+			continue
+		}
+
+		id, err := networkSvc.ParseVirtualHubID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.Name); err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Get on network.VirtualHubClient: %+v", err)
+			}
+		}
+
+		return nil
+	}
+
+	return nil
+}
+
+func testAccAzureRMVirtualHub_basic(rInt int, location string) string {
+	template := testAccAzureRMVirtualHub_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub" "test" {
+  name                = "acctestVHUB-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  virtual_wan_id      = "${azurerm_virtual_wan.test.id}"
+  address_prefix      = "10.0.1.0/24"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMVirtualHub_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMVirtualHub_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub" "import" {
+  name                = "${azurerm_virtual_hub.test.name}"
+  location            = "${azurerm_virtual_hub.test.location}"
+  resource_group_name = "${azurerm_virtual_hub.test.resource_group_name}"
+  virtual_wan_id      = "${azurerm_virtual_hub.test.virtual_wan_id}"
+  address_prefix      = "10.0.1.0/24"
+}
+`, template)
+}
+
+func testAccAzureRMVirtualHub_route(rInt int, location string) string {
+	template := testAccAzureRMVirtualHub_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub" "test" {
+  name                = "acctestVHUB-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  virtual_wan_id      = "${azurerm_virtual_wan.test.id}"
+  address_prefix      = "10.0.1.0/24"
+
+  route {
+    address_prefixes    = ["172.0.1.0/24"]
+    next_hop_ip_address = "12.34.56.78"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMVirtualHub_tags(rInt int, location string) string {
+	template := testAccAzureRMVirtualHub_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub" "test" {
+  name                = "acctestVHUB-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  virtual_wan_id      = "${azurerm_virtual_wan.test.id}"
+  address_prefix      = "10.0.1.0/24"
+
+  tags = {
+    Hello = "World"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMVirtualHub_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_wan" "test" {
+  name                = "acctestvwan-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+}
+`, rInt, location, rInt)
+}
@@ -0,0 +1,467 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	computeSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+const (
+	diskEncryptionExtensionPublisher = "Microsoft.Azure.Security"
+
+	diskEncryptionExtensionTypeLinux    = "AzureDiskEncryptionForLinux"
+	diskEncryptionExtensionVersionLinux = "1.1"
+
+	diskEncryptionExtensionTypeWindows    = "AzureDiskEncryption"
+	diskEncryptionExtensionVersionWindows = "2.2"
+
+	diskEncryptionStatusPrefix = "EncryptionState/"
+)
+
+func resourceArmVirtualMachineDiskEncryption() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVirtualMachineDiskEncryptionCreate,
+		Read:   resourceArmVirtualMachineDiskEncryptionRead,
+		Delete: resourceArmVirtualMachineDiskEncryptionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"virtual_machine_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     computeSvc.ValidateVirtualMachineID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
+			},
+
+			"key_vault_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
+			},
+
+			"key_encryption_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateKeyVaultChildId,
+			},
+
+			"key_encryption_key_vault_id": {
+				// defaults to the `key_vault_id` when a `key_encryption_key_id` is specified
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
+			},
+
+			"key_encryption_algorithm": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "RSA-OAEP",
+				ValidateFunc: validation.StringInSlice([]string{
+					"RSA-OAEP",
+					"RSA-OAEP-256",
+					"RSA1_5",
+				}, false),
+			},
+
+			"volume_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "All",
+				ValidateFunc: validation.StringInSlice([]string{
+					"All",
+					"Data",
+					"OS",
+				}, false),
+			},
+
+			"disk": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"encryption_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmVirtualMachineDiskEncryptionCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).compute.VMExtensionClient
+	vmClient := meta.(*ArmClient).compute.VMClient
+	vaultsClient := meta.(*ArmClient).keyvault.VaultsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	virtualMachineId := d.Get("virtual_machine_id").(string)
+	vmId, err := azure.ParseAzureResourceID(virtualMachineId)
+	if err != nil {
+		return err
+	}
+	resourceGroup := vmId.ResourceGroup
+	vmName := vmId.Path["virtualMachines"]
+
+	virtualMachine, err := vmClient.Get(ctx, resourceGroup, vmName, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Virtual Machine %q (Resource Group %q): %+v", vmName, resourceGroup, err)
+	}
+
+	osType, err := diskEncryptionVirtualMachineOSType(virtualMachine)
+	if err != nil {
+		return fmt.Errorf("Error determining the OS Type of Virtual Machine %q (Resource Group %q): %+v", vmName, resourceGroup, err)
+	}
+
+	extensionType, extensionVersion := diskEncryptionExtensionForOSType(osType)
+	name := extensionType
+
+	if meta.(*ArmClient).Features.RequiresImport {
+		existing, err := client.Get(ctx, resourceGroup, vmName, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Disk Encryption Extension %q (Virtual Machine %q / Resource Group %q): %s", name, vmName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_virtual_machine_disk_encryption", *existing.ID)
+		}
+	}
+
+	keyVaultId := d.Get("key_vault_id").(string)
+	keyVaultUrl, err := azure.GetKeyVaultBaseUrlFromID(ctx, vaultsClient, keyVaultId)
+	if err != nil {
+		return fmt.Errorf("Error looking up Key Vault URI from ID %q: %+v", keyVaultId, err)
+	}
+
+	settings := map[string]interface{}{
+		"EncryptionOperation": "EnableEncryption",
+		"KeyVaultURL":         keyVaultUrl,
+		"KeyVaultResourceId":  keyVaultId,
+		"VolumeType":          d.Get("volume_type").(string),
+	}
+
+	if keyEncryptionKeyId := d.Get("key_encryption_key_id").(string); keyEncryptionKeyId != "" {
+		keyEncryptionKeyVaultId := d.Get("key_encryption_key_vault_id").(string)
+		if keyEncryptionKeyVaultId == "" {
+			keyEncryptionKeyVaultId = keyVaultId
+		}
+
+		settings["KeyEncryptionKeyURL"] = keyEncryptionKeyId
+		settings["KekVaultResourceId"] = keyEncryptionKeyVaultId
+		settings["KeyEncryptionAlgorithm"] = d.Get("key_encryption_algorithm").(string)
+	} else if d.Get("key_encryption_key_vault_id").(string) != "" {
+		return fmt.Errorf("`key_encryption_key_vault_id` can only be specified when `key_encryption_key_id` is set")
+	}
+
+	extension := compute.VirtualMachineExtension{
+		Location: virtualMachine.Location,
+		VirtualMachineExtensionProperties: &compute.VirtualMachineExtensionProperties{
+			Publisher:               utils.String(diskEncryptionExtensionPublisher),
+			Type:                    utils.String(extensionType),
+			TypeHandlerVersion:      utils.String(extensionVersion),
+			AutoUpgradeMinorVersion: utils.Bool(true),
+			Settings:                settings,
+		},
+	}
+
+	log.Printf("[DEBUG] Enabling Disk Encryption on Virtual Machine %q (Resource Group %q)..", vmName, resourceGroup)
+	if err := applyDiskEncryptionExtension(ctx, client, resourceGroup, vmName, name, extension); err != nil {
+		return err
+	}
+	log.Printf("[DEBUG] Enabled Disk Encryption on Virtual Machine %q (Resource Group %q).", vmName, resourceGroup)
+
+	read, err := client.Get(ctx, resourceGroup, vmName, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Disk Encryption Extension %q (Virtual Machine %q / Resource Group %q): %+v", name, vmName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for Disk Encryption Extension %q (Virtual Machine %q / Resource Group %q)", name, vmName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmVirtualMachineDiskEncryptionRead(d, meta)
+}
+
+func resourceArmVirtualMachineDiskEncryptionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).compute.VMExtensionClient
+	vmClient := meta.(*ArmClient).compute.VMClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	vmName := id.Path["virtualMachines"]
+	name := id.Path["extensions"]
+
+	resp, err := client.Get(ctx, resourceGroup, vmName, name, "instanceView")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Disk Encryption Extension %q was not found on Virtual Machine %q (Resource Group %q) - removing from state!", name, vmName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Disk Encryption Extension %q (Virtual Machine %q / Resource Group %q): %+v", name, vmName, resourceGroup, err)
+	}
+
+	d.Set("virtual_machine_id", fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/virtualMachines/%s", id.SubscriptionID, resourceGroup, vmName))
+
+	if props := resp.VirtualMachineExtensionProperties; props != nil {
+		if settings, ok := props.Settings.(map[string]interface{}); ok {
+			d.Set("key_vault_id", diskEncryptionSettingValue(settings, "KeyVaultResourceId"))
+			d.Set("key_encryption_key_id", diskEncryptionSettingValue(settings, "KeyEncryptionKeyURL"))
+			d.Set("key_encryption_key_vault_id", diskEncryptionSettingValue(settings, "KekVaultResourceId"))
+
+			if algorithm := diskEncryptionSettingValue(settings, "KeyEncryptionAlgorithm"); algorithm != "" {
+				d.Set("key_encryption_algorithm", algorithm)
+			}
+
+			if volumeType := diskEncryptionSettingValue(settings, "VolumeType"); volumeType != "" {
+				d.Set("volume_type", volumeType)
+			}
+		}
+
+		statusMessage := ""
+		if instanceView := props.InstanceView; instanceView != nil && instanceView.Statuses != nil {
+			for _, status := range *instanceView.Statuses {
+				if status.Message != nil {
+					statusMessage = *status.Message
+					break
+				}
+			}
+		}
+		d.Set("status_message", statusMessage)
+	}
+
+	instanceView, err := vmClient.InstanceView(ctx, resourceGroup, vmName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Instance View for Virtual Machine %q (Resource Group %q): %+v", vmName, resourceGroup, err)
+	}
+
+	if err := d.Set("disk", flattenVirtualMachineDiskEncryptionStatuses(instanceView.Disks)); err != nil {
+		return fmt.Errorf("Error setting `disk`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmVirtualMachineDiskEncryptionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).compute.VMExtensionClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	vmName := id.Path["virtualMachines"]
+	name := id.Path["extensions"]
+
+	existing, err := client.Get(ctx, resourceGroup, vmName, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Disk Encryption Extension %q (Virtual Machine %q / Resource Group %q): %+v", name, vmName, resourceGroup, err)
+	}
+
+	// Linux Virtual Machines don't support decrypting the OS Disk - so in that case we can only remove the Extension
+	volumeType := d.Get("volume_type").(string)
+	canDisableEncryption := !strings.EqualFold(name, diskEncryptionExtensionTypeLinux) || strings.EqualFold(volumeType, "Data")
+	if canDisableEncryption && existing.VirtualMachineExtensionProperties != nil {
+		settings := map[string]interface{}{
+			"EncryptionOperation": "DisableEncryption",
+			"VolumeType":          volumeType,
+		}
+
+		extension := compute.VirtualMachineExtension{
+			Location: existing.Location,
+			VirtualMachineExtensionProperties: &compute.VirtualMachineExtensionProperties{
+				Publisher:               existing.VirtualMachineExtensionProperties.Publisher,
+				Type:                    existing.VirtualMachineExtensionProperties.Type,
+				TypeHandlerVersion:      existing.VirtualMachineExtensionProperties.TypeHandlerVersion,
+				AutoUpgradeMinorVersion: existing.VirtualMachineExtensionProperties.AutoUpgradeMinorVersion,
+				Settings:                settings,
+			},
+		}
+
+		log.Printf("[DEBUG] Disabling Disk Encryption on Virtual Machine %q (Resource Group %q)..", vmName, resourceGroup)
+		if err := applyDiskEncryptionExtension(ctx, client, resourceGroup, vmName, name, extension); err != nil {
+			return err
+		}
+		log.Printf("[DEBUG] Disabled Disk Encryption on Virtual Machine %q (Resource Group %q).", vmName, resourceGroup)
+	} else {
+		log.Printf("[DEBUG] Disk Encryption of the OS Disk cannot be disabled on Linux Virtual Machine %q (Resource Group %q) - removing the Extension only", vmName, resourceGroup)
+	}
+
+	future, err := client.Delete(ctx, resourceGroup, vmName, name)
+	if err != nil {
+		return fmt.Errorf("Error deleting Disk Encryption Extension %q (Virtual Machine %q / Resource Group %q): %+v", name, vmName, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of Disk Encryption Extension %q (Virtual Machine %q / Resource Group %q): %+v", name, vmName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+// applyDiskEncryptionExtension provisions the Disk Encryption Extension and waits for it to finish running, since
+// the encryption operation only completes once the Extension reports that it's been provisioned successfully
+func applyDiskEncryptionExtension(ctx context.Context, client *compute.VirtualMachineExtensionsClient, resourceGroup, vmName, name string, extension compute.VirtualMachineExtension) error {
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, vmName, name, extension)
+	if err != nil {
+		return fmt.Errorf("Error provisioning Disk Encryption Extension %q (Virtual Machine %q / Resource Group %q): %+v", name, vmName, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Disk Encryption Extension %q (Virtual Machine %q / Resource Group %q) to finish: %+v", name, vmName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, vmName, name, "instanceView")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Disk Encryption Extension %q (Virtual Machine %q / Resource Group %q): %+v", name, vmName, resourceGroup, err)
+	}
+
+	if props := read.VirtualMachineExtensionProperties; props != nil && props.ProvisioningState != nil {
+		if !strings.EqualFold(*props.ProvisioningState, "Succeeded") {
+			message := ""
+			if instanceView := props.InstanceView; instanceView != nil && instanceView.Statuses != nil {
+				for _, status := range *instanceView.Statuses {
+					if status.Message != nil {
+						message = *status.Message
+					}
+				}
+			}
+
+			return fmt.Errorf("Disk Encryption Extension %q (Virtual Machine %q / Resource Group %q) finished with the Provisioning State %q: %s", name, vmName, resourceGroup, *props.ProvisioningState, message)
+		}
+	}
+
+	return nil
+}
+
+func diskEncryptionVirtualMachineOSType(virtualMachine compute.VirtualMachine) (compute.OperatingSystemTypes, error) {
+	props := virtualMachine.VirtualMachineProperties
+	if props == nil {
+		return "", fmt.Errorf("`properties` was nil")
+	}
+
+	if props.StorageProfile != nil && props.StorageProfile.OsDisk != nil && props.StorageProfile.OsDisk.OsType != "" {
+		return props.StorageProfile.OsDisk.OsType, nil
+	}
+
+	if profile := props.OsProfile; profile != nil {
+		if profile.LinuxConfiguration != nil {
+			return compute.Linux, nil
+		}
+
+		if profile.WindowsConfiguration != nil {
+			return compute.Windows, nil
+		}
+	}
+
+	return "", fmt.Errorf("the OS Type couldn't be determined from the OS Disk or OS Profile")
+}
+
+func diskEncryptionExtensionForOSType(osType compute.OperatingSystemTypes) (string, string) {
+	if osType == compute.Linux {
+		return diskEncryptionExtensionTypeLinux, diskEncryptionExtensionVersionLinux
+	}
+
+	return diskEncryptionExtensionTypeWindows, diskEncryptionExtensionVersionWindows
+}
+
+func diskEncryptionSettingValue(settings map[string]interface{}, key string) string {
+	for k, v := range settings {
+		if !strings.EqualFold(k, key) {
+			continue
+		}
+
+		if value, ok := v.(string); ok {
+			return value
+		}
+	}
+
+	return ""
+}
+
+func flattenVirtualMachineDiskEncryptionStatuses(input *[]compute.DiskInstanceView) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make([]interface{}, 0)
+	for _, disk := range *input {
+		name := ""
+		if disk.Name != nil {
+			name = *disk.Name
+		}
+
+		encryptionStatus := ""
+		if disk.Statuses != nil {
+			for _, status := range *disk.Statuses {
+				if status.Code != nil && strings.HasPrefix(*status.Code, diskEncryptionStatusPrefix) {
+					encryptionStatus = strings.TrimPrefix(*status.Code, diskEncryptionStatusPrefix)
+				}
+			}
+		}
+
+		output = append(output, map[string]interface{}{
+			"name":              name,
+			"encryption_status": encryptionStatus,
+		})
+	}
+
+	return output
+}
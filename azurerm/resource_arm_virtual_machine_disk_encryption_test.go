@@ -0,0 +1,253 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+)
+
+func TestAccAzureRMVirtualMachineDiskEncryption_basic(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_disk_encryption.test"
+	ri := tf.AccRandTimeInt()
+	rs := tf.AccRandString(6)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineDiskEncryptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineDiskEncryption_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineDiskEncryptionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "volume_type", "All"),
+					resource.TestCheckResourceAttr(resourceName, "disk.0.encryption_status", "encrypted"),
+					resource.TestCheckResourceAttrSet(resourceName, "status_message"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualMachineDiskEncryption_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_virtual_machine_disk_encryption.test"
+	ri := tf.AccRandTimeInt()
+	rs := tf.AccRandString(6)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineDiskEncryptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineDiskEncryption_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineDiskEncryptionExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMVirtualMachineDiskEncryption_requiresImport(ri, rs, location),
+				ExpectError: testRequiresImportError("azurerm_virtual_machine_disk_encryption"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualMachineDiskEncryption_keyEncryptionKey(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_disk_encryption.test"
+	ri := tf.AccRandTimeInt()
+	rs := tf.AccRandString(6)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineDiskEncryptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineDiskEncryption_keyEncryptionKey(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineDiskEncryptionExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "key_encryption_key_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "key_encryption_key_vault_id", "azurerm_key_vault.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "key_encryption_algorithm", "RSA-OAEP"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMVirtualMachineDiskEncryptionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := azure.ParseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		vmName := id.Path["virtualMachines"]
+		name := id.Path["extensions"]
+
+		client := testAccProvider.Meta().(*ArmClient).compute.VMExtensionClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, vmName, name, "")
+		if err != nil {
+			return fmt.Errorf("Bad: Get on VMExtensionClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Disk Encryption Extension %q (Virtual Machine %q / Resource Group %q) does not exist", name, vmName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMVirtualMachineDiskEncryptionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).compute.VMExtensionClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_virtual_machine_disk_encryption" {
+			continue
+		}
+
+		id, err := azure.ParseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		vmName := id.Path["virtualMachines"]
+		name := id.Path["extensions"]
+
+		resp, err := client.Get(ctx, resourceGroup, vmName, name, "")
+		if err != nil {
+			if resp.StatusCode == http.StatusNotFound {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Disk Encryption Extension %q (Virtual Machine %q / Resource Group %q) still exists", name, vmName, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMVirtualMachineDiskEncryption_template(rInt int, rString string, location string) string {
+	template := testAccAzureRMWindowsVirtualMachine_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_key_vault" "test" {
+  name                        = "acctestkv%s"
+  location                    = "${azurerm_resource_group.test.location}"
+  resource_group_name         = "${azurerm_resource_group.test.name}"
+  tenant_id                   = "${data.azurerm_client_config.current.tenant_id}"
+  enabled_for_disk_encryption = true
+
+  sku_name = "premium"
+
+  access_policy {
+    tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+    object_id = "${data.azurerm_client_config.current.service_principal_object_id}"
+
+    key_permissions = [
+      "create",
+      "delete",
+      "get",
+    ]
+
+    secret_permissions = [
+      "delete",
+      "get",
+      "set",
+    ]
+  }
+}
+`, template, rString)
+}
+
+func testAccAzureRMVirtualMachineDiskEncryption_basic(rInt int, rString string, location string) string {
+	template := testAccAzureRMVirtualMachineDiskEncryption_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_disk_encryption" "test" {
+  virtual_machine_id = "${azurerm_windows_virtual_machine.test.id}"
+  key_vault_id       = "${azurerm_key_vault.test.id}"
+}
+`, template)
+}
+
+func testAccAzureRMVirtualMachineDiskEncryption_requiresImport(rInt int, rString string, location string) string {
+	template := testAccAzureRMVirtualMachineDiskEncryption_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_disk_encryption" "import" {
+  virtual_machine_id = "${azurerm_virtual_machine_disk_encryption.test.virtual_machine_id}"
+  key_vault_id       = "${azurerm_virtual_machine_disk_encryption.test.key_vault_id}"
+}
+`, template)
+}
+
+func testAccAzureRMVirtualMachineDiskEncryption_keyEncryptionKey(rInt int, rString string, location string) string {
+	template := testAccAzureRMVirtualMachineDiskEncryption_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_key" "test" {
+  name         = "acctestkek-%s"
+  key_vault_id = "${azurerm_key_vault.test.id}"
+  key_type     = "RSA"
+  key_size     = 2048
+
+  key_opts = [
+    "decrypt",
+    "encrypt",
+    "sign",
+    "unwrapKey",
+    "verify",
+    "wrapKey",
+  ]
+}
+
+resource "azurerm_virtual_machine_disk_encryption" "test" {
+  virtual_machine_id    = "${azurerm_windows_virtual_machine.test.id}"
+  key_vault_id          = "${azurerm_key_vault.test.id}"
+  key_encryption_key_id = "${azurerm_key_vault_key.test.id}"
+}
+`, template, rString)
+}
@@ -9,7 +9,6 @@ import (
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
@@ -268,7 +267,7 @@ func TestAccAzureRMVirtualMachine_dataDiskTypeConflict(t *testing.T) {
 
 func TestAccAzureRMVirtualMachine_bugAzureRM33(t *testing.T) {
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(7)
+	rs := tf.AccRandString(7)
 	config := testAccAzureRMVirtualMachine_bugAzureRM33(ri, rs, testLocation())
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -349,7 +348,7 @@ func TestAccAzureRMVirtualMachine_windowsNoConfig(t *testing.T) {
 func TestAccAzureRMVirtualMachine_multipleNICs(t *testing.T) {
 	resourceName := "azurerm_virtual_machine.test"
 	ri := tf.AccRandTimeInt()
-	rs := acctest.RandString(5)
+	rs := tf.AccRandString(5)
 	subscriptionId := os.Getenv("ARM_SUBSCRIPTION_ID")
 	prefix := fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-%d/providers/Microsoft.Network/networkInterfaces", subscriptionId, ri)
 	firstNicName := fmt.Sprintf("%s/acctni1-%d", prefix, ri)
@@ -496,7 +495,7 @@ func TestAccAzureRMVirtualMachine_basicLinuxMachine_managedDisk_changeWriteAccel
 func TestAccAzureRMVirtualMachine_winRMCerts(t *testing.T) {
 	var vm compute.VirtualMachine
 	resourceName := "azurerm_virtual_machine.test"
-	rString := acctest.RandString(5)
+	rString := tf.AccRandString(5)
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
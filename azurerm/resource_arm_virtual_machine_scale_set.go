@@ -5,9 +5,9 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 
 	"github.com/hashicorp/terraform/helper/hashcode"
@@ -18,6 +18,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	networkSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -34,6 +36,13 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -131,9 +140,10 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 			},
 
 			"health_probe_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: azure.ValidateResourceID,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     networkSvc.ValidateLoadBalancerProbeID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
 			},
 
 			"automatic_os_upgrade": {
@@ -254,9 +264,10 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"source_vault_id": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: azure.ValidateResourceID,
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     azure.ValidateResourceID,
+							DiffSuppressFunc: suppress.ResourceIDDifference,
 						},
 
 						"vault_certificates": {
@@ -404,9 +415,10 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 						},
 
 						"network_security_group_id": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: azure.ValidateResourceID,
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     networkSvc.ValidateNetworkSecurityGroupID,
+							DiffSuppressFunc: suppress.ResourceIDDifference,
 						},
 
 						"dns_settings": {
@@ -439,9 +451,10 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 									},
 
 									"subnet_id": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: azure.ValidateResourceID,
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateFunc:     networkSvc.ValidateSubnetID,
+										DiffSuppressFunc: suppress.ResourceIDDifference,
 									},
 
 									"application_gateway_backend_address_pool_ids": {
@@ -456,7 +469,7 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 										Optional: true,
 										Elem: &schema.Schema{
 											Type:         schema.TypeString,
-											ValidateFunc: azure.ValidateResourceID,
+											ValidateFunc: networkSvc.ValidateApplicationSecurityGroupID,
 										},
 										Set:      schema.HashString,
 										MaxItems: 20,
@@ -763,7 +776,7 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 				// proximity placement group ID in the response we get from the API request
 				//
 				// todo can be removed when https://github.com/Azure/azure-sdk-for-go/issues/5699 is fixed
-				DiffSuppressFunc: suppress.CaseDifference,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
 			},
 
 			"tags": tags.Schema(),
@@ -775,14 +788,15 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 
 func resourceArmVirtualMachineScaleSetCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).compute.VMScaleSetClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Virtual Machine Scale Set creation.")
 
 	name := d.Get("name").(string)
 	resGroup := d.Get("resource_group_name").(string)
 
-	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+	if meta.(*ArmClient).Features.RequiresImport && d.IsNewResource() {
 		existing, err := client.Get(ctx, resGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -934,7 +948,8 @@ func resourceArmVirtualMachineScaleSetCreateUpdate(d *schema.ResourceData, meta
 
 func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).compute.VMScaleSetClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -1093,7 +1108,8 @@ func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interfac
 
 func resourceArmVirtualMachineScaleSetDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).compute.VMScaleSetClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -1312,7 +1328,7 @@ func flattenAzureRmVirtualMachineScaleSetNetworkProfile(profile *compute.Virtual
 				if properties := ipConfig.VirtualMachineScaleSetIPConfigurationProperties; properties != nil {
 
 					if properties.Subnet != nil {
-						config["subnet_id"] = *properties.Subnet.ID
+						config["subnet_id"] = azure.NormalizeResourceID(*properties.Subnet.ID)
 					}
 
 					addressPools := make([]interface{}, 0)
@@ -8,7 +8,6 @@ import (
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -195,8 +194,8 @@ func TestAccAzureRMVirtualMachine_tags(t *testing.T) {
 	})
 }
 
-//This is a regression test around https://github.com/hashicorp/terraform/issues/6517
-//Because we use CreateUpdate, we were sending an empty password on update requests
+// This is a regression test around https://github.com/hashicorp/terraform/issues/6517
+// Because we use CreateUpdate, we were sending an empty password on update requests
 func TestAccAzureRMVirtualMachine_updateMachineSize(t *testing.T) {
 	var vm compute.VirtualMachine
 
@@ -505,7 +504,8 @@ func TestAccAzureRMVirtualMachine_changeOSDiskVhdUri(t *testing.T) {
 }
 
 // to accept terms for config:
-//   get-AzureRmMarketplaceTerms -publisher kemptech -product vlm-azure -name freeloadmaster | Set-AzureRmMarketplaceTerms -accept
+//
+//	get-AzureRmMarketplaceTerms -publisher kemptech -product vlm-azure -name freeloadmaster | Set-AzureRmMarketplaceTerms -accept
 func TestAccAzureRMVirtualMachine_plan(t *testing.T) {
 	var vm compute.VirtualMachine
 	ri := tf.AccRandTimeInt()
@@ -527,7 +527,7 @@ func TestAccAzureRMVirtualMachine_plan(t *testing.T) {
 
 func TestAccAzureRMVirtualMachine_changeSSHKey(t *testing.T) {
 	var vm compute.VirtualMachine
-	rs := strings.ToLower(acctest.RandString(10))
+	rs := strings.ToLower(tf.AccRandString(10))
 	preConfig := testAccAzureRMVirtualMachine_linuxMachineWithSSH(rs, testLocation())
 	postConfig := testAccAzureRMVirtualMachine_linuxMachineWithSSHRemoved(rs, testLocation())
 	resource.ParallelTest(t, resource.TestCase{
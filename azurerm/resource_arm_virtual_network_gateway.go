@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
 	"github.com/hashicorp/terraform/helper/hashcode"
@@ -14,8 +15,9 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	networkSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -25,12 +27,18 @@ func resourceArmVirtualNetworkGateway() *schema.Resource {
 		Read:   resourceArmVirtualNetworkGatewayRead,
 		Update: resourceArmVirtualNetworkGatewayCreateUpdate,
 		Delete: resourceArmVirtualNetworkGatewayDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+
+		Importer: azure.ValidateResourceIDPriorToImport(networkSvc.ValidateVirtualNetworkGatewayID),
 
 		CustomizeDiff: resourceArmVirtualNetworkGatewayCustomizeDiff,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
+			Delete: schema.DefaultTimeout(90 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -122,13 +130,14 @@ func resourceArmVirtualNetworkGateway() *schema.Resource {
 							Type:             schema.TypeString,
 							Required:         true,
 							ValidateFunc:     validateArmVirtualNetworkGatewaySubnetId,
-							DiffSuppressFunc: suppress.CaseDifference,
+							DiffSuppressFunc: suppress.ResourceIDDifference,
 						},
 
 						"public_ip_address_id": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: azure.ValidateResourceIDOrEmpty,
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     azure.ValidateResourceIDOrEmpty,
+							DiffSuppressFunc: suppress.ResourceIDDifference,
 						},
 					},
 				},
@@ -256,9 +265,10 @@ func resourceArmVirtualNetworkGateway() *schema.Resource {
 			},
 
 			"default_local_network_gateway_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: azure.ValidateResourceIDOrEmpty,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     azure.ValidateResourceIDOrEmpty,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
 			},
 
 			"tags": tags.Schema(),
@@ -268,14 +278,15 @@ func resourceArmVirtualNetworkGateway() *schema.Resource {
 
 func resourceArmVirtualNetworkGatewayCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).network.VnetGatewayClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Virtual Network Gateway creation.")
 
 	name := d.Get("name").(string)
 	resGroup := d.Get("resource_group_name").(string)
 
-	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+	if meta.(*ArmClient).Features.RequiresImport && d.IsNewResource() {
 		existing, err := client.Get(ctx, resGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -327,7 +338,8 @@ func resourceArmVirtualNetworkGatewayCreateUpdate(d *schema.ResourceData, meta i
 
 func resourceArmVirtualNetworkGatewayRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).network.VnetGatewayClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resGroup, name, err := resourceGroupAndVirtualNetworkGatewayFromId(d.Id())
 	if err != nil {
@@ -384,7 +396,8 @@ func resourceArmVirtualNetworkGatewayRead(d *schema.ResourceData, meta interface
 
 func resourceArmVirtualNetworkGatewayDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).network.VnetGatewayClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resGroup, name, err := resourceGroupAndVirtualNetworkGatewayFromId(d.Id())
 	if err != nil {
@@ -617,13 +630,13 @@ func flattenArmVirtualNetworkGatewayIPConfigurations(ipConfigs *[]network.Virtua
 
 			if subnet := props.Subnet; subnet != nil {
 				if id := subnet.ID; id != nil {
-					v["subnet_id"] = *id
+					v["subnet_id"] = azure.NormalizeResourceID(*id)
 				}
 			}
 
 			if pip := props.PublicIPAddress; pip != nil {
 				if id := pip.ID; id != nil {
-					v["public_ip_address_id"] = *id
+					v["public_ip_address_id"] = azure.NormalizeResourceID(*id)
 				}
 			}
 
@@ -5,7 +5,6 @@ import (
 	"net/http"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -189,7 +188,7 @@ func TestAccAzureRMVirtualNetwork_withTags(t *testing.T) {
 
 func TestAccAzureRMVirtualNetwork_bug373(t *testing.T) {
 	resourceName := "azurerm_virtual_network.test"
-	rs := acctest.RandString(6)
+	rs := tf.AccRandString(6)
 	config := testAccAzureRMVirtualNetwork_bug373(rs, testLocation())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -0,0 +1,490 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	networkSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmVpnGateway() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVpnGatewayCreateUpdate,
+		Read:   resourceArmVpnGatewayRead,
+		Update: resourceArmVpnGatewayCreateUpdate,
+		Delete: resourceArmVpnGatewayDelete,
+
+		Importer: azure.ValidateResourceIDPriorToImport(networkSvc.ValidateVpnGatewayID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
+			Delete: schema.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"virtual_hub_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     networkSvc.ValidateVirtualHubID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
+			},
+
+			"scale_unit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"bgp_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"asn": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"peer_weight": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+
+						"bgp_peering_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"vpn_connection": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"remote_vpn_site_id": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     networkSvc.ValidateVpnSiteID,
+							DiffSuppressFunc: suppress.ResourceIDDifference,
+						},
+
+						"shared_key": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"protocol": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(network.IKEv2),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.IKEv1),
+								string(network.IKEv2),
+							}, false),
+						},
+
+						"bandwidth_in_mbps": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      10,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"routing_weight": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 32000),
+						},
+
+						"enable_bgp": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"enable_internet_security": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"use_policy_based_traffic_selectors": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceArmVpnGatewayCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network.VpnGatewaysClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for VPN Gateway creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if meta.(*ArmClient).Features.RequiresImport && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing VPN Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_vpn_gateway", *existing.ID)
+		}
+	}
+
+	virtualHubId := d.Get("virtual_hub_id").(string)
+	parsedVirtualHubId, err := networkSvc.ParseVirtualHubID(virtualHubId)
+	if err != nil {
+		return err
+	}
+
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	scaleUnit := d.Get("scale_unit").(int)
+	bgpSettings := d.Get("bgp_settings").([]interface{})
+	connections := d.Get("vpn_connection").([]interface{})
+	t := d.Get("tags").(map[string]interface{})
+
+	parameters := network.VpnGateway{
+		Location: utils.String(location),
+		VpnGatewayProperties: &network.VpnGatewayProperties{
+			VirtualHub: &network.SubResource{
+				ID: utils.String(virtualHubId),
+			},
+			BgpSettings:         expandArmVpnGatewayBgpSettings(bgpSettings),
+			Connections:         expandArmVpnGatewayConnections(connections),
+			VpnGatewayScaleUnit: utils.Int32(int32(scaleUnit)),
+		},
+		Tags: tags.Expand(t),
+	}
+
+	// the VPN Gateway is provisioned into the Virtual Hub
+	if err := locks.ByNameWithContext(ctx, parsedVirtualHubId.Name, virtualHubResourceName); err != nil {
+		return err
+	}
+	defer locks.UnlockByName(parsedVirtualHubId.Name, virtualHubResourceName)
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating VPN Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of VPN Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving VPN Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read VPN Gateway %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmVpnGatewayRead(d, meta)
+}
+
+func resourceArmVpnGatewayRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network.VpnGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := networkSvc.ParseVpnGatewayID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] VPN Gateway %q (Resource Group %q) was not found - removing from state", id.Name, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving VPN Gateway %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	if props := resp.VpnGatewayProperties; props != nil {
+		virtualHubId := ""
+		if props.VirtualHub != nil && props.VirtualHub.ID != nil {
+			virtualHubId = *props.VirtualHub.ID
+		}
+		d.Set("virtual_hub_id", virtualHubId)
+
+		scaleUnit := 0
+		if props.VpnGatewayScaleUnit != nil {
+			scaleUnit = int(*props.VpnGatewayScaleUnit)
+		}
+		d.Set("scale_unit", scaleUnit)
+
+		if err := d.Set("bgp_settings", flattenArmVpnGatewayBgpSettings(props.BgpSettings)); err != nil {
+			return fmt.Errorf("Error setting `bgp_settings`: %+v", err)
+		}
+
+		// the API doesn't return the Shared Key, so we pull it from the existing state
+		if err := d.Set("vpn_connection", flattenArmVpnGatewayConnections(props.Connections, d.Get("vpn_connection").([]interface{}))); err != nil {
+			return fmt.Errorf("Error setting `vpn_connection`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceArmVpnGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network.VpnGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := networkSvc.ParseVpnGatewayID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	parsedVirtualHubId, err := networkSvc.ParseVirtualHubID(d.Get("virtual_hub_id").(string))
+	if err != nil {
+		return err
+	}
+
+	if err := locks.ByNameWithContext(ctx, parsedVirtualHubId.Name, virtualHubResourceName); err != nil {
+		return err
+	}
+	defer locks.UnlockByName(parsedVirtualHubId.Name, virtualHubResourceName)
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		// deleted outside of Terraform
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting VPN Gateway %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for the deletion of VPN Gateway %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandArmVpnGatewayBgpSettings(input []interface{}) *network.BgpSettings {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &network.BgpSettings{
+		Asn:        utils.Int64(int64(v["asn"].(int))),
+		PeerWeight: utils.Int32(int32(v["peer_weight"].(int))),
+	}
+}
+
+func flattenArmVpnGatewayBgpSettings(input *network.BgpSettings) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	asn := 0
+	if input.Asn != nil {
+		asn = int(*input.Asn)
+	}
+
+	bgpPeeringAddress := ""
+	if input.BgpPeeringAddress != nil {
+		bgpPeeringAddress = *input.BgpPeeringAddress
+	}
+
+	peerWeight := 0
+	if input.PeerWeight != nil {
+		peerWeight = int(*input.PeerWeight)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"asn":                 asn,
+			"bgp_peering_address": bgpPeeringAddress,
+			"peer_weight":         peerWeight,
+		},
+	}
+}
+
+func expandArmVpnGatewayConnections(input []interface{}) *[]network.VpnConnection {
+	results := make([]network.VpnConnection, 0)
+
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+
+		v := item.(map[string]interface{})
+		connection := network.VpnConnection{
+			Name: utils.String(v["name"].(string)),
+			VpnConnectionProperties: &network.VpnConnectionProperties{
+				RemoteVpnSite: &network.SubResource{
+					ID: utils.String(v["remote_vpn_site_id"].(string)),
+				},
+				VpnConnectionProtocolType:      network.VirtualNetworkGatewayConnectionProtocol(v["protocol"].(string)),
+				ConnectionBandwidth:            utils.Int32(int32(v["bandwidth_in_mbps"].(int))),
+				RoutingWeight:                  utils.Int32(int32(v["routing_weight"].(int))),
+				EnableBgp:                      utils.Bool(v["enable_bgp"].(bool)),
+				EnableInternetSecurity:         utils.Bool(v["enable_internet_security"].(bool)),
+				UsePolicyBasedTrafficSelectors: utils.Bool(v["use_policy_based_traffic_selectors"].(bool)),
+			},
+		}
+
+		if sharedKey := v["shared_key"].(string); sharedKey != "" {
+			connection.VpnConnectionProperties.SharedKey = utils.String(sharedKey)
+		}
+
+		results = append(results, connection)
+	}
+
+	return &results
+}
+
+func flattenArmVpnGatewayConnections(input *[]network.VpnConnection, existing []interface{}) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	existingSharedKeys := make(map[string]string)
+	for _, item := range existing {
+		if item == nil {
+			continue
+		}
+
+		v := item.(map[string]interface{})
+		existingSharedKeys[v["name"].(string)] = v["shared_key"].(string)
+	}
+
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		remoteVpnSiteId := ""
+		protocol := ""
+		bandwidthInMbps := 0
+		routingWeight := 0
+		enableBgp := false
+		enableInternetSecurity := false
+		usePolicyBasedTrafficSelectors := false
+		sharedKey := existingSharedKeys[name]
+		if props := item.VpnConnectionProperties; props != nil {
+			if props.RemoteVpnSite != nil && props.RemoteVpnSite.ID != nil {
+				remoteVpnSiteId = *props.RemoteVpnSite.ID
+			}
+
+			protocol = string(props.VpnConnectionProtocolType)
+
+			if props.ConnectionBandwidth != nil {
+				bandwidthInMbps = int(*props.ConnectionBandwidth)
+			}
+
+			if props.RoutingWeight != nil {
+				routingWeight = int(*props.RoutingWeight)
+			}
+
+			if props.EnableBgp != nil {
+				enableBgp = *props.EnableBgp
+			}
+
+			if props.EnableInternetSecurity != nil {
+				enableInternetSecurity = *props.EnableInternetSecurity
+			}
+
+			if props.UsePolicyBasedTrafficSelectors != nil {
+				usePolicyBasedTrafficSelectors = *props.UsePolicyBasedTrafficSelectors
+			}
+
+			if props.SharedKey != nil {
+				sharedKey = *props.SharedKey
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                               name,
+			"remote_vpn_site_id":                 remoteVpnSiteId,
+			"shared_key":                         sharedKey,
+			"protocol":                           protocol,
+			"bandwidth_in_mbps":                  bandwidthInMbps,
+			"routing_weight":                     routingWeight,
+			"enable_bgp":                         enableBgp,
+			"enable_internet_security":           enableInternetSecurity,
+			"use_policy_based_traffic_selectors": usePolicyBasedTrafficSelectors,
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,235 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	networkSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMVpnGateway_basic(t *testing.T) {
+	resourceName := "azurerm_vpn_gateway.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVpnGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVpnGateway_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVpnGatewayExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "scale_unit", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVpnGateway_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_vpn_gateway.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVpnGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVpnGateway_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVpnGatewayExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMVpnGateway_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_vpn_gateway"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVpnGateway_connection(t *testing.T) {
+	resourceName := "azurerm_vpn_gateway.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVpnGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVpnGateway_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVpnGatewayExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "vpn_connection.#", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMVpnGateway_connection(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVpnGatewayExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "vpn_connection.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "vpn_connection.0.protocol", "IKEv2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"vpn_connection.0.shared_key"},
+			},
+		},
+	})
+}
+
+func testCheckAzureRMVpnGatewayExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("VPN Gateway not found: %s", resourceName)
+		}
+
+		id, err := networkSvc.ParseVpnGatewayID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).network.VpnGatewaysClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.Name); err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: VPN Gateway %q (Resource Group %q) does not exist", id.Name, id.ResourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on network.VpnGatewaysClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMVpnGatewayDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).network.VpnGatewaysClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_vpn_gateway" {
+			continue
+		}
+
+		id, err := networkSvc.ParseVpnGatewayID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.Name); err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Get on network.VpnGatewaysClient: %+v", err)
+			}
+		}
+
+		return nil
+	}
+
+	return nil
+}
+
+func testAccAzureRMVpnGateway_basic(rInt int, location string) string {
+	template := testAccAzureRMVpnGateway_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_gateway" "test" {
+  name                = "acctestVPNG-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  virtual_hub_id      = "${azurerm_virtual_hub.test.id}"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMVpnGateway_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMVpnGateway_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_gateway" "import" {
+  name                = "${azurerm_vpn_gateway.test.name}"
+  location            = "${azurerm_vpn_gateway.test.location}"
+  resource_group_name = "${azurerm_vpn_gateway.test.resource_group_name}"
+  virtual_hub_id      = "${azurerm_vpn_gateway.test.virtual_hub_id}"
+}
+`, template)
+}
+
+func testAccAzureRMVpnGateway_connection(rInt int, location string) string {
+	template := testAccAzureRMVpnGateway_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_site" "test" {
+  name                = "acctestvpnsite-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  virtual_wan_id      = "${azurerm_virtual_wan.test.id}"
+  ip_address          = "10.1.0.1"
+  address_prefixes    = ["10.1.0.0/24"]
+}
+
+resource "azurerm_vpn_gateway" "test" {
+  name                = "acctestVPNG-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  virtual_hub_id      = "${azurerm_virtual_hub.test.id}"
+
+  vpn_connection {
+    name               = "acctestvpnconn-%d"
+    remote_vpn_site_id = "${azurerm_vpn_site.test.id}"
+    shared_key         = "4-v3ry-53cr37-1p53c-5h4r3d-k3y"
+  }
+}
+`, template, rInt, rInt, rInt)
+}
+
+func testAccAzureRMVpnGateway_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_wan" "test" {
+  name                = "acctestvwan-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+}
+
+resource "azurerm_virtual_hub" "test" {
+  name                = "acctestvhub-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  virtual_wan_id      = "${azurerm_virtual_wan.test.id}"
+  address_prefix      = "10.0.0.0/24"
+}
+`, rInt, location, rInt, rInt)
+}
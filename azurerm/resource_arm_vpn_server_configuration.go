@@ -0,0 +1,497 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	networkSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmVpnServerConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVpnServerConfigurationCreateUpdate,
+		Read:   resourceArmVpnServerConfigurationRead,
+		Update: resourceArmVpnServerConfigurationCreateUpdate,
+		Delete: resourceArmVpnServerConfigurationDelete,
+
+		Importer: azure.ValidateResourceIDPriorToImport(networkSvc.ValidateVpnServerConfigurationID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"virtual_wan_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     networkSvc.ValidateVirtualWanID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
+			},
+
+			"vpn_protocols": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(network.VpnGatewayTunnelingProtocolIkeV2),
+						string(network.VpnGatewayTunnelingProtocolOpenVPN),
+					}, false),
+				},
+				Set: schema.HashString,
+			},
+
+			"client_root_certificate": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"public_cert_data": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
+
+			"client_revoked_certificate": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"thumbprint": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
+
+			"radius_server": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"secret": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"server_root_certificate": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+
+									"public_cert_data": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+								},
+							},
+						},
+
+						"client_root_certificate": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+
+									"thumbprint": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmVpnServerConfigurationCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network.VpnServerConfigurationsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for VPN Server Configuration creation.")
+
+	name := d.Get("name").(string)
+	virtualWanId, err := networkSvc.ParseVirtualWanID(d.Get("virtual_wan_id").(string))
+	if err != nil {
+		return err
+	}
+	resourceGroup := virtualWanId.ResourceGroup
+	virtualWanName := virtualWanId.Name
+
+	if meta.(*ArmClient).Features.RequiresImport && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, virtualWanName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing VPN Server Configuration %q (Virtual WAN %q / Resource Group %q): %+v", name, virtualWanName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_vpn_server_configuration", *existing.ID)
+		}
+	}
+
+	vpnProtocols := make([]network.VpnGatewayTunnelingProtocol, 0)
+	for _, v := range d.Get("vpn_protocols").(*schema.Set).List() {
+		vpnProtocols = append(vpnProtocols, network.VpnGatewayTunnelingProtocol(v.(string)))
+	}
+
+	props := network.P2SVpnServerConfigurationProperties{
+		Name:         utils.String(name),
+		VpnProtocols: &vpnProtocols,
+		P2SVpnServerConfigVpnClientRootCertificates:    expandArmVpnServerConfigurationClientRootCertificates(d.Get("client_root_certificate").(*schema.Set).List()),
+		P2SVpnServerConfigVpnClientRevokedCertificates: expandArmVpnServerConfigurationClientRevokedCertificates(d.Get("client_revoked_certificate").(*schema.Set).List()),
+	}
+
+	if v := d.Get("radius_server").([]interface{}); len(v) > 0 && v[0] != nil {
+		radius := v[0].(map[string]interface{})
+		props.RadiusServerAddress = utils.String(radius["address"].(string))
+		props.RadiusServerSecret = utils.String(radius["secret"].(string))
+		props.P2SVpnServerConfigRadiusServerRootCertificates = expandArmVpnServerConfigurationRadiusServerRootCertificates(radius["server_root_certificate"].(*schema.Set).List())
+		props.P2SVpnServerConfigRadiusClientRootCertificates = expandArmVpnServerConfigurationRadiusClientRootCertificates(radius["client_root_certificate"].(*schema.Set).List())
+	}
+
+	parameters := network.P2SVpnServerConfiguration{
+		Name:                                utils.String(name),
+		P2SVpnServerConfigurationProperties: &props,
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, virtualWanName, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating VPN Server Configuration %q (Virtual WAN %q / Resource Group %q): %+v", name, virtualWanName, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of VPN Server Configuration %q (Virtual WAN %q / Resource Group %q): %+v", name, virtualWanName, resourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, virtualWanName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving VPN Server Configuration %q (Virtual WAN %q / Resource Group %q): %+v", name, virtualWanName, resourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read VPN Server Configuration %q (Virtual WAN %q / Resource Group %q) ID", name, virtualWanName, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmVpnServerConfigurationRead(d, meta)
+}
+
+func resourceArmVpnServerConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network.VpnServerConfigurationsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := networkSvc.ParseVpnServerConfigurationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualWanName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] VPN Server Configuration %q (Virtual WAN %q / Resource Group %q) was not found - removing from state", id.Name, id.VirtualWanName, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving VPN Server Configuration %q (Virtual WAN %q / Resource Group %q): %+v", id.Name, id.VirtualWanName, id.ResourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("virtual_wan_id", networkSvc.NewVirtualWanID(id.SubscriptionId, id.ResourceGroup, id.VirtualWanName).String())
+
+	if props := resp.P2SVpnServerConfigurationProperties; props != nil {
+		vpnProtocols := make([]interface{}, 0)
+		if props.VpnProtocols != nil {
+			for _, v := range *props.VpnProtocols {
+				vpnProtocols = append(vpnProtocols, string(v))
+			}
+		}
+		if err := d.Set("vpn_protocols", schema.NewSet(schema.HashString, vpnProtocols)); err != nil {
+			return fmt.Errorf("Error setting `vpn_protocols`: %+v", err)
+		}
+
+		if err := d.Set("client_root_certificate", flattenArmVpnServerConfigurationClientRootCertificates(props.P2SVpnServerConfigVpnClientRootCertificates)); err != nil {
+			return fmt.Errorf("Error setting `client_root_certificate`: %+v", err)
+		}
+
+		if err := d.Set("client_revoked_certificate", flattenArmVpnServerConfigurationClientRevokedCertificates(props.P2SVpnServerConfigVpnClientRevokedCertificates)); err != nil {
+			return fmt.Errorf("Error setting `client_revoked_certificate`: %+v", err)
+		}
+
+		// the API doesn't return the Radius Server Secret, so we pull it from the existing state
+		existingSecret := ""
+		if v := d.Get("radius_server").([]interface{}); len(v) > 0 && v[0] != nil {
+			existingSecret = v[0].(map[string]interface{})["secret"].(string)
+		}
+		if err := d.Set("radius_server", flattenArmVpnServerConfigurationRadiusServer(props, existingSecret)); err != nil {
+			return fmt.Errorf("Error setting `radius_server`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmVpnServerConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network.VpnServerConfigurationsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := networkSvc.ParseVpnServerConfigurationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.VirtualWanName, id.Name)
+	if err != nil {
+		// deleted outside of Terraform
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting VPN Server Configuration %q (Virtual WAN %q / Resource Group %q): %+v", id.Name, id.VirtualWanName, id.ResourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for the deletion of VPN Server Configuration %q (Virtual WAN %q / Resource Group %q): %+v", id.Name, id.VirtualWanName, id.ResourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandArmVpnServerConfigurationClientRootCertificates(input []interface{}) *[]network.P2SVpnServerConfigVpnClientRootCertificate {
+	results := make([]network.P2SVpnServerConfigVpnClientRootCertificate, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		results = append(results, network.P2SVpnServerConfigVpnClientRootCertificate{
+			Name: utils.String(v["name"].(string)),
+			P2SVpnServerConfigVpnClientRootCertificatePropertiesFormat: &network.P2SVpnServerConfigVpnClientRootCertificatePropertiesFormat{
+				PublicCertData: utils.String(v["public_cert_data"].(string)),
+			},
+		})
+	}
+	return &results
+}
+
+func flattenArmVpnServerConfigurationClientRootCertificates(input *[]network.P2SVpnServerConfigVpnClientRootCertificate) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		publicCertData := ""
+		if props := item.P2SVpnServerConfigVpnClientRootCertificatePropertiesFormat; props != nil && props.PublicCertData != nil {
+			publicCertData = *props.PublicCertData
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":             name,
+			"public_cert_data": publicCertData,
+		})
+	}
+	return results
+}
+
+func expandArmVpnServerConfigurationClientRevokedCertificates(input []interface{}) *[]network.P2SVpnServerConfigVpnClientRevokedCertificate {
+	results := make([]network.P2SVpnServerConfigVpnClientRevokedCertificate, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		results = append(results, network.P2SVpnServerConfigVpnClientRevokedCertificate{
+			Name: utils.String(v["name"].(string)),
+			P2SVpnServerConfigVpnClientRevokedCertificatePropertiesFormat: &network.P2SVpnServerConfigVpnClientRevokedCertificatePropertiesFormat{
+				Thumbprint: utils.String(v["thumbprint"].(string)),
+			},
+		})
+	}
+	return &results
+}
+
+func flattenArmVpnServerConfigurationClientRevokedCertificates(input *[]network.P2SVpnServerConfigVpnClientRevokedCertificate) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		thumbprint := ""
+		if props := item.P2SVpnServerConfigVpnClientRevokedCertificatePropertiesFormat; props != nil && props.Thumbprint != nil {
+			thumbprint = *props.Thumbprint
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":       name,
+			"thumbprint": thumbprint,
+		})
+	}
+	return results
+}
+
+func expandArmVpnServerConfigurationRadiusServerRootCertificates(input []interface{}) *[]network.P2SVpnServerConfigRadiusServerRootCertificate {
+	results := make([]network.P2SVpnServerConfigRadiusServerRootCertificate, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		results = append(results, network.P2SVpnServerConfigRadiusServerRootCertificate{
+			Name: utils.String(v["name"].(string)),
+			P2SVpnServerConfigRadiusServerRootCertificatePropertiesFormat: &network.P2SVpnServerConfigRadiusServerRootCertificatePropertiesFormat{
+				PublicCertData: utils.String(v["public_cert_data"].(string)),
+			},
+		})
+	}
+	return &results
+}
+
+func expandArmVpnServerConfigurationRadiusClientRootCertificates(input []interface{}) *[]network.P2SVpnServerConfigRadiusClientRootCertificate {
+	results := make([]network.P2SVpnServerConfigRadiusClientRootCertificate, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		results = append(results, network.P2SVpnServerConfigRadiusClientRootCertificate{
+			Name: utils.String(v["name"].(string)),
+			P2SVpnServerConfigRadiusClientRootCertificatePropertiesFormat: &network.P2SVpnServerConfigRadiusClientRootCertificatePropertiesFormat{
+				Thumbprint: utils.String(v["thumbprint"].(string)),
+			},
+		})
+	}
+	return &results
+}
+
+func flattenArmVpnServerConfigurationRadiusServer(input *network.P2SVpnServerConfigurationProperties, existingSecret string) []interface{} {
+	if input == nil || input.RadiusServerAddress == nil || *input.RadiusServerAddress == "" {
+		return []interface{}{}
+	}
+
+	serverRootCertificates := make([]interface{}, 0)
+	if input.P2SVpnServerConfigRadiusServerRootCertificates != nil {
+		for _, item := range *input.P2SVpnServerConfigRadiusServerRootCertificates {
+			name := ""
+			if item.Name != nil {
+				name = *item.Name
+			}
+
+			publicCertData := ""
+			if props := item.P2SVpnServerConfigRadiusServerRootCertificatePropertiesFormat; props != nil && props.PublicCertData != nil {
+				publicCertData = *props.PublicCertData
+			}
+
+			serverRootCertificates = append(serverRootCertificates, map[string]interface{}{
+				"name":             name,
+				"public_cert_data": publicCertData,
+			})
+		}
+	}
+
+	clientRootCertificates := make([]interface{}, 0)
+	if input.P2SVpnServerConfigRadiusClientRootCertificates != nil {
+		for _, item := range *input.P2SVpnServerConfigRadiusClientRootCertificates {
+			name := ""
+			if item.Name != nil {
+				name = *item.Name
+			}
+
+			thumbprint := ""
+			if props := item.P2SVpnServerConfigRadiusClientRootCertificatePropertiesFormat; props != nil && props.Thumbprint != nil {
+				thumbprint = *props.Thumbprint
+			}
+
+			clientRootCertificates = append(clientRootCertificates, map[string]interface{}{
+				"name":       name,
+				"thumbprint": thumbprint,
+			})
+		}
+	}
+
+	secret := existingSecret
+	if input.RadiusServerSecret != nil && *input.RadiusServerSecret != "" {
+		secret = *input.RadiusServerSecret
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"address":                 *input.RadiusServerAddress,
+			"secret":                  secret,
+			"server_root_certificate": serverRootCertificates,
+			"client_root_certificate": clientRootCertificates,
+		},
+	}
+}
@@ -0,0 +1,264 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	networkSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMVpnServerConfiguration_basic(t *testing.T) {
+	resourceName := "azurerm_vpn_server_configuration.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVpnServerConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVpnServerConfiguration_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVpnServerConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "client_root_certificate.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVpnServerConfiguration_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_vpn_server_configuration.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVpnServerConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVpnServerConfiguration_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVpnServerConfigurationExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMVpnServerConfiguration_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_vpn_server_configuration"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVpnServerConfiguration_radius(t *testing.T) {
+	resourceName := "azurerm_vpn_server_configuration.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVpnServerConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVpnServerConfiguration_radius(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVpnServerConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "radius_server.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "radius_server.0.address", "10.105.1.1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"radius_server.0.secret"},
+			},
+		},
+	})
+}
+
+func testCheckAzureRMVpnServerConfigurationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("VPN Server Configuration not found: %s", resourceName)
+		}
+
+		id, err := networkSvc.ParseVpnServerConfigurationID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).network.VpnServerConfigurationsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualWanName, id.Name); err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: VPN Server Configuration %q (Virtual WAN %q / Resource Group %q) does not exist", id.Name, id.VirtualWanName, id.ResourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on network.VpnServerConfigurationsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMVpnServerConfigurationDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).network.VpnServerConfigurationsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_vpn_server_configuration" {
+			continue
+		}
+
+		id, err := networkSvc.ParseVpnServerConfigurationID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualWanName, id.Name); err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Get on network.VpnServerConfigurationsClient: %+v", err)
+			}
+		}
+
+		return nil
+	}
+
+	return nil
+}
+
+func testAccAzureRMVpnServerConfiguration_basic(rInt int, location string) string {
+	template := testAccAzureRMVpnServerConfiguration_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_server_configuration" "test" {
+  name           = "acctestvpnsc-%d"
+  virtual_wan_id = "${azurerm_virtual_wan.test.id}"
+  vpn_protocols  = ["IkeV2"]
+
+  client_root_certificate {
+    name = "VpnServerConfigurationRoot"
+
+    public_cert_data = <<EOF
+MIICJjCCAY+gAwIBAgIUMdXwPPf2yJuGFiF7Odf/RFQ/ts8wDQYJKoZIhvcNAQEL
+BQAwJTEjMCEGA1UEAwwaVnBuU2VydmVyQ29uZmlndXJhdGlvblJvb3QwHhcNMjYx
+MDE2MTMyMzI4WhcNMzYxMDEzMTMyMzI4WjAlMSMwIQYDVQQDDBpWcG5TZXJ2ZXJD
+b25maWd1cmF0aW9uUm9vdDCBnzANBgkqhkiG9w0BAQEFAAOBjQAwgYkCgYEApdeV
+DAPK90aUuc8man2+5qPSx2823HfBQZvhY8DgfO9ScIRtTD2hCZiL5mF71VCTWzgy
+ZMcrudWB9X5a7vaCEwwQoPXMYvPfQG8LcmP9NOH+XvkdRpbzKxWSMLiMTT0CF1hT
+QCI8XY8ELwX34N4DrjZZzLYQa3OxMUET66cvs4ECAwEAAaNTMFEwHQYDVR0OBBYE
+FIWrQapq5u60vI3vJ3Sz1CCsalmWMB8GA1UdIwQYMBaAFIWrQapq5u60vI3vJ3Sz
+1CCsalmWMA8GA1UdEwEB/wQFMAMBAf8wDQYJKoZIhvcNAQELBQADgYEAC2MqhmUF
+DYSnKMeewR5RxXaKYVTpWmrW+HNpsKfS2w9s5pHhtBNqWvSE50KuI0M7qPpI4Cqh
+OtiKh6jziGqc7/B5AuixgvtZw2yChORHzHn0H0ATDcpHHjER/x8Axol+kzHZ6lzn
+au1NduhiKruYihkgHTZX1AwvyRy8MW2yK1c=
+EOF
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMVpnServerConfiguration_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMVpnServerConfiguration_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_server_configuration" "import" {
+  name           = "${azurerm_vpn_server_configuration.test.name}"
+  virtual_wan_id = "${azurerm_vpn_server_configuration.test.virtual_wan_id}"
+  vpn_protocols  = ["IkeV2"]
+
+  client_root_certificate {
+    name = "VpnServerConfigurationRoot"
+
+    public_cert_data = <<EOF
+MIICJjCCAY+gAwIBAgIUMdXwPPf2yJuGFiF7Odf/RFQ/ts8wDQYJKoZIhvcNAQEL
+BQAwJTEjMCEGA1UEAwwaVnBuU2VydmVyQ29uZmlndXJhdGlvblJvb3QwHhcNMjYx
+MDE2MTMyMzI4WhcNMzYxMDEzMTMyMzI4WjAlMSMwIQYDVQQDDBpWcG5TZXJ2ZXJD
+b25maWd1cmF0aW9uUm9vdDCBnzANBgkqhkiG9w0BAQEFAAOBjQAwgYkCgYEApdeV
+DAPK90aUuc8man2+5qPSx2823HfBQZvhY8DgfO9ScIRtTD2hCZiL5mF71VCTWzgy
+ZMcrudWB9X5a7vaCEwwQoPXMYvPfQG8LcmP9NOH+XvkdRpbzKxWSMLiMTT0CF1hT
+QCI8XY8ELwX34N4DrjZZzLYQa3OxMUET66cvs4ECAwEAAaNTMFEwHQYDVR0OBBYE
+FIWrQapq5u60vI3vJ3Sz1CCsalmWMB8GA1UdIwQYMBaAFIWrQapq5u60vI3vJ3Sz
+1CCsalmWMA8GA1UdEwEB/wQFMAMBAf8wDQYJKoZIhvcNAQELBQADgYEAC2MqhmUF
+DYSnKMeewR5RxXaKYVTpWmrW+HNpsKfS2w9s5pHhtBNqWvSE50KuI0M7qPpI4Cqh
+OtiKh6jziGqc7/B5AuixgvtZw2yChORHzHn0H0ATDcpHHjER/x8Axol+kzHZ6lzn
+au1NduhiKruYihkgHTZX1AwvyRy8MW2yK1c=
+EOF
+  }
+}
+`, template)
+}
+
+func testAccAzureRMVpnServerConfiguration_radius(rInt int, location string) string {
+	template := testAccAzureRMVpnServerConfiguration_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_server_configuration" "test" {
+  name           = "acctestvpnsc-%d"
+  virtual_wan_id = "${azurerm_virtual_wan.test.id}"
+  vpn_protocols  = ["OpenVPN"]
+
+  radius_server {
+    address = "10.105.1.1"
+    secret  = "vindicators-the-return-of-worldender"
+
+    server_root_certificate {
+      name = "VpnServerConfigurationRoot"
+
+      public_cert_data = <<EOF
+MIICJjCCAY+gAwIBAgIUMdXwPPf2yJuGFiF7Odf/RFQ/ts8wDQYJKoZIhvcNAQEL
+BQAwJTEjMCEGA1UEAwwaVnBuU2VydmVyQ29uZmlndXJhdGlvblJvb3QwHhcNMjYx
+MDE2MTMyMzI4WhcNMzYxMDEzMTMyMzI4WjAlMSMwIQYDVQQDDBpWcG5TZXJ2ZXJD
+b25maWd1cmF0aW9uUm9vdDCBnzANBgkqhkiG9w0BAQEFAAOBjQAwgYkCgYEApdeV
+DAPK90aUuc8man2+5qPSx2823HfBQZvhY8DgfO9ScIRtTD2hCZiL5mF71VCTWzgy
+ZMcrudWB9X5a7vaCEwwQoPXMYvPfQG8LcmP9NOH+XvkdRpbzKxWSMLiMTT0CF1hT
+QCI8XY8ELwX34N4DrjZZzLYQa3OxMUET66cvs4ECAwEAAaNTMFEwHQYDVR0OBBYE
+FIWrQapq5u60vI3vJ3Sz1CCsalmWMB8GA1UdIwQYMBaAFIWrQapq5u60vI3vJ3Sz
+1CCsalmWMA8GA1UdEwEB/wQFMAMBAf8wDQYJKoZIhvcNAQELBQADgYEAC2MqhmUF
+DYSnKMeewR5RxXaKYVTpWmrW+HNpsKfS2w9s5pHhtBNqWvSE50KuI0M7qPpI4Cqh
+OtiKh6jziGqc7/B5AuixgvtZw2yChORHzHn0H0ATDcpHHjER/x8Axol+kzHZ6lzn
+au1NduhiKruYihkgHTZX1AwvyRy8MW2yK1c=
+EOF
+    }
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMVpnServerConfiguration_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_wan" "test" {
+  name                = "acctestvwan-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+}
+`, rInt, location, rInt)
+}
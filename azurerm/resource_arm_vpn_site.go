@@ -0,0 +1,339 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	networkSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmVpnSite() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVpnSiteCreateUpdate,
+		Read:   resourceArmVpnSiteRead,
+		Update: resourceArmVpnSiteCreateUpdate,
+		Delete: resourceArmVpnSiteDelete,
+
+		Importer: azure.ValidateResourceIDPriorToImport(networkSvc.ValidateVpnSiteID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"virtual_wan_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     networkSvc.ValidateVirtualWanID,
+				DiffSuppressFunc: suppress.ResourceIDDifference,
+			},
+
+			"ip_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.IPv4Address,
+			},
+
+			"address_prefixes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.CIDR,
+				},
+			},
+
+			"device_vendor": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"device_model": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"link_speed_in_mbps": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"bgp_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"asn": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"peering_address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.IPv4Address,
+						},
+
+						"peer_weight": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+					},
+				},
+			},
+
+			"is_security_site": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceArmVpnSiteCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network.VpnSitesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for VPN Site creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if meta.(*ArmClient).Features.RequiresImport && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing VPN Site %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_vpn_site", *existing.ID)
+		}
+	}
+
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	virtualWanId := d.Get("virtual_wan_id").(string)
+	ipAddress := d.Get("ip_address").(string)
+	addressPrefixes := d.Get("address_prefixes").([]interface{})
+	bgpSettings := d.Get("bgp_settings").([]interface{})
+	isSecuritySite := d.Get("is_security_site").(bool)
+	t := d.Get("tags").(map[string]interface{})
+
+	parameters := network.VpnSite{
+		Location: utils.String(location),
+		VpnSiteProperties: &network.VpnSiteProperties{
+			VirtualWan: &network.SubResource{
+				ID: utils.String(virtualWanId),
+			},
+			IPAddress: utils.String(ipAddress),
+			AddressSpace: &network.AddressSpace{
+				AddressPrefixes: utils.ExpandStringSlice(addressPrefixes),
+			},
+			DeviceProperties: &network.DeviceProperties{},
+			BgpProperties:    expandArmVpnSiteBgpSettings(bgpSettings),
+			IsSecuritySite:   utils.Bool(isSecuritySite),
+		},
+		Tags: tags.Expand(t),
+	}
+
+	if v, ok := d.GetOk("device_vendor"); ok {
+		parameters.VpnSiteProperties.DeviceProperties.DeviceVendor = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("device_model"); ok {
+		parameters.VpnSiteProperties.DeviceProperties.DeviceModel = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("link_speed_in_mbps"); ok {
+		parameters.VpnSiteProperties.DeviceProperties.LinkSpeedInMbps = utils.Int32(int32(v.(int)))
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating VPN Site %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of VPN Site %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving VPN Site %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read VPN Site %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmVpnSiteRead(d, meta)
+}
+
+func resourceArmVpnSiteRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network.VpnSitesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := networkSvc.ParseVpnSiteID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] VPN Site %q (Resource Group %q) was not found - removing from state", id.Name, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving VPN Site %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	if props := resp.VpnSiteProperties; props != nil {
+		virtualWanId := ""
+		if props.VirtualWan != nil && props.VirtualWan.ID != nil {
+			virtualWanId = *props.VirtualWan.ID
+		}
+		d.Set("virtual_wan_id", virtualWanId)
+		d.Set("ip_address", props.IPAddress)
+		d.Set("is_security_site", props.IsSecuritySite)
+
+		addressPrefixes := make([]interface{}, 0)
+		if space := props.AddressSpace; space != nil {
+			addressPrefixes = utils.FlattenStringSlice(space.AddressPrefixes)
+		}
+		if err := d.Set("address_prefixes", addressPrefixes); err != nil {
+			return fmt.Errorf("Error setting `address_prefixes`: %+v", err)
+		}
+
+		if device := props.DeviceProperties; device != nil {
+			d.Set("device_vendor", device.DeviceVendor)
+			d.Set("device_model", device.DeviceModel)
+			d.Set("link_speed_in_mbps", device.LinkSpeedInMbps)
+		}
+
+		if err := d.Set("bgp_settings", flattenArmVpnSiteBgpSettings(props.BgpProperties)); err != nil {
+			return fmt.Errorf("Error setting `bgp_settings`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceArmVpnSiteDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network.VpnSitesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := networkSvc.ParseVpnSiteID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		// deleted outside of Terraform
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting VPN Site %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for the deletion of VPN Site %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandArmVpnSiteBgpSettings(input []interface{}) *network.BgpSettings {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &network.BgpSettings{
+		Asn:               utils.Int64(int64(v["asn"].(int))),
+		BgpPeeringAddress: utils.String(v["peering_address"].(string)),
+		PeerWeight:        utils.Int32(int32(v["peer_weight"].(int))),
+	}
+}
+
+func flattenArmVpnSiteBgpSettings(input *network.BgpSettings) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	asn := 0
+	if input.Asn != nil {
+		asn = int(*input.Asn)
+	}
+
+	peeringAddress := ""
+	if input.BgpPeeringAddress != nil {
+		peeringAddress = *input.BgpPeeringAddress
+	}
+
+	peerWeight := 0
+	if input.PeerWeight != nil {
+		peerWeight = int(*input.PeerWeight)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"asn":             asn,
+			"peering_address": peeringAddress,
+			"peer_weight":     peerWeight,
+		},
+	}
+}
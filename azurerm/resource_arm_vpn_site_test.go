@@ -0,0 +1,232 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	networkSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMVpnSite_basic(t *testing.T) {
+	resourceName := "azurerm_vpn_site.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVpnSiteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVpnSite_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVpnSiteExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVpnSite_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_vpn_site.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVpnSiteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVpnSite_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVpnSiteExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMVpnSite_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_vpn_site"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVpnSite_complete(t *testing.T) {
+	resourceName := "azurerm_vpn_site.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVpnSiteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVpnSite_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVpnSiteExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMVpnSite_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVpnSiteExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "address_prefixes.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "device_vendor", "Cisco"),
+					resource.TestCheckResourceAttr(resourceName, "link_speed_in_mbps", "50"),
+					resource.TestCheckResourceAttr(resourceName, "bgp_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "bgp_settings.0.asn", "65515"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMVpnSiteExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("VPN Site not found: %s", resourceName)
+		}
+
+		id, err := networkSvc.ParseVpnSiteID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).network.VpnSitesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.Name); err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: VPN Site %q (Resource Group %q) does not exist", id.Name, id.ResourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on network.VpnSitesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMVpnSiteDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).network.VpnSitesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_vpn_site" {
+			continue
+		}
+
+		id, err := networkSvc.ParseVpnSiteID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.Name); err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Get on network.VpnSitesClient: %+v", err)
+			}
+		}
+
+		return nil
+	}
+
+	return nil
+}
+
+func testAccAzureRMVpnSite_basic(rInt int, location string) string {
+	template := testAccAzureRMVpnSite_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_site" "test" {
+  name                = "acctestvpnsite-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  virtual_wan_id      = "${azurerm_virtual_wan.test.id}"
+  ip_address          = "10.1.0.1"
+  address_prefixes    = ["10.1.0.0/24"]
+}
+`, template, rInt)
+}
+
+func testAccAzureRMVpnSite_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMVpnSite_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_site" "import" {
+  name                = "${azurerm_vpn_site.test.name}"
+  location            = "${azurerm_vpn_site.test.location}"
+  resource_group_name = "${azurerm_vpn_site.test.resource_group_name}"
+  virtual_wan_id      = "${azurerm_vpn_site.test.virtual_wan_id}"
+  ip_address          = "${azurerm_vpn_site.test.ip_address}"
+  address_prefixes    = ["10.1.0.0/24"]
+}
+`, template)
+}
+
+func testAccAzureRMVpnSite_complete(rInt int, location string) string {
+	template := testAccAzureRMVpnSite_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_site" "test" {
+  name                = "acctestvpnsite-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  virtual_wan_id      = "${azurerm_virtual_wan.test.id}"
+  ip_address          = "10.1.0.1"
+  address_prefixes    = ["10.1.0.0/24", "10.2.0.0/24"]
+  device_vendor       = "Cisco"
+  device_model        = "ISR"
+  link_speed_in_mbps  = 50
+
+  bgp_settings {
+    asn             = 65515
+    peering_address = "10.1.0.2"
+    peer_weight     = 0
+  }
+
+  tags = {
+    environment = "Production"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMVpnSite_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_wan" "test" {
+  name                = "acctestvwan-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+}
+`, rInt, location, rInt)
+}
@@ -0,0 +1,344 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+)
+
+func TestAccAzureRMWindowsVirtualMachine_basic(t *testing.T) {
+	resourceName := "azurerm_windows_virtual_machine.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMWindowsVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMWindowsVirtualMachine_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWindowsVirtualMachineExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "virtual_machine_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"admin_password",
+				},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMWindowsVirtualMachine_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_windows_virtual_machine.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMWindowsVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMWindowsVirtualMachine_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWindowsVirtualMachineExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMWindowsVirtualMachine_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_windows_virtual_machine"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMWindowsVirtualMachine_complete(t *testing.T) {
+	resourceName := "azurerm_windows_virtual_machine.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMWindowsVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMWindowsVirtualMachine_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWindowsVirtualMachineExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enable_automatic_updates", "false"),
+					resource.TestCheckResourceAttr(resourceName, "timezone", "Pacific Standard Time"),
+					resource.TestCheckResourceAttr(resourceName, "winrm_listener.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "additional_unattend_content.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"admin_password",
+					"additional_unattend_content.0.content",
+				},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMWindowsVirtualMachine_update(t *testing.T) {
+	resourceName := "azurerm_windows_virtual_machine.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMWindowsVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMWindowsVirtualMachine_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWindowsVirtualMachineExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "size", "Standard_F2"),
+				),
+			},
+			{
+				Config: testAccAzureRMWindowsVirtualMachine_updated(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWindowsVirtualMachineExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "size", "Standard_F4"),
+					resource.TestCheckResourceAttr(resourceName, "license_type", "Windows_Server"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"admin_password",
+				},
+			},
+		},
+	})
+}
+
+func testCheckAzureRMWindowsVirtualMachineExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).Compute.VMClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			return fmt.Errorf("Bad: Get on VMClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Windows Virtual Machine %q (Resource Group %q) does not exist", name, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMWindowsVirtualMachineDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).Compute.VMClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_windows_virtual_machine" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			if resp.StatusCode == http.StatusNotFound {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Windows Virtual Machine %q (Resource Group %q) still exists", name, resourceGroup)
+	}
+
+	return nil
+}
+
+// the Computer Name of a Windows Virtual Machine is limited to 15 characters, so a shorter suffix is used
+func testAccAzureRMWindowsVirtualMachine_basic(rInt int, location string) string {
+	template := testAccAzureRMLinuxVirtualMachine_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_windows_virtual_machine" "test" {
+  name                = "acctvm%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  size                = "Standard_F2"
+  admin_username      = "adminuser"
+  admin_password      = "P@$$w0rd1234!"
+
+  network_interface_ids = [
+    "${azurerm_network_interface.test.id}",
+  ]
+
+  os_disk {
+    caching              = "ReadWrite"
+    storage_account_type = "Standard_LRS"
+  }
+
+  source_image_reference {
+    publisher = "MicrosoftWindowsServer"
+    offer     = "WindowsServer"
+    sku       = "2016-Datacenter"
+    version   = "latest"
+  }
+}
+`, template, rInt%1000000)
+}
+
+func testAccAzureRMWindowsVirtualMachine_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMWindowsVirtualMachine_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_windows_virtual_machine" "import" {
+  name                = "${azurerm_windows_virtual_machine.test.name}"
+  resource_group_name = "${azurerm_windows_virtual_machine.test.resource_group_name}"
+  location            = "${azurerm_windows_virtual_machine.test.location}"
+  size                = "${azurerm_windows_virtual_machine.test.size}"
+  admin_username      = "${azurerm_windows_virtual_machine.test.admin_username}"
+  admin_password      = "${azurerm_windows_virtual_machine.test.admin_password}"
+
+  network_interface_ids = [
+    "${azurerm_network_interface.test.id}",
+  ]
+
+  os_disk {
+    caching              = "ReadWrite"
+    storage_account_type = "Standard_LRS"
+  }
+
+  source_image_reference {
+    publisher = "MicrosoftWindowsServer"
+    offer     = "WindowsServer"
+    sku       = "2016-Datacenter"
+    version   = "latest"
+  }
+}
+`, template)
+}
+
+func testAccAzureRMWindowsVirtualMachine_complete(rInt int, location string) string {
+	template := testAccAzureRMLinuxVirtualMachine_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_windows_virtual_machine" "test" {
+  name                     = "acctestvm-windows-%d"
+  computer_name            = "acctvm%d"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  size                     = "Standard_F2"
+  admin_username           = "adminuser"
+  admin_password           = "P@$$w0rd1234!"
+  enable_automatic_updates = false
+  timezone                 = "Pacific Standard Time"
+
+  network_interface_ids = [
+    "${azurerm_network_interface.test.id}",
+  ]
+
+  additional_unattend_content {
+    setting = "AutoLogon"
+    content = "<AutoLogon><Username>adminuser</Username><Password><Value>P@$$w0rd1234!</Value></Password><Enabled>true</Enabled><LogonCount>1</LogonCount></AutoLogon>"
+  }
+
+  os_disk {
+    caching              = "ReadWrite"
+    storage_account_type = "Standard_LRS"
+  }
+
+  source_image_reference {
+    publisher = "MicrosoftWindowsServer"
+    offer     = "WindowsServer"
+    sku       = "2016-Datacenter"
+    version   = "latest"
+  }
+
+  winrm_listener {
+    protocol = "Http"
+  }
+
+  tags = {
+    environment = "Production"
+  }
+}
+`, template, rInt, rInt%1000000)
+}
+
+func testAccAzureRMWindowsVirtualMachine_updated(rInt int, location string) string {
+	template := testAccAzureRMLinuxVirtualMachine_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_windows_virtual_machine" "test" {
+  name                = "acctvm%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  size                = "Standard_F4"
+  admin_username      = "adminuser"
+  admin_password      = "P@$$w0rd1234!"
+  license_type        = "Windows_Server"
+
+  network_interface_ids = [
+    "${azurerm_network_interface.test.id}",
+  ]
+
+  os_disk {
+    caching              = "ReadWrite"
+    storage_account_type = "Standard_LRS"
+  }
+
+  source_image_reference {
+    publisher = "MicrosoftWindowsServer"
+    offer     = "WindowsServer"
+    sku       = "2016-Datacenter"
+    version   = "latest"
+  }
+
+  tags = {
+    environment = "Staging"
+  }
+}
+`, template, rInt%1000000)
+}